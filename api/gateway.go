@@ -4,6 +4,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"log"
 	"net/http"
@@ -13,11 +14,17 @@ import (
 
 	"github.com/archyhsh/gochat/api/internal/config"
 	"github.com/archyhsh/gochat/api/internal/handler"
+	rpcserver "github.com/archyhsh/gochat/api/internal/server"
 	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/pkg/presence"
+	"github.com/archyhsh/gochat/pkg/router"
+	"github.com/archyhsh/gochat/rpc/pb"
 	"github.com/joho/godotenv"
 
 	"github.com/zeromicro/go-zero/core/conf"
 	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/zrpc"
+	"google.golang.org/grpc"
 )
 
 var configFile = flag.String("f", "etc/gateway.yaml", "the config file")
@@ -57,7 +64,62 @@ func main() {
 	// 3. Register Business Handlers (API Routes)
 	handler.RegisterHandlers(server, ctx)
 
-	// 4. Static Files Discovery
+	// Node lease and dead-node janitor; devices left on a dead gateway go offline right away
+	janitorCtx, stopJanitor := context.WithCancel(context.Background())
+	defer func() {
+		stopJanitor()
+		if err := ctx.Router.DeregisterNode(context.Background()); err != nil {
+			log.Printf("Failed to deregister node: %v", err)
+		}
+	}()
+	go router.NewJanitor(ctx.Router, c.Node.Lease, c.Node.Heartbeat, func(jctx context.Context, d router.DeviceRef) {
+		ctx.Presence.Update(jctx, d.UserID, d.DeviceID, presence.StateOffline)
+	}).Start(janitorCtx)
+
+	// 4. Internal Server (service-to-service routes on their own listener, HMAC-signed)
+	var internalConf rest.RestConf
+	if err := conf.FillDefault(&internalConf); err != nil {
+		log.Fatalf("Invalid internal server config: %v", err)
+	}
+	internalConf.ServiceConf = c.ServiceConf
+	internalConf.Host = c.Internal.Host
+	internalConf.Port = c.Internal.Port
+
+	internalServer := rest.MustNewServer(internalConf)
+	defer internalServer.Stop()
+	handler.RegisterInternalHandlers(internalServer, ctx)
+	go func() {
+		log.Printf("Starting gateway internal HTTP server at %s:%d (advertised as %s)...", c.Internal.Host, c.Internal.Port, ctx.InternalAddr)
+		internalServer.Start()
+	}()
+	if err := ctx.Router.RegisterInternalAddr(context.Background(), ctx.InternalAddr); err != nil {
+		log.Printf("Failed to advertise internal address: %v", err)
+	}
+
+	// 5. Push RPC Server (message service delivers over gRPC, advertised next to our user routes)
+	if c.PushRpc.ListenOn != "" {
+		var rpcConf zrpc.RpcServerConf
+		if err := conf.FillDefault(&rpcConf); err != nil {
+			log.Fatalf("Invalid push rpc config: %v", err)
+		}
+		rpcConf.ServiceConf = c.ServiceConf
+		rpcConf.ListenOn = c.PushRpc.ListenOn
+
+		rpcServer := zrpc.MustNewServer(rpcConf, func(grpcServer *grpc.Server) {
+			pb.RegisterChatServiceServer(grpcServer, rpcserver.NewChatServiceServer(ctx))
+		})
+		rpcServer.AddUnaryInterceptors(ctx.InternalVerifier.UnaryServerInterceptor())
+		defer rpcServer.Stop()
+		go func() {
+			log.Printf("Starting gateway push rpc server at %s (advertised as %s)...", c.PushRpc.ListenOn, ctx.PushRpcAddr)
+			rpcServer.Start()
+		}()
+		if err := ctx.Router.RegisterRpcAddr(context.Background(), ctx.PushRpcAddr); err != nil {
+			log.Printf("Failed to advertise push rpc address: %v", err)
+		}
+	}
+
+	// 6. Static Files Discovery
 	staticDir := ""
 	targets := []string{"/app/web/static", "web/static", "../web/static", "./web/static"}
 	for _, t := range targets {
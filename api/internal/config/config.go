@@ -1,6 +1,11 @@
 package config
 
 import (
+	"time"
+
+	"github.com/archyhsh/gochat/pkg/auth"
+	"github.com/archyhsh/gochat/pkg/moderation"
+	"github.com/archyhsh/gochat/pkg/ratelimit"
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/rest"
 	"github.com/zeromicro/go-zero/zrpc"
@@ -15,14 +20,47 @@ type Config struct {
 	JWT         struct {
 		JwtSecret   string
 		ExpireHours int
+		auth.KeyConf
 	}
 	Kafka struct {
-		Brokers []string
-		Topic   string
+		Brokers    []string
+		Topic      string
+		EventTopic string `json:",default=user-topic"`
 	}
 	Redis cache.CacheConf
 	Etcd  struct {
 		Hosts []string
 		Key   string
 	}
+	// Internal serves the signed /internal/* routes on their own listener
+	Internal struct {
+		Host    string `json:",default=0.0.0.0"`
+		Port    int    `json:",default=8890"`
+		Secret  string
+		MaxSkew time.Duration `json:",default=30s"`
+	}
+	// Pushes and kicks use the Internal routes when ListenOn is empty
+	PushRpc struct {
+		ListenOn string `json:",optional"`
+	}
+	RateLimit struct {
+		Rules []ratelimit.Rule `json:",optional"`
+	}
+	Moderation moderation.Conf `json:",optional"`
+	Node       struct {
+		Lease     time.Duration `json:",default=15s"`
+		Heartbeat time.Duration `json:",default=5s"`
+	}
+	Conn struct {
+		SendQueue    int           `json:",default=256"`
+		WriteTimeout time.Duration `json:",default=10s"`
+		SlowConsumer string        `json:",default=coalesce,options=drop|coalesce|disconnect"`
+	}
+	Presence struct {
+		Debounce   time.Duration `json:",default=5s"`
+		StaleAfter time.Duration `json:",default=2m"`
+	}
+	BanCache time.Duration `json:",default=10s"`
+	// IPs or CIDRs whose X-Forwarded-For is believed
+	TrustedProxies []string `json:",optional"`
 }
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"net/http"
+
+	push "github.com/archyhsh/gochat/api/internal/handler/push"
+	"github.com/archyhsh/gochat/api/internal/svc"
+
+	"github.com/zeromicro/go-zero/rest"
+)
+
+// RegisterInternalHandlers registers the service-to-service routes of internal.api. They are
+// served on the internal listener only, never next to the public API.
+func RegisterInternalHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.InternalAuthMiddleware},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/internal/push",
+					Handler: push.PushMessageHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/internal/kick",
+					Handler: push.KickConnectionsHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+}
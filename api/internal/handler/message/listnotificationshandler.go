@@ -0,0 +1,31 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"net/http"
+
+	"github.com/archyhsh/gochat/api/internal/logic/message"
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func ListNotificationsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ListNotificationsRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := message.NewListNotificationsLogic(r.Context(), svcCtx)
+		resp, err := l.ListNotifications(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
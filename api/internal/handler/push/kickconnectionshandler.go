@@ -0,0 +1,28 @@
+package push
+
+import (
+	"net/http"
+
+	"github.com/archyhsh/gochat/api/internal/logic/push"
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func KickConnectionsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.KickRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := push.NewKickConnectionsLogic(r.Context(), svcCtx)
+		resp, err := l.KickConnections(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
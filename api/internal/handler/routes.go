@@ -9,7 +9,6 @@ import (
 	dispatch "github.com/archyhsh/gochat/api/internal/handler/dispatch"
 	group "github.com/archyhsh/gochat/api/internal/handler/group"
 	message "github.com/archyhsh/gochat/api/internal/handler/message"
-	push "github.com/archyhsh/gochat/api/internal/handler/push"
 	relation "github.com/archyhsh/gochat/api/internal/handler/relation"
 	user "github.com/archyhsh/gochat/api/internal/handler/user"
 	websocket "github.com/archyhsh/gochat/api/internal/handler/websocket"
@@ -21,7 +20,7 @@ import (
 func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 	server.AddRoutes(
 		rest.WithMiddlewares(
-			[]rest.Middleware{serverCtx.AuthMiddleware},
+			[]rest.Middleware{serverCtx.AuthMiddleware, serverCtx.RateLimitMiddleware},
 			[]rest.Route{
 				{
 					Method:  http.MethodPost,
@@ -34,7 +33,7 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 
 	server.AddRoutes(
 		rest.WithMiddlewares(
-			[]rest.Middleware{serverCtx.AuthMiddleware},
+			[]rest.Middleware{serverCtx.AuthMiddleware, serverCtx.RateLimitMiddleware},
 			[]rest.Route{
 				{
 					Method:  http.MethodPost,
@@ -117,7 +116,7 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 
 	server.AddRoutes(
 		rest.WithMiddlewares(
-			[]rest.Middleware{serverCtx.AuthMiddleware},
+			[]rest.Middleware{serverCtx.AuthMiddleware, serverCtx.RateLimitMiddleware},
 			[]rest.Route{
 				{
 					Method:  http.MethodGet,
@@ -154,23 +153,23 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/messages/send",
 					Handler: message.SendMessageHandler(serverCtx),
 				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/notifications",
+					Handler: message.ListNotificationsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/notifications/ack",
+					Handler: message.AckNotificationsHandler(serverCtx),
+				},
 			}...,
 		),
 	)
 
-	server.AddRoutes(
-		[]rest.Route{
-			{
-				Method:  http.MethodPost,
-				Path:    "/internal/push",
-				Handler: push.PushMessageHandler(serverCtx),
-			},
-		},
-	)
-
 	server.AddRoutes(
 		rest.WithMiddlewares(
-			[]rest.Middleware{serverCtx.AuthMiddleware},
+			[]rest.Middleware{serverCtx.AuthMiddleware, serverCtx.RateLimitMiddleware},
 			[]rest.Route{
 				{
 					Method:  http.MethodPost,
@@ -217,34 +216,87 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 	)
 
 	server.AddRoutes(
-		[]rest.Route{
-			{
-				Method:  http.MethodPost,
-				Path:    "/forgot_password",
-				Handler: user.ForgotPasswordHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodPost,
-				Path:    "/login",
-				Handler: user.LoginHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodPost,
-				Path:    "/register",
-				Handler: user.RegisterHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodGet,
-				Path:    "/users/:id",
-				Handler: user.GetUserHandler(serverCtx),
-			},
-		},
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RateLimitMiddleware},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/account/reactivate",
+					Handler: user.ReactivateAccountHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/forgot_password",
+					Handler: user.ForgotPasswordHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/login",
+					Handler: user.LoginHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/login/mfa",
+					Handler: user.VerifyMfaHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/login/mfa/confirm",
+					Handler: user.ConfirmLoginTotpHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/login/mfa/enroll",
+					Handler: user.EnrollLoginTotpHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/oauth/:provider/start",
+					Handler: user.StartOidcLoginHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/oauth/callback",
+					Handler: user.OidcCallbackHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/oauth/providers",
+					Handler: user.ListOidcProvidersHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/register",
+					Handler: user.RegisterHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/reset_password",
+					Handler: user.ResetPasswordHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/token/refresh",
+					Handler: user.RefreshTokenHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/users/:id",
+					Handler: user.GetUserHandler(serverCtx),
+				},
+			}...,
+		),
 	)
 
 	server.AddRoutes(
 		rest.WithMiddlewares(
-			[]rest.Middleware{serverCtx.AuthMiddleware},
+			[]rest.Middleware{serverCtx.AuthMiddleware, serverCtx.RateLimitMiddleware},
 			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/logout",
+					Handler: user.LogoutHandler(serverCtx),
+				},
 				{
 					Method:  http.MethodGet,
 					Path:    "/user/me",
@@ -255,6 +307,91 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/user/me",
 					Handler: user.UpdateUserHandler(serverCtx),
 				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/user/me/notify",
+					Handler: user.GetNotifySettingHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPut,
+					Path:    "/user/me/notify",
+					Handler: user.UpdateNotifySettingHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/user/me/deactivate",
+					Handler: user.DeactivateAccountHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/user/me/delete",
+					Handler: user.DeleteAccountHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/user/me/devices",
+					Handler: user.ListDevicesHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/user/me/logins",
+					Handler: user.ListLoginHistoryHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/user/me/devices",
+					Handler: user.RegisterDeviceHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodDelete,
+					Path:    "/user/me/devices/:device_id",
+					Handler: user.RemoveDeviceHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/user/me/mfa/totp",
+					Handler: user.EnrollTotpHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/user/me/mfa/totp/confirm",
+					Handler: user.ConfirmTotpHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/user/me/mfa/totp/disable",
+					Handler: user.DisableTotpHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/user/me/verify",
+					Handler: user.VerifyContactHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/user/me/verify/send",
+					Handler: user.SendVerificationCodeHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/user/me/privacy",
+					Handler: user.GetPrivacySettingHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPut,
+					Path:    "/user/me/privacy",
+					Handler: user.UpdatePrivacySettingHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/users/:id/presence",
+					Handler: user.GetPresenceHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/users/presence",
+					Handler: user.BatchGetPresenceHandler(serverCtx),
+				},
 				{
 					Method:  http.MethodGet,
 					Path:    "/users/search",
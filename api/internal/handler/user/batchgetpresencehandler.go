@@ -0,0 +1,31 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"net/http"
+
+	"github.com/archyhsh/gochat/api/internal/logic/user"
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func BatchGetPresenceHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.BatchPresenceRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := user.NewBatchGetPresenceLogic(r.Context(), svcCtx)
+		resp, err := l.BatchGetPresence(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -7,10 +7,15 @@ import (
 
 	wslogic "github.com/archyhsh/gochat/api/internal/logic/websocket"
 	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/pkg/auth"
+	"github.com/archyhsh/gochat/pkg/manager"
+	"github.com/archyhsh/gochat/pkg/wsproto"
+	"github.com/archyhsh/gochat/rpc/pb"
 	gws "github.com/gorilla/websocket"
 )
 
 var upgrader = gws.Upgrader{
+	Subprotocols: wsproto.Subprotocols,
 	CheckOrigin: func(r *http.Request) bool {
 		origin := r.Header.Get("Origin")
 		if origin == "" {
@@ -41,6 +46,16 @@ func WsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 			http.Error(w, "Invalid token", http.StatusUnauthorized)
 			return
 		}
+		if err := svcCtx.Sessions.Validate(r.Context(), claims); err != nil {
+			http.Error(w, "Session revoked", http.StatusUnauthorized)
+			return
+		}
+		if banned, err := svcCtx.Status.Banned(r.Context(), claims.UserID); err != nil {
+			log.Printf("Status check failed for user %d: %v", claims.UserID, err)
+		} else if banned {
+			http.Error(w, "Account banned", http.StatusForbidden)
+			return
+		}
 
 		userId := claims.UserID
 
@@ -52,26 +67,65 @@ func WsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 		}
 
 		// 3. Initialize logic and register connection
+		// Clients that negotiated no subprotocol keep the legacy "ping" and JSON push format
+		codec := wsproto.CodecFor(conn.Subprotocol())
 		l := wslogic.NewWsLogic(r.Context(), svcCtx)
-		l.OnConnect(userId, conn)
+		c := l.OnConnect(claims, codec, conn)
 
 		defer func() {
-			l.OnDisconnect(userId, conn)
-			conn.Close()
+			l.OnDisconnect(c)
+			_ = c.Close()
 		}()
 
-		// 4. Listen for client messages (heartbeats)
+		// 4. Listen for client messages (heartbeats, away/active)
 		for {
 			mt, message, err := conn.ReadMessage()
 			if err != nil {
 				break
 			}
 
+			if codec != nil && (mt == gws.BinaryMessage || !isActivity(message)) {
+				if !handleFrame(l, claims, c, mt, message) {
+					break
+				}
+				continue
+			}
+
 			// Handle "ping" heartbeat to renew Redis lease
 			if mt == gws.TextMessage && string(message) == "ping" {
-				l.HandleHeartbeat(userId)
-				_ = conn.WriteMessage(gws.TextMessage, []byte("pong"))
+				// A device signed out while the kick did not reach it is dropped here
+				if !l.HandleHeartbeat(c, claims) {
+					break
+				}
+				_ = c.SendMessage(manager.Frame{Type: gws.TextMessage, Data: []byte("pong")})
+			} else if mt == gws.TextMessage && isActivity(message) {
+				l.HandleActivity(claims, string(message) == "away")
 			}
 		}
 	}
 }
+
+// isActivity reports whether a text frame is a presence update, which every protocol version
+// sends as plain text.
+func isActivity(message []byte) bool {
+	return string(message) == "away" || string(message) == "active"
+}
+
+// handleFrame decodes and handles one envelope, writing the reply if there is one; it reports
+// false once the connection must be closed.
+func handleFrame(l *wslogic.WsLogic, claims *auth.Claims, c *manager.WsConnection, mt int, message []byte) bool {
+	codec := c.GetCodec()
+	var reply *pb.OutgoingMessage
+	keep := true
+	if in, err := codec.Decode(mt, message); err != nil {
+		reply = wsproto.Error("", wsproto.ErrCodeBadFrame, "malformed frame: "+err.Error())
+	} else {
+		reply, keep = l.HandleFrame(c, claims, in)
+	}
+	if reply != nil {
+		if ft, data, err := codec.Encode(reply); err == nil {
+			_ = c.SendMessage(manager.Frame{Type: ft, Data: data})
+		}
+	}
+	return keep
+}
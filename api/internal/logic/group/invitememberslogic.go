@@ -5,11 +5,13 @@ package group
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/archyhsh/gochat/api/internal/svc"
 	"github.com/archyhsh/gochat/api/internal/types"
 	"github.com/archyhsh/gochat/rpc/pb"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -30,12 +32,20 @@ func NewInviteMembersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Inv
 }
 
 func (l *InviteMembersLogic) InviteMembers(req *types.InviteRequest) (resp *types.CommonResponse, err error) {
-	_, err = l.svcCtx.GroupRpc.InviteMembers(l.ctx, &pb.InviteMembersRequest{
+	userId, ok := l.ctx.Value("user_id").(int64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not login")
+	}
+	// The inviter has to be allowed to add each invitee to groups
+	md := metadata.Pairs("user_id", strconv.FormatInt(userId, 10))
+	ctx := metadata.NewOutgoingContext(l.ctx, md)
+
+	_, err = l.svcCtx.GroupRpc.InviteMembers(ctx, &pb.InviteMembersRequest{
 		GroupId:   req.Id,
 		MemberIds: req.MemberIds,
 	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, "fail to call GroupRpc func InviteMembers"+err.Error())
+		return nil, err
 	}
 	return &types.CommonResponse{
 		Message: "Invite members successfully",
@@ -0,0 +1,53 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AckNotificationsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAckNotificationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AckNotificationsLogic {
+	return &AckNotificationsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AckNotificationsLogic) AckNotifications(req *types.AckNotificationsRequest) (resp *types.CommonResponse, err error) {
+	userId, ok := l.ctx.Value("user_id").(int64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not login")
+	}
+	// send userId to backend RPC via gRPC Metadata
+	md := metadata.Pairs("user_id", strconv.FormatInt(userId, 10))
+	ctx := metadata.NewOutgoingContext(l.ctx, md)
+
+	_, err = l.svcCtx.MessageRpc.AckNotifications(ctx, &pb.AckNotificationsRequest{
+		Ids: req.Ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.CommonResponse{
+		Message: "notifications acknowledged",
+	}, nil
+}
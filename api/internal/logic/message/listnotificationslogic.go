@@ -0,0 +1,71 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package message
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListNotificationsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListNotificationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListNotificationsLogic {
+	return &ListNotificationsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListNotificationsLogic) ListNotifications(req *types.ListNotificationsRequest) (resp *types.NotificationsResponse, err error) {
+	userId, ok := l.ctx.Value("user_id").(int64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not login")
+	}
+	// send userId to backend RPC via gRPC Metadata
+	md := metadata.Pairs("user_id", strconv.FormatInt(userId, 10))
+	ctx := metadata.NewOutgoingContext(l.ctx, md)
+
+	rpcResp, err := l.svcCtx.MessageRpc.ListNotifications(ctx, &pb.ListNotificationsRequest{
+		AfterId: req.AfterId,
+		Limit:   int32(req.Limit),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "fail to call messageRPC func ListNotifications"+err.Error())
+	}
+
+	notifications := make([]types.Notification, 0, len(rpcResp.Notifications))
+	for _, n := range rpcResp.Notifications {
+		notifications = append(notifications, types.Notification{
+			Id: n.Id,
+			Message: types.Message{
+				MsgId:          n.Message.GetMsgId(),
+				ConversationId: n.Message.GetConversationId(),
+				SenderId:       n.Message.GetSenderId(),
+				Content:        n.Message.GetContent(),
+				MsgType:        int(n.Message.GetMsgType()),
+				Timestamp:      n.Message.GetTimestamp(),
+			},
+			Reason:    n.Reason,
+			CreatedAt: n.CreatedAt,
+		})
+	}
+	return &types.NotificationsResponse{
+		Notifications: notifications,
+		HasMore:       rpcResp.HasMore,
+	}, nil
+}
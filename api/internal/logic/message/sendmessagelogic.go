@@ -8,6 +8,7 @@ import (
 
 	"github.com/archyhsh/gochat/api/internal/svc"
 	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/pkg/moderation"
 	"github.com/archyhsh/gochat/pkg/snowflake"
 	"github.com/archyhsh/gochat/rpc/pb"
 
@@ -34,6 +35,12 @@ func (l *SendMessageLogic) SendMessage(req *types.SendMessageRequest) (resp *typ
 	if !ok {
 		return nil, fmt.Errorf("unauthorized")
 	}
+	// Connections opened before a ban may still be sending until they are closed
+	if banned, err := l.svcCtx.Status.Banned(l.ctx, userId); err != nil {
+		l.Errorf("Status check failed for user %d: %v", userId, err)
+	} else if banned {
+		return nil, fmt.Errorf("your account is banned")
+	}
 
 	// Permission check
 	if req.GroupId > 0 {
@@ -64,13 +71,29 @@ func (l *SendMessageLogic) SendMessage(req *types.SendMessageRequest) (resp *typ
 	msgId := strconv.FormatInt(snowflake.MustNextID(), 10)
 	now := time.Now().UnixMilli()
 
+	// Moderation: only text is checked, other types carry uploaded media references
+	content := req.Content
+	if req.MsgType <= 1 {
+		verdict := l.svcCtx.Moderator.Check(l.ctx, &moderation.Message{
+			MsgId:          msgId,
+			ConversationId: req.ConversationId,
+			SenderId:       userId,
+			GroupId:        req.GroupId,
+			Content:        req.Content,
+		})
+		if verdict.Blocked {
+			return nil, fmt.Errorf("message rejected by content policy")
+		}
+		content = verdict.Content
+	}
+
 	event := &pb.ChatMessageEvent{
 		MsgId:          msgId,
 		ConversationId: req.ConversationId,
 		SenderId:       userId,
 		ReceiverId:     req.ReceiverId,
 		GroupId:        req.GroupId,
-		Content:        req.Content,
+		Content:        content,
 		MsgType:        int32(req.MsgType),
 		Timestamp:      now,
 	}
@@ -0,0 +1,36 @@
+package push
+
+import (
+	"context"
+
+	wslogic "github.com/archyhsh/gochat/api/internal/logic/websocket"
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type KickConnectionsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewKickConnectionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *KickConnectionsLogic {
+	return &KickConnectionsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// KickConnections is the HTTP counterpart of ChatService.KickUser, used when the gateway serves
+// no push rpc.
+func (l *KickConnectionsLogic) KickConnections(req *types.KickRequest) (resp *types.KickResponse, err error) {
+	kicked := wslogic.NewWsLogic(l.ctx, l.svcCtx).Kick(req.UserId, req.DeviceId, pb.KickReason(req.Code), req.Reason)
+	l.Infof("Kicked %d connection(s) of user %d (device %q): %s %s", kicked, req.UserId, req.DeviceId, pb.KickReason(req.Code), req.Reason)
+	return &types.KickResponse{
+		Kicked: kicked,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package push
+
+import (
+	"context"
+
+	wslogic "github.com/archyhsh/gochat/api/internal/logic/websocket"
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type KickUserLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewKickUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *KickUserLogic {
+	return &KickUserLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// KickUser closes the live connections of a user, or of one of their devices, on this gateway.
+func (l *KickUserLogic) KickUser(in *pb.KickRequest) (*pb.KickResponse, error) {
+	kicked := wslogic.NewWsLogic(l.ctx, l.svcCtx).Kick(in.UserId, in.DeviceId, in.Code, in.Reason)
+	l.Infof("Kicked %d connection(s) of user %d (device %q): %s %s", kicked, in.UserId, in.DeviceId, in.Code, in.Reason)
+	return &pb.KickResponse{
+		Base: &pb.BaseResponse{Code: 200, Message: "Success"},
+	}, nil
+}
@@ -0,0 +1,57 @@
+package push
+
+import (
+	"context"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PushBatchLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewPushBatchLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PushBatchLogic {
+	return &PushBatchLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// PushBatch is the gRPC counterpart of POST /internal/push and delivers through the same logic.
+func (l *PushBatchLogic) PushBatch(in *pb.PushBatchRequest) (*pb.PushBatchResponse, error) {
+	if in.Event == nil {
+		return nil, status.Error(codes.InvalidArgument, "event is required")
+	}
+
+	evt := in.Event
+	_, err := NewPushMessageLogic(l.ctx, l.svcCtx).PushMessage(&types.PushRequest{
+		UserIds:           in.UserIds,
+		ConversationId:    evt.ConversationId,
+		MsgId:             evt.MsgId,
+		SenderId:          evt.SenderId,
+		Content:           evt.Content,
+		MsgType:           int(evt.MsgType),
+		Timestamp:         evt.Timestamp,
+		SenderInfoVersion: evt.SenderInfoVersion,
+		GroupMetaVersion:  evt.GroupMetaVersion,
+		RelationVersion:   evt.RelationVersion,
+		Sequence:          evt.Sequence,
+		UnreadMap:         in.UnreadMap,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.PushBatchResponse{
+		Base: &pb.BaseResponse{Code: 200, Message: "Success"},
+	}, nil
+}
@@ -3,15 +3,25 @@ package push
 import (
 	"context"
 	"encoding/json"
-	"sync"
+	"fmt"
 
 	"github.com/archyhsh/gochat/api/internal/svc"
 	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/pkg/manager"
+	"github.com/archyhsh/gochat/pkg/wsproto"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/gorilla/websocket"
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
+// frame is an encoded envelope ready to be written
+type frame struct {
+	frameType int
+	data      []byte
+}
+
 type PushMessageLogic struct {
 	logx.Logger
 	ctx    context.Context
@@ -41,27 +51,69 @@ func (l *PushMessageLogic) PushMessage(req *types.PushRequest) (resp *types.Push
 		"sequence":            req.Sequence,
 	}
 
+	// Envelope for clients that negotiated a subprotocol, encoded at most once per codec
+	chatMsg := &pb.ChatMessage{
+		MsgId:             req.MsgId,
+		ConversationId:    req.ConversationId,
+		SenderId:          req.SenderId,
+		Content:           req.Content,
+		MsgType:           int32(req.MsgType),
+		Timestamp:         req.Timestamp,
+		SenderInfoVersion: req.SenderInfoVersion,
+		GroupMetaVersion:  req.GroupMetaVersion,
+		RelationVersion:   req.RelationVersion,
+		Sequence:          req.Sequence,
+	}
+
+	// Frames that only matter in their latest version may replace a queued predecessor
+	var key string
+	switch req.MsgType {
+	case 19:
+		key = fmt.Sprintf("presence:%d", req.SenderId)
+	case wsproto.MsgTypeTyping:
+		key = fmt.Sprintf("typing:%s:%d", req.ConversationId, req.SenderId)
+	}
+
 	for _, uid := range req.UserIds {
-		if val, ok := l.svcCtx.Conns.Load(uid); ok {
-			// Deep copy or extend for specific user unread count
-			userData := make(map[string]interface{})
-			for k, v := range baseData {
-				userData[k] = v
+		conns := l.svcCtx.Manager.GetUserConnections(uid)
+		if len(conns) == 0 {
+			continue
+		}
+
+		// Deep copy or extend for specific user unread count
+		userData := make(map[string]interface{})
+		for k, v := range baseData {
+			userData[k] = v
+		}
+
+		var unread int64
+		if req.UnreadMap != nil {
+			if count, ok := req.UnreadMap[uid]; ok {
+				userData["unread_count"] = count
+				unread = count
 			}
+		}
 
-			if req.UnreadMap != nil {
-				if count, ok := req.UnreadMap[uid]; ok {
-					userData["unread_count"] = count
+		jsonData, _ := json.Marshal(userData)
+		frames := make(map[string]frame)
+		for _, c := range conns {
+			codec := c.GetCodec()
+			if codec == nil {
+				_ = c.SendMessage(manager.Frame{Type: websocket.TextMessage, Data: jsonData, Key: key})
+				continue
+			}
+			f, ok := frames[codec.Name()]
+			if !ok {
+				msg := proto.Clone(chatMsg).(*pb.ChatMessage)
+				msg.UnreadCount = unread
+				var err error
+				if f.frameType, f.data, err = codec.Encode(wsproto.FromChat(msg)); err != nil {
+					l.Errorf("Failed to encode push %s for %s: %v", req.MsgId, codec.Name(), err)
+					continue
 				}
+				frames[codec.Name()] = f
 			}
-
-			jsonData, _ := json.Marshal(userData)
-			m := val.(*sync.Map)
-			m.Range(func(key, value interface{}) bool {
-				conn := key.(*websocket.Conn)
-				_ = conn.WriteMessage(websocket.TextMessage, jsonData)
-				return true
-			})
+			_ = c.SendMessage(manager.Frame{Type: f.frameType, Data: f.data, Key: key})
 		}
 	}
 
@@ -43,9 +43,11 @@ func (l *ApplyFriendLogic) ApplyFriend(req *types.ApplyRequest) (resp *types.Com
 	_, err = l.svcCtx.RelationRpc.Apply(ctx, &pb.ApplyRequest{
 		ToUserId: req.ToUserId,
 		Message:  req.Message,
+		Answer:   req.Answer,
 	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to apply friend: "+err.Error())
+		// Refusals under the recipient's privacy settings keep their status
+		return nil, err
 	}
 
 	return &types.CommonResponse{
@@ -0,0 +1,51 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchGetPresenceLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewBatchGetPresenceLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchGetPresenceLogic {
+	return &BatchGetPresenceLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *BatchGetPresenceLogic) BatchGetPresence(req *types.BatchPresenceRequest) (resp *types.BatchPresenceResponse, err error) {
+	userId, ok := l.ctx.Value("user_id").(int64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not login")
+	}
+	md := metadata.Pairs("user_id", strconv.FormatInt(userId, 10))
+	ctx := metadata.NewOutgoingContext(l.ctx, md)
+
+	rpcResp, err := l.svcCtx.UserRpc.BatchGetPresence(ctx, &pb.BatchGetPresenceRequest{UserIds: req.UserIds})
+	if err != nil {
+		return nil, err
+	}
+	resp = &types.BatchPresenceResponse{Presences: make([]types.Presence, 0, len(rpcResp.Presences))}
+	for _, p := range rpcResp.Presences {
+		resp.Presences = append(resp.Presences, *toPresence(p))
+	}
+	return resp, nil
+}
@@ -0,0 +1,40 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ConfirmLoginTotpLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewConfirmLoginTotpLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ConfirmLoginTotpLogic {
+	return &ConfirmLoginTotpLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ConfirmLoginTotp turns 2FA on during sign-in and, with it, finishes signing in.
+func (l *ConfirmLoginTotpLogic) ConfirmLoginTotp(req *types.ConfirmTotpRequest) (resp *types.ConfirmTotpResponse, err error) {
+	rpcResp, err := l.svcCtx.UserRpc.ConfirmTotp(l.ctx, &pb.ConfirmTotpRequest{
+		Code:     req.Code,
+		MfaToken: req.MfaToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toConfirmTotpResponse(rpcResp), nil
+}
@@ -0,0 +1,59 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ConfirmTotpLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewConfirmTotpLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ConfirmTotpLogic {
+	return &ConfirmTotpLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ConfirmTotpLogic) ConfirmTotp(req *types.ConfirmTotpRequest) (resp *types.ConfirmTotpResponse, err error) {
+	userId, ok := l.ctx.Value("user_id").(int64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not login")
+	}
+	md := metadata.Pairs("user_id", strconv.FormatInt(userId, 10))
+	ctx := metadata.NewOutgoingContext(l.ctx, md)
+
+	rpcResp, err := l.svcCtx.UserRpc.ConfirmTotp(ctx, &pb.ConfirmTotpRequest{
+		Code: req.Code,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toConfirmTotpResponse(rpcResp), nil
+}
+
+func toConfirmTotpResponse(r *pb.ConfirmTotpResponse) *types.ConfirmTotpResponse {
+	resp := &types.ConfirmTotpResponse{
+		RecoveryCodes: r.RecoveryCodes,
+	}
+	if r.Login != nil {
+		resp.Login = toLoginResponse(r.Login)
+	}
+	return resp
+}
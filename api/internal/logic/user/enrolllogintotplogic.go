@@ -0,0 +1,42 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type EnrollLoginTotpLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewEnrollLoginTotpLogic(ctx context.Context, svcCtx *svc.ServiceContext) *EnrollLoginTotpLogic {
+	return &EnrollLoginTotpLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// EnrollLoginTotp sets up 2FA for an account that must have it before it can finish signing in.
+func (l *EnrollLoginTotpLogic) EnrollLoginTotp(req *types.EnrollTotpRequest) (resp *types.EnrollTotpResponse, err error) {
+	rpcResp, err := l.svcCtx.UserRpc.EnrollTotp(l.ctx, &pb.EnrollTotpRequest{
+		MfaToken: req.MfaToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.EnrollTotpResponse{
+		Secret:     rpcResp.Secret,
+		OtpauthUri: rpcResp.OtpauthUri,
+	}, nil
+}
@@ -0,0 +1,52 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type EnrollTotpLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewEnrollTotpLogic(ctx context.Context, svcCtx *svc.ServiceContext) *EnrollTotpLogic {
+	return &EnrollTotpLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// EnrollTotp returns a new secret to add to an authenticator app; 2FA turns on once a code from
+// it is confirmed.
+func (l *EnrollTotpLogic) EnrollTotp() (resp *types.EnrollTotpResponse, err error) {
+	userId, ok := l.ctx.Value("user_id").(int64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not login")
+	}
+	md := metadata.Pairs("user_id", strconv.FormatInt(userId, 10))
+	ctx := metadata.NewOutgoingContext(l.ctx, md)
+
+	rpcResp, err := l.svcCtx.UserRpc.EnrollTotp(ctx, &pb.EnrollTotpRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &types.EnrollTotpResponse{
+		Secret:     rpcResp.Secret,
+		OtpauthUri: rpcResp.OtpauthUri,
+	}, nil
+}
@@ -24,16 +24,17 @@ func NewForgotPasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Fo
 	}
 }
 
+// ForgotPassword asks for a reset code, which is then redeemed at /reset_password.
 func (l *ForgotPasswordLogic) ForgotPassword(req *types.ForgotPasswordRequest) (resp *types.CommonResponse, err error) {
-	_, err = l.svcCtx.UserRpc.ForgotPassword(l.ctx, &pb.ForgotPasswordRequest{
-		Username:    req.Username,
-		NewPassword: req.NewPassword,
+	rpcResp, err := l.svcCtx.UserRpc.ForgotPassword(l.ctx, &pb.ForgotPasswordRequest{
+		Username: req.Username,
+		Channel:  req.Channel,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.CommonResponse{
-		Message: "Success",
+		Message: rpcResp.Base.Message,
 	}, nil
 }
@@ -46,12 +46,15 @@ func (l *GetCurrentUserLogic) GetCurrentUser() (resp *types.User, err error) {
 	}
 
 	return &types.User{
-		Id:       rpcResp.User.Id,
-		Username: rpcResp.User.Username,
-		Nickname: rpcResp.User.Nickname,
-		Avatar:   rpcResp.User.Avatar,
-		Phone:    rpcResp.User.Phone,
-		Email:    rpcResp.User.Email,
-		Gender:   int(rpcResp.User.Gender),
+		Id:            rpcResp.User.Id,
+		Username:      rpcResp.User.Username,
+		Nickname:      rpcResp.User.Nickname,
+		Avatar:        rpcResp.User.Avatar,
+		Phone:         rpcResp.User.Phone,
+		Email:         rpcResp.User.Email,
+		Gender:        int(rpcResp.User.Gender),
+		EmailVerified: rpcResp.User.EmailVerified,
+		PhoneVerified: rpcResp.User.PhoneVerified,
+		MfaEnabled:    rpcResp.User.MfaEnabled,
 	}, nil
 }
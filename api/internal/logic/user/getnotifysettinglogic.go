@@ -0,0 +1,56 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetNotifySettingLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetNotifySettingLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetNotifySettingLogic {
+	return &GetNotifySettingLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetNotifySettingLogic) GetNotifySetting() (resp *types.NotifySetting, err error) {
+	userId, ok := l.ctx.Value("user_id").(int64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not login")
+	}
+	md := metadata.Pairs("user_id", strconv.FormatInt(userId, 10))
+	ctx := metadata.NewOutgoingContext(l.ctx, md)
+
+	rpcResp, err := l.svcCtx.UserRpc.GetNotifySetting(ctx, &pb.GetNotifySettingRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return toNotifySetting(rpcResp.Setting), nil
+}
+
+func toNotifySetting(s *pb.NotifySetting) *types.NotifySetting {
+	return &types.NotifySetting{
+		DndEnabled: s.GetDndEnabled(),
+		DndStart:   int(s.GetDndStart()),
+		DndEnd:     int(s.GetDndEnd()),
+		Timezone:   s.GetTimezone(),
+	}
+}
@@ -0,0 +1,70 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPresenceLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetPresenceLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPresenceLogic {
+	return &GetPresenceLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetPresenceLogic) GetPresence(req *types.GetPresenceRequest) (resp *types.Presence, err error) {
+	userId, ok := l.ctx.Value("user_id").(int64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not login")
+	}
+	md := metadata.Pairs("user_id", strconv.FormatInt(userId, 10))
+	ctx := metadata.NewOutgoingContext(l.ctx, md)
+
+	rpcResp, err := l.svcCtx.UserRpc.GetPresence(ctx, &pb.GetPresenceRequest{UserId: req.UserId})
+	if err != nil {
+		return nil, err
+	}
+	return toPresence(rpcResp.Presence), nil
+}
+
+var presenceStates = map[pb.PresenceState]string{
+	pb.PresenceState_PRESENCE_STATE_ONLINE:  "online",
+	pb.PresenceState_PRESENCE_STATE_AWAY:    "away",
+	pb.PresenceState_PRESENCE_STATE_OFFLINE: "offline",
+}
+
+func toPresence(p *pb.Presence) *types.Presence {
+	res := &types.Presence{
+		UserId:   p.GetUserId(),
+		State:    presenceStates[p.GetState()],
+		LastSeen: p.GetLastSeen(),
+		Devices:  []types.DevicePresence{},
+	}
+	for _, d := range p.GetDevices() {
+		res.Devices = append(res.Devices, types.DevicePresence{
+			DeviceId:  d.DeviceId,
+			State:     presenceStates[d.State],
+			UpdatedAt: d.UpdatedAt,
+		})
+	}
+	return res
+}
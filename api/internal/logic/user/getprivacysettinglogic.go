@@ -0,0 +1,94 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPrivacySettingLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetPrivacySettingLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPrivacySettingLogic {
+	return &GetPrivacySettingLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetPrivacySettingLogic) GetPrivacySetting() (resp *types.PrivacySetting, err error) {
+	userId, ok := l.ctx.Value("user_id").(int64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not login")
+	}
+	md := metadata.Pairs("user_id", strconv.FormatInt(userId, 10))
+	ctx := metadata.NewOutgoingContext(l.ctx, md)
+
+	rpcResp, err := l.svcCtx.UserRpc.GetPrivacySetting(ctx, &pb.GetPrivacySettingRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return toPrivacySetting(rpcResp.Setting), nil
+}
+
+var audiences = map[pb.Audience]string{
+	pb.Audience_AUDIENCE_EVERYONE: "everyone",
+	pb.Audience_AUDIENCE_FRIENDS:  "friends",
+	pb.Audience_AUDIENCE_NOBODY:   "nobody",
+}
+
+var friendRequestPolicies = map[pb.FriendRequestPolicy]string{
+	pb.FriendRequestPolicy_FRIEND_REQUEST_ANYONE:             "anyone",
+	pb.FriendRequestPolicy_FRIEND_REQUEST_FRIENDS_OF_FRIENDS: "friends_of_friends",
+	pb.FriendRequestPolicy_FRIEND_REQUEST_NOBODY:             "nobody",
+	pb.FriendRequestPolicy_FRIEND_REQUEST_QUESTION:           "question",
+}
+
+func toPrivacySetting(s *pb.PrivacySetting) *types.PrivacySetting {
+	return &types.PrivacySetting{
+		SearchableByUsername: s.GetSearchableByUsername(),
+		SearchableByPhone:    s.GetSearchableByPhone(),
+		SearchableByEmail:    s.GetSearchableByEmail(),
+		FriendRequest:        friendRequestPolicies[s.GetFriendRequest()],
+		FriendQuestion:       s.GetFriendQuestion(),
+		GroupInvite:          audiences[s.GetGroupInvite()],
+		Phone:                audiences[s.GetPhone()],
+		Email:                audiences[s.GetEmail()],
+		Gender:               audiences[s.GetGender()],
+		LastSeen:             audiences[s.GetLastSeen()],
+	}
+}
+
+// toPbAudience maps a name checked by the request's options back
+func toPbAudience(name string) pb.Audience {
+	for a, n := range audiences {
+		if n == name {
+			return a
+		}
+	}
+	return pb.Audience_AUDIENCE_EVERYONE
+}
+
+func toPbFriendRequestPolicy(name string) pb.FriendRequestPolicy {
+	for p, n := range friendRequestPolicies {
+		if n == name {
+			return p
+		}
+	}
+	return pb.FriendRequestPolicy_FRIEND_REQUEST_ANYONE
+}
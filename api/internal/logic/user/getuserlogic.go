@@ -5,12 +5,13 @@ package user
 
 import (
 	"context"
-	"strings"
+	"strconv"
 
 	"github.com/archyhsh/gochat/api/internal/svc"
 	"github.com/archyhsh/gochat/api/internal/types"
 	"github.com/archyhsh/gochat/rpc/pb"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -33,50 +34,41 @@ func NewGetUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUserLo
 func (l *GetUserLogic) GetUser(req *types.GetUserRequest) (resp *types.User, err error) {
 	userId, _ := l.ctx.Value("user_id").(int64)
 
-	rpcResp, err := l.svcCtx.UserRpc.GetUser(l.ctx, &pb.GetUserRequest{
+	// The user service hides phone, email and gender per the target's privacy settings
+	md := metadata.Pairs("user_id", strconv.FormatInt(userId, 10))
+	ctx := metadata.NewOutgoingContext(l.ctx, md)
+
+	rpcResp, err := l.svcCtx.UserRpc.GetUser(ctx, &pb.GetUserRequest{
 		UserId: req.Id,
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, "fail to call UserRpc func GetUser"+err.Error())
 	}
 
-	// Privacy Masking: Only owner can see full details
+	// Only the owner sees the full username
 	username := rpcResp.User.Username
-	phone := rpcResp.User.Phone
-	email := rpcResp.User.Email
-
 	if userId != req.Id {
-		// Mask Username
 		if len(username) > 4 {
 			username = username[:2] + "****" + username[len(username)-2:]
 		} else if len(username) > 0 {
 			username = username[:1] + "****"
 		}
-		// Mask Phone
-		if len(phone) >= 7 {
-			phone = phone[:3] + "****" + phone[len(phone)-4:]
-		} else {
-			phone = "****"
-		}
-		// Mask Email
-		if parts := strings.Split(email, "@"); len(parts) == 2 {
-			if len(parts[0]) > 2 {
-				email = parts[0][:2] + "****@" + parts[1]
-			} else {
-				email = "****@" + parts[1]
-			}
-		} else {
-			email = "****"
-		}
+	}
+
+	var friendRequest string
+	if userId != req.Id {
+		friendRequest = friendRequestPolicies[rpcResp.User.FriendRequest]
 	}
 
 	return &types.User{
-		Id:       rpcResp.User.Id,
-		Username: username,
-		Nickname: rpcResp.User.Nickname,
-		Avatar:   rpcResp.User.Avatar,
-		Phone:    phone,
-		Email:    email,
-		Gender:   int(rpcResp.User.Gender),
+		Id:             rpcResp.User.Id,
+		Username:       username,
+		Nickname:       rpcResp.User.Nickname,
+		Avatar:         rpcResp.User.Avatar,
+		Phone:          rpcResp.User.Phone,
+		Email:          rpcResp.User.Email,
+		Gender:         int(rpcResp.User.Gender),
+		FriendRequest:  friendRequest,
+		FriendQuestion: rpcResp.User.FriendQuestion,
 	}, nil
 }
@@ -0,0 +1,79 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListDevicesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListDevicesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListDevicesLogic {
+	return &ListDevicesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListDevicesLogic) ListDevices() (resp *types.DeviceListResponse, err error) {
+	userId, ok := l.ctx.Value("user_id").(int64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not login")
+	}
+	deviceId, _ := l.ctx.Value("device_id").(string)
+	md := metadata.Pairs("user_id", strconv.FormatInt(userId, 10), "device_id", deviceId)
+	ctx := metadata.NewOutgoingContext(l.ctx, md)
+
+	rpcResp, err := l.svcCtx.UserRpc.ListDevices(ctx, &pb.ListDevicesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	resp = &types.DeviceListResponse{
+		Devices: make([]types.Device, 0, len(rpcResp.Devices)),
+	}
+	for _, d := range rpcResp.Devices {
+		resp.Devices = append(resp.Devices, toDevice(d))
+	}
+	return resp, nil
+}
+
+func toDevice(d *pb.Device) types.Device {
+	return types.Device{
+		DeviceId:     d.DeviceId,
+		Platform:     platformName(d.Platform),
+		HasPushToken: d.HasPushToken,
+		LastActiveAt: d.LastActiveAt,
+		CreatedAt:    d.CreatedAt,
+		Current:      d.Current,
+	}
+}
+
+// platformName maps PLATFORM_IOS to "ios"
+func platformName(p pb.DevicePlatform) string {
+	if p == pb.DevicePlatform_PLATFORM_UNSPECIFIED {
+		return "unknown"
+	}
+	return strings.ToLower(strings.TrimPrefix(p.String(), "PLATFORM_"))
+}
+
+// toPbPlatform maps "ios" to PLATFORM_IOS; an empty platform is unspecified
+func toPbPlatform(name string) pb.DevicePlatform {
+	return pb.DevicePlatform(pb.DevicePlatform_value["PLATFORM_"+strings.ToUpper(name)])
+}
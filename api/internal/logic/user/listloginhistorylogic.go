@@ -0,0 +1,65 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListLoginHistoryLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListLoginHistoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListLoginHistoryLogic {
+	return &ListLoginHistoryLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ListLoginHistory returns recent sign-in attempts on the user's account, newest first.
+func (l *ListLoginHistoryLogic) ListLoginHistory(req *types.LoginHistoryRequest) (resp *types.LoginHistoryResponse, err error) {
+	userId, ok := l.ctx.Value("user_id").(int64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not login")
+	}
+	md := metadata.Pairs("user_id", strconv.FormatInt(userId, 10))
+	ctx := metadata.NewOutgoingContext(l.ctx, md)
+
+	rpcResp, err := l.svcCtx.UserRpc.ListLoginHistory(ctx, &pb.ListLoginHistoryRequest{
+		BeforeId: req.BeforeId,
+		Limit:    int32(req.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	records := make([]types.LoginRecord, 0, len(rpcResp.Records))
+	for _, r := range rpcResp.Records {
+		records = append(records, types.LoginRecord{
+			Id:        r.Id,
+			DeviceId:  r.DeviceId,
+			Platform:  r.Platform,
+			Ip:        r.Ip,
+			Success:   r.Success,
+			Reason:    r.Reason,
+			CreatedAt: r.CreatedAt,
+		})
+	}
+	return &types.LoginHistoryResponse{
+		Records: records,
+	}, nil
+}
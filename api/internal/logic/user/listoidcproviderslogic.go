@@ -0,0 +1,46 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListOidcProvidersLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListOidcProvidersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListOidcProvidersLogic {
+	return &ListOidcProvidersLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ListOidcProviders lists the single sign-on providers for the sign-in page.
+func (l *ListOidcProvidersLogic) ListOidcProviders() (resp *types.OidcProvidersResponse, err error) {
+	rpcResp, err := l.svcCtx.UserRpc.ListOidcProviders(l.ctx, &pb.ListOidcProvidersRequest{})
+	if err != nil {
+		return nil, err
+	}
+	providers := make([]types.OidcProvider, 0, len(rpcResp.Providers))
+	for _, p := range rpcResp.Providers {
+		providers = append(providers, types.OidcProvider{
+			Name:        p.Name,
+			DisplayName: p.DisplayName,
+		})
+	}
+	return &types.OidcProvidersResponse{
+		Providers: providers,
+	}, nil
+}
@@ -9,8 +9,6 @@ import (
 	"github.com/archyhsh/gochat/api/internal/svc"
 	"github.com/archyhsh/gochat/api/internal/types"
 	"github.com/archyhsh/gochat/rpc/pb"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -30,23 +28,50 @@ func NewLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LoginLogic
 }
 
 func (l *LoginLogic) Login(req *types.LoginRequest) (resp *types.LoginResponse, err error) {
+	ip, _ := l.ctx.Value("client_ip").(string)
 	rpcResp, err := l.svcCtx.UserRpc.Login(l.ctx, &pb.LoginRequest{
 		Username: req.Username,
 		Password: req.Password,
+		DeviceId: req.DeviceId,
+		Platform: toPbPlatform(defaultPlatform(req.Platform)),
+		Ip:       ip,
 	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, "fail to call UserRpc func login"+err.Error())
+		return nil, err
 	}
-	return &types.LoginResponse{
-		Token: rpcResp.Token,
-		User: types.User{
-			Id:       rpcResp.User.Id,
-			Username: rpcResp.User.Username,
-			Nickname: rpcResp.User.Nickname,
-			Avatar:   rpcResp.User.Avatar,
-			Email:    rpcResp.User.Email,
-			Phone:    rpcResp.User.Phone,
-			Gender:   int(rpcResp.User.Gender),
-		},
-	}, nil
+	return toLoginResponse(rpcResp), nil
+}
+
+// toLoginResponse maps a sign-in result, which carries either the tokens and user or, when a
+// second factor is still due, only the mfa fields
+func toLoginResponse(r *pb.LoginResponse) *types.LoginResponse {
+	resp := &types.LoginResponse{
+		Token:                 r.Token,
+		DeviceId:              r.DeviceId,
+		RefreshToken:          r.RefreshToken,
+		ExpiresIn:             r.ExpiresIn,
+		MfaRequired:           r.MfaRequired,
+		MfaToken:              r.MfaToken,
+		MfaEnrollmentRequired: r.MfaEnrollmentRequired,
+	}
+	if r.User != nil {
+		resp.User = types.User{
+			Id:       r.User.Id,
+			Username: r.User.Username,
+			Nickname: r.User.Nickname,
+			Avatar:   r.User.Avatar,
+			Email:    r.User.Email,
+			Phone:    r.User.Phone,
+			Gender:   int(r.User.Gender),
+		}
+	}
+	return resp
+}
+
+// defaultPlatform treats clients that do not say otherwise as the web client
+func defaultPlatform(platform string) string {
+	if platform == "" {
+		return "web"
+	}
+	return platform
 }
@@ -0,0 +1,42 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type OidcCallbackLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewOidcCallbackLogic(ctx context.Context, svcCtx *svc.ServiceContext) *OidcCallbackLogic {
+	return &OidcCallbackLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// OidcCallback finishes a single sign-on; the response is the same as for /login.
+func (l *OidcCallbackLogic) OidcCallback(req *types.OidcCallbackRequest) (resp *types.LoginResponse, err error) {
+	ip, _ := l.ctx.Value("client_ip").(string)
+	rpcResp, err := l.svcCtx.UserRpc.OidcLogin(l.ctx, &pb.OidcLoginRequest{
+		State: req.State,
+		Code:  req.Code,
+		Ip:    ip,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toLoginResponse(rpcResp), nil
+}
@@ -0,0 +1,46 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReactivateAccountLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewReactivateAccountLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReactivateAccountLogic {
+	return &ReactivateAccountLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ReactivateAccount reopens a closed account, which /login refused; sign in again afterwards.
+func (l *ReactivateAccountLogic) ReactivateAccount(req *types.ReactivateAccountRequest) (resp *types.CommonResponse, err error) {
+	ip, _ := l.ctx.Value("client_ip").(string)
+	_, err = l.svcCtx.UserRpc.ReactivateAccount(l.ctx, &pb.ReactivateAccountRequest{
+		Username:     req.Username,
+		Password:     req.Password,
+		Code:         req.Code,
+		RecoveryCode: req.RecoveryCode,
+		Ip:           ip,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.CommonResponse{
+		Message: "account reactivated",
+	}, nil
+}
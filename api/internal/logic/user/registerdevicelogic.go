@@ -0,0 +1,53 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RegisterDeviceLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRegisterDeviceLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RegisterDeviceLogic {
+	return &RegisterDeviceLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// RegisterDevice sets the push token of the device the request was made from.
+func (l *RegisterDeviceLogic) RegisterDevice(req *types.RegisterDeviceRequest) (resp *types.Device, err error) {
+	userId, ok := l.ctx.Value("user_id").(int64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not login")
+	}
+	deviceId, _ := l.ctx.Value("device_id").(string)
+	md := metadata.Pairs("user_id", strconv.FormatInt(userId, 10), "device_id", deviceId)
+	ctx := metadata.NewOutgoingContext(l.ctx, md)
+
+	rpcResp, err := l.svcCtx.UserRpc.RegisterDevice(ctx, &pb.RegisterDeviceRequest{
+		Platform:  toPbPlatform(req.Platform),
+		PushToken: req.PushToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	device := toDevice(rpcResp.Device)
+	return &device, nil
+}
@@ -0,0 +1,52 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RemoveDeviceLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRemoveDeviceLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RemoveDeviceLogic {
+	return &RemoveDeviceLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// RemoveDevice signs one of the user's devices out, which may be the current one.
+func (l *RemoveDeviceLogic) RemoveDevice(req *types.RemoveDeviceRequest) (resp *types.CommonResponse, err error) {
+	userId, ok := l.ctx.Value("user_id").(int64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not login")
+	}
+	md := metadata.Pairs("user_id", strconv.FormatInt(userId, 10))
+	ctx := metadata.NewOutgoingContext(l.ctx, md)
+
+	_, err = l.svcCtx.UserRpc.RemoveDevice(ctx, &pb.RemoveDeviceRequest{
+		DeviceId: req.DeviceId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.CommonResponse{
+		Message: "device signed out",
+	}, nil
+}
@@ -0,0 +1,44 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ResetPasswordLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewResetPasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ResetPasswordLogic {
+	return &ResetPasswordLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ResetPassword sets a new password with the code sent by /forgot_password.
+func (l *ResetPasswordLogic) ResetPassword(req *types.ResetPasswordRequest) (resp *types.CommonResponse, err error) {
+	_, err = l.svcCtx.UserRpc.ResetPassword(l.ctx, &pb.ResetPasswordRequest{
+		Username:    req.Username,
+		Code:        req.Code,
+		NewPassword: req.NewPassword,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.CommonResponse{
+		Message: "password reset, sign in again on every device",
+	}, nil
+}
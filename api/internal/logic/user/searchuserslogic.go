@@ -5,12 +5,13 @@ package user
 
 import (
 	"context"
-	"strings"
+	"strconv"
 
 	"github.com/archyhsh/gochat/api/internal/svc"
 	"github.com/archyhsh/gochat/api/internal/types"
 	"github.com/archyhsh/gochat/rpc/pb"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -31,7 +32,12 @@ func NewSearchUsersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Searc
 }
 
 func (l *SearchUsersLogic) SearchUsers(req *types.SearchRequest) (resp *types.SearchResponse, err error) {
-	rpcResp, err := l.svcCtx.UserRpc.SearchUsers(l.ctx, &pb.SearchUsersRequest{
+	userId, _ := l.ctx.Value("user_id").(int64)
+	// The user service honours each result's discovery and visibility settings
+	md := metadata.Pairs("user_id", strconv.FormatInt(userId, 10))
+	ctx := metadata.NewOutgoingContext(l.ctx, md)
+
+	rpcResp, err := l.svcCtx.UserRpc.SearchUsers(ctx, &pb.SearchUsersRequest{
 		Keyword: req.Keyword,
 		Limit:   int32(req.Limit),
 	})
@@ -40,7 +46,7 @@ func (l *SearchUsersLogic) SearchUsers(req *types.SearchRequest) (resp *types.Se
 	}
 	var users []types.User
 	for _, u := range rpcResp.Users {
-		// Mask sensitive data for public search
+		// Mask the username for public search
 		maskedUsername := ""
 		if len(u.Username) > 4 {
 			maskedUsername = u.Username[:2] + "****" + u.Username[len(u.Username)-2:]
@@ -48,27 +54,13 @@ func (l *SearchUsersLogic) SearchUsers(req *types.SearchRequest) (resp *types.Se
 			maskedUsername = u.Username[:1] + "****"
 		}
 
-		maskedPhone := ""
-		if len(u.Phone) >= 7 {
-			maskedPhone = u.Phone[:3] + "****" + u.Phone[len(u.Phone)-4:]
-		}
-
-		maskedEmail := ""
-		if parts := strings.Split(u.Email, "@"); len(parts) == 2 {
-			if len(parts[0]) > 2 {
-				maskedEmail = parts[0][:2] + "****@" + parts[1]
-			} else {
-				maskedEmail = "****@" + parts[1]
-			}
-		}
-
 		users = append(users, types.User{
 			Id:       u.Id,
 			Username: maskedUsername,
 			Nickname: u.Nickname,
 			Avatar:   u.Avatar,
-			Phone:    maskedPhone,
-			Email:    maskedEmail,
+			Phone:    u.Phone,
+			Email:    u.Email,
 			Gender:   int(u.Gender),
 		})
 	}
@@ -0,0 +1,44 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type StartOidcLoginLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewStartOidcLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *StartOidcLoginLogic {
+	return &StartOidcLoginLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// StartOidcLogin returns the provider's sign-in page; the provider then redirects the browser to
+// the client, which posts the code and state to /oauth/callback.
+func (l *StartOidcLoginLogic) StartOidcLogin(req *types.StartOidcLoginRequest) (resp *types.StartOidcLoginResponse, err error) {
+	rpcResp, err := l.svcCtx.UserRpc.StartOidcLogin(l.ctx, &pb.StartOidcLoginRequest{
+		Provider: req.Provider,
+		DeviceId: req.DeviceId,
+		Platform: toPbPlatform(defaultPlatform(req.Platform)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.StartOidcLoginResponse{
+		AuthorizationUrl: rpcResp.AuthorizationUrl,
+	}, nil
+}
@@ -0,0 +1,54 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateNotifySettingLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewUpdateNotifySettingLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateNotifySettingLogic {
+	return &UpdateNotifySettingLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateNotifySettingLogic) UpdateNotifySetting(req *types.NotifySetting) (resp *types.NotifySetting, err error) {
+	userId, ok := l.ctx.Value("user_id").(int64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not login")
+	}
+	md := metadata.Pairs("user_id", strconv.FormatInt(userId, 10))
+	ctx := metadata.NewOutgoingContext(l.ctx, md)
+
+	rpcResp, err := l.svcCtx.UserRpc.UpdateNotifySetting(ctx, &pb.UpdateNotifySettingRequest{
+		Setting: &pb.NotifySetting{
+			DndEnabled: req.DndEnabled,
+			DndStart:   int32(req.DndStart),
+			DndEnd:     int32(req.DndEnd),
+			Timezone:   req.Timezone,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toNotifySetting(rpcResp.Setting), nil
+}
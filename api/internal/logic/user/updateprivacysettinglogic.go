@@ -0,0 +1,61 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdatePrivacySettingLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewUpdatePrivacySettingLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdatePrivacySettingLogic {
+	return &UpdatePrivacySettingLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdatePrivacySettingLogic) UpdatePrivacySetting(req *types.PrivacySetting) (resp *types.PrivacySetting, err error) {
+	userId, ok := l.ctx.Value("user_id").(int64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not login")
+	}
+	md := metadata.Pairs("user_id", strconv.FormatInt(userId, 10))
+	ctx := metadata.NewOutgoingContext(l.ctx, md)
+
+	rpcResp, err := l.svcCtx.UserRpc.UpdatePrivacySetting(ctx, &pb.UpdatePrivacySettingRequest{
+		Setting: &pb.PrivacySetting{
+			SearchableByUsername: req.SearchableByUsername,
+			SearchableByPhone:    req.SearchableByPhone,
+			SearchableByEmail:    req.SearchableByEmail,
+			FriendRequest:        toPbFriendRequestPolicy(req.FriendRequest),
+			FriendQuestion:       req.FriendQuestion,
+			FriendAnswer:         req.FriendAnswer,
+			GroupInvite:          toPbAudience(req.GroupInvite),
+			Phone:                toPbAudience(req.Phone),
+			Email:                toPbAudience(req.Email),
+			Gender:               toPbAudience(req.Gender),
+			LastSeen:             toPbAudience(req.LastSeen),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toPrivacySetting(rpcResp.Setting), nil
+}
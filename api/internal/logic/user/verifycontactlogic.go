@@ -0,0 +1,61 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type VerifyContactLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewVerifyContactLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VerifyContactLogic {
+	return &VerifyContactLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// VerifyContact marks the user's email or phone as verified with the code sent to it.
+func (l *VerifyContactLogic) VerifyContact(req *types.VerifyContactRequest) (resp *types.User, err error) {
+	userId, ok := l.ctx.Value("user_id").(int64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not login")
+	}
+	md := metadata.Pairs("user_id", strconv.FormatInt(userId, 10))
+	ctx := metadata.NewOutgoingContext(l.ctx, md)
+
+	rpcResp, err := l.svcCtx.UserRpc.VerifyContact(ctx, &pb.VerifyContactRequest{
+		Channel: req.Channel,
+		Code:    req.Code,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.User{
+		Id:            rpcResp.User.Id,
+		Username:      rpcResp.User.Username,
+		Nickname:      rpcResp.User.Nickname,
+		Avatar:        rpcResp.User.Avatar,
+		Phone:         rpcResp.User.Phone,
+		Email:         rpcResp.User.Email,
+		Gender:        int(rpcResp.User.Gender),
+		EmailVerified: rpcResp.User.EmailVerified,
+		PhoneVerified: rpcResp.User.PhoneVerified,
+	}, nil
+}
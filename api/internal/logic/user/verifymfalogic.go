@@ -0,0 +1,41 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type VerifyMfaLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewVerifyMfaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VerifyMfaLogic {
+	return &VerifyMfaLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// VerifyMfa finishes a sign-in that /login answered with mfa_required.
+func (l *VerifyMfaLogic) VerifyMfa(req *types.VerifyMfaRequest) (resp *types.LoginResponse, err error) {
+	rpcResp, err := l.svcCtx.UserRpc.VerifyMfa(l.ctx, &pb.VerifyMfaRequest{
+		MfaToken:     req.MfaToken,
+		Code:         req.Code,
+		RecoveryCode: req.RecoveryCode,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toLoginResponse(rpcResp), nil
+}
@@ -0,0 +1,165 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/archyhsh/gochat/api/internal/logic/message"
+	"github.com/archyhsh/gochat/api/internal/types"
+	"github.com/archyhsh/gochat/pkg/auth"
+	"github.com/archyhsh/gochat/pkg/manager"
+	"github.com/archyhsh/gochat/pkg/ratelimit"
+	"github.com/archyhsh/gochat/pkg/wsproto"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// HandleFrame handles one envelope sent by a client that negotiated a subprotocol. It returns the
+// reply to write, if any, and false once the connection must be closed.
+//
+// Chat frames are answered with TYPE_ACK carrying the assigned msg_id and heartbeats with
+// TYPE_HEARTBEAT; read, typing and ack frames are only answered on failure. Replies echo the
+// frame's trace_id so clients can match them.
+func (l *WsLogic) HandleFrame(c manager.Connection, claims *auth.Claims, in *pb.IncomingMessage) (*pb.OutgoingMessage, bool) {
+	switch in.Type {
+	case pb.IncomingMessage_TYPE_HEARTBEAT:
+		if !l.HandleHeartbeat(c, claims) {
+			return wsproto.Error(in.TraceId, wsproto.ErrCodeUnauthorized, "session revoked"), false
+		}
+		return &pb.OutgoingMessage{Type: pb.OutgoingMessage_TYPE_HEARTBEAT, TraceId: in.TraceId}, true
+
+	case pb.IncomingMessage_TYPE_CHAT:
+		msg := in.GetChatMsg()
+		if reply := l.rateLimit(claims, in.TraceId, "POST", "/messages/send", ratelimit.MessageKind(msg.GetConversationId(), msg.GetGroupId())); reply != nil {
+			return reply, true
+		}
+		return l.handleChat(claims, in), true
+
+	case pb.IncomingMessage_TYPE_READ:
+		if reply := l.rateLimit(claims, in.TraceId, "POST", "/conversations/clear_unread", ""); reply != nil {
+			return reply, true
+		}
+		if err := l.handleRead(claims, in.GetReadMsg()); err != nil {
+			return frameError(in.TraceId, err), true
+		}
+		return nil, true
+
+	case pb.IncomingMessage_TYPE_TYPING:
+		if reply := l.rateLimit(claims, in.TraceId, "WS", "/typing", ""); reply != nil {
+			return reply, true
+		}
+		if err := l.handleTyping(claims, in.GetTypingMsg()); err != nil {
+			return frameError(in.TraceId, err), true
+		}
+		return nil, true
+
+	case pb.IncomingMessage_TYPE_ACK:
+		// Delivery acknowledgements of pushed messages; nothing tracks delivery yet
+		if in.GetAckMsg().GetMsgId() == "" {
+			return wsproto.Error(in.TraceId, wsproto.ErrCodeBadFrame, "ack_msg.msg_id is required"), true
+		}
+		return nil, true
+	}
+	return wsproto.Error(in.TraceId, wsproto.ErrCodeBadFrame, fmt.Sprintf("unsupported frame type %s", in.Type)), true
+}
+
+func (l *WsLogic) handleChat(claims *auth.Claims, in *pb.IncomingMessage) *pb.OutgoingMessage {
+	msg := in.GetChatMsg()
+	if msg == nil || msg.ConversationId == "" {
+		return wsproto.Error(in.TraceId, wsproto.ErrCodeBadFrame, "chat_msg.conversation_id is required")
+	}
+
+	ctx := context.WithValue(l.ctx, "user_id", claims.UserID)
+	resp, err := message.NewSendMessageLogic(ctx, l.svcCtx).SendMessage(&types.SendMessageRequest{
+		ConversationId: msg.ConversationId,
+		Content:        msg.Content,
+		MsgType:        int(msg.MsgType),
+		ReceiverId:     msg.ReceiverId,
+		GroupId:        msg.GroupId,
+	})
+	if err != nil {
+		return frameError(in.TraceId, err)
+	}
+	return &pb.OutgoingMessage{
+		Type:    pb.OutgoingMessage_TYPE_ACK,
+		TraceId: in.TraceId,
+		Payload: &pb.OutgoingMessage_AckMsg{AckMsg: &pb.AckPayload{
+			MsgId:  resp.MsgId,
+			Status: int32(pb.MessageStatus_MESSAGE_STATUS_SENT),
+		}},
+	}
+}
+
+// handleRead marks the conversation as read, like POST /conversations/clear_unread.
+func (l *WsLogic) handleRead(claims *auth.Claims, read *pb.ReadPayload) error {
+	if read.GetConversationId() == "" {
+		return status.Error(codes.InvalidArgument, "read_msg.conversation_id is required")
+	}
+	md := metadata.Pairs("user_id", strconv.FormatInt(claims.UserID, 10))
+	_, err := l.svcCtx.MessageRpc.ClearUnread(metadata.NewOutgoingContext(l.ctx, md), &pb.ClearUnreadRequest{
+		ConversationId: read.ConversationId,
+	})
+	return err
+}
+
+// handleTyping hands the typing state to the message service, which relays it to the other
+// members of the conversation.
+func (l *WsLogic) handleTyping(claims *auth.Claims, typing *pb.TypingPayload) error {
+	convId := typing.GetConversationId()
+	if !strings.HasPrefix(convId, "conv_") && !strings.HasPrefix(convId, "group_") {
+		return status.Error(codes.InvalidArgument, "typing_msg.conversation_id is invalid")
+	}
+	event := map[string]interface{}{
+		"type":            "typing_event",
+		"user_id":         claims.UserID,
+		"conversation_id": convId,
+		"is_typing":       typing.IsTyping,
+		"timestamp":       time.Now().UnixMilli(),
+	}
+	data, _ := json.Marshal(event)
+	return l.svcCtx.KafkaProducer.SendToTopic(l.ctx, l.svcCtx.Config.Kafka.EventTopic, []byte(convId), data)
+}
+
+// rateLimit checks a frame against the rules of the REST route it stands for, or of the
+// pseudo-route "WS <path>" when there is none; it returns the 429 reply once over budget.
+func (l *WsLogic) rateLimit(claims *auth.Claims, traceId, method, path, kind string) *pb.OutgoingMessage {
+	allowed, retryAfter := l.svcCtx.RateLimiter.Allow(l.ctx, ratelimit.Request{
+		Method: method,
+		Path:   path,
+		UserId: claims.UserID,
+		Kind:   func() string { return kind },
+	})
+	if allowed {
+		return nil
+	}
+	reply := wsproto.Error(traceId, wsproto.ErrCodeRateLimited, "too many requests")
+	reply.GetErrorMsg().RetryAfterMs = retryAfter.Milliseconds()
+	return reply
+}
+
+// frameError turns an error into the ErrorPayload reply, with the HTTP-like status the REST
+// routes would answer; plain errors are the caller's fault, as with httpx.
+func frameError(traceId string, err error) *pb.OutgoingMessage {
+	code := int32(wsproto.ErrCodeBadFrame)
+	msg := err.Error()
+	if st, ok := status.FromError(err); ok {
+		msg = st.Message()
+		switch st.Code() {
+		case codes.Unauthenticated:
+			code = wsproto.ErrCodeUnauthorized
+		case codes.PermissionDenied:
+			code = wsproto.ErrCodeForbidden
+		case codes.InvalidArgument, codes.NotFound, codes.FailedPrecondition, codes.Unknown:
+			code = wsproto.ErrCodeBadFrame
+		default:
+			code = wsproto.ErrCodeInternal
+		}
+	}
+	return wsproto.Error(traceId, code, msg)
+}
@@ -2,9 +2,16 @@ package websocket
 
 import (
 	"context"
-	"sync"
+	"errors"
+	"strconv"
 
 	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/pkg/auth"
+	"github.com/archyhsh/gochat/pkg/manager"
+	"github.com/archyhsh/gochat/pkg/presence"
+	"github.com/archyhsh/gochat/pkg/snowflake"
+	"github.com/archyhsh/gochat/pkg/wsproto"
+	"github.com/archyhsh/gochat/rpc/pb"
 	"github.com/gorilla/websocket"
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -23,38 +30,95 @@ func NewWsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *WsLogic {
 	}
 }
 
-func (l *WsLogic) OnConnect(userId int64, conn *websocket.Conn) {
-	actual, _ := l.svcCtx.Conns.LoadOrStore(userId, &sync.Map{})
-	m := actual.(*sync.Map)
-	m.Store(conn, struct{}{})
+// OnConnect registers a new socket and returns the connection all writes to it must go through.
+func (l *WsLogic) OnConnect(claims *auth.Claims, codec wsproto.Codec, conn *websocket.Conn) *manager.WsConnection {
+	userId, deviceId := claims.UserID, claims.DeviceID
+	c := manager.NewWsConnection(strconv.FormatInt(snowflake.MustNextID(), 10), userId, deviceId, claims.Platform,
+		codec, conn, l.svcCtx.ConnOptions)
+	l.svcCtx.Manager.Register(c)
+	_ = l.svcCtx.Sessions.Touch(l.ctx, userId, deviceId)
+	l.svcCtx.Presence.Update(l.ctx, userId, deviceId, presence.StateOnline)
 
 	// Register in global router
-	if err := l.svcCtx.Router.Register(l.ctx, userId); err != nil {
+	if err := l.svcCtx.Router.Register(l.ctx, userId, c.GetID(), deviceId); err != nil {
 		l.Errorf("Router register error for user %d: %v", userId, err)
 	}
+	return c
 }
 
-func (l *WsLogic) OnDisconnect(userId int64, conn *websocket.Conn) {
-	if val, ok := l.svcCtx.Conns.Load(userId); ok {
-		m := val.(*sync.Map)
-		m.Delete(conn)
+func (l *WsLogic) OnDisconnect(c manager.Connection) {
+	l.removeConn(c)
+}
 
-		isEmpty := true
-		m.Range(func(key, value interface{}) bool {
-			isEmpty = false
-			return false
-		})
+// removeConn forgets a connection and removes its route entry. The device goes offline once none
+// of its connections are left.
+func (l *WsLogic) removeConn(c manager.Connection) {
+	if !l.svcCtx.Manager.Unregister(c) {
+		return
+	}
+	userId, deviceId := c.GetUserID(), c.GetDeviceID()
+	_ = l.svcCtx.Router.Unregister(context.WithoutCancel(l.ctx), userId, c.GetID())
 
-		if isEmpty {
-			l.svcCtx.Conns.Delete(userId)
-			_ = l.svcCtx.Router.Unregister(l.ctx, userId)
-		}
+	remaining := l.svcCtx.Manager.GetUserConnections(userId)
+	deviceLeft := false
+	for _, other := range remaining {
+		deviceLeft = deviceLeft || other.GetDeviceID() == deviceId
+	}
+
+	if !deviceLeft {
+		l.svcCtx.Presence.Update(context.WithoutCancel(l.ctx), userId, deviceId, presence.StateOffline)
 	}
 }
 
-func (l *WsLogic) HandleHeartbeat(userId int64) {
+// HandleHeartbeat renews the route entry of a connection; it reports false once the connection's
+// device has been signed out or the user banned. Refreshing the access token keeps the
+// connection open.
+func (l *WsLogic) HandleHeartbeat(c manager.Connection, claims *auth.Claims) bool {
+	userId := claims.UserID
+	if err := l.svcCtx.Sessions.ValidateDevice(l.ctx, claims); errors.Is(err, auth.ErrSessionRevoked) {
+		l.Infof("Session of device %s of user %d was revoked, closing", claims.DeviceID, userId)
+		return false
+	}
+	if banned, _ := l.svcCtx.Status.Banned(l.ctx, userId); banned {
+		l.Infof("User %d is banned, closing", userId)
+		return false
+	}
+
 	// Renew lease in Redis
-	if err := l.svcCtx.Router.Register(l.ctx, userId); err != nil {
-		l.Errorf("Router renewal failed for user %d: %v", userId, err)
+	if err := l.svcCtx.Router.Register(l.ctx, userId, c.GetID(), claims.DeviceID); err != nil {
+		l.Errorf("Router renewal failed for connection %s of user %d: %v", c.GetID(), userId, err)
+	}
+	_ = l.svcCtx.Sessions.Touch(l.ctx, userId, claims.DeviceID)
+	l.svcCtx.Presence.Touch(l.ctx, userId, claims.DeviceID)
+	return true
+}
+
+// HandleActivity records that the client went to the background ("away") or came back
+// ("active"), e.g. when its window loses or regains focus.
+func (l *WsLogic) HandleActivity(claims *auth.Claims, away bool) {
+	state := presence.StateOnline
+	if away {
+		state = presence.StateAway
+	}
+	l.svcCtx.Presence.Update(l.ctx, claims.UserID, claims.DeviceID, state)
+}
+
+// KickCloseCodeBase is added to the KickReason to form the WebSocket close code (4000-4999 are
+// reserved for applications), so clients can tell a kick from a network failure
+const KickCloseCodeBase = 4000
+
+// Kick closes the connections of a user on this gateway, only those of deviceId when it is set,
+// with a close frame carrying the reason.
+func (l *WsLogic) Kick(userId int64, deviceId string, code pb.KickReason, reason string) int {
+	kicked := 0
+	for _, c := range l.svcCtx.Manager.GetUserConnections(userId) {
+		if deviceId != "" && c.GetDeviceID() != deviceId {
+			continue
+		}
+		// The read loop fails on the closed conn and runs OnDisconnect again, which is a no-op
+		_ = c.CloseWithReason(KickCloseCodeBase+int(code), reason)
+		l.removeConn(c)
+		kicked++
 	}
+	return kicked
 }
@@ -15,11 +15,15 @@ import (
 
 type AuthMiddleware struct {
 	jwtManager *auth.JWTManager
+	sessions   *auth.SessionStore
+	status     *auth.StatusStore
 }
 
-func NewAuthMiddleware(jwtManager *auth.JWTManager) *AuthMiddleware {
+func NewAuthMiddleware(jwtManager *auth.JWTManager, sessions *auth.SessionStore, status *auth.StatusStore) *AuthMiddleware {
 	return &AuthMiddleware{
 		jwtManager: jwtManager,
+		sessions:   sessions,
+		status:     status,
 	}
 }
 
@@ -44,9 +48,26 @@ func (m *AuthMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
 			return
 		}
 
+		// A device that was signed out keeps a well-formed token until it expires
+		if err := m.sessions.Validate(r.Context(), claims); err != nil {
+			log.Printf("AuthMiddleware: Session check failed for user %d: %v", claims.UserID, err)
+			response.Unauthorized(w, "Session Revoked")
+			return
+		}
+
+		// Banning revokes the sessions as well; this covers a revocation that did not go through
+		if banned, err := m.status.Banned(r.Context(), claims.UserID); err != nil {
+			log.Printf("AuthMiddleware: Status check failed for user %d: %v", claims.UserID, err)
+		} else if banned {
+			response.Forbidden(w, "Account Banned")
+			return
+		}
+
 		// Unified key name: user_id
 		ctx := context.WithValue(r.Context(), "user_id", claims.UserID)
 		ctx = context.WithValue(ctx, "username", claims.Username)
+		ctx = context.WithValue(ctx, "device_id", claims.DeviceID)
+		ctx = context.WithValue(ctx, "platform", claims.Platform)
 
 		next(w, r.WithContext(ctx))
 	}
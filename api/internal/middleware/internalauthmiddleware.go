@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/archyhsh/gochat/pkg/auth"
+	"github.com/archyhsh/gochat/pkg/response"
+)
+
+// InternalAuthMiddleware only lets through requests signed by another gochat service.
+type InternalAuthMiddleware struct {
+	verifier *auth.Verifier
+}
+
+func NewInternalAuthMiddleware(verifier *auth.Verifier) *InternalAuthMiddleware {
+	return &InternalAuthMiddleware{
+		verifier: verifier,
+	}
+}
+
+func (m *InternalAuthMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := m.verifier.VerifyRequest(r); err != nil {
+			log.Printf("InternalAuthMiddleware: rejected %s %s from %s: %v", r.Method, r.URL.Path, r.RemoteAddr, err)
+			response.Unauthorized(w, "Invalid Internal Signature")
+			return
+		}
+		next(w, r)
+	}
+}
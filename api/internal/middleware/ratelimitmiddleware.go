@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/archyhsh/gochat/pkg/ratelimit"
+	"github.com/archyhsh/gochat/pkg/response"
+)
+
+// maxPeekBody bounds how much of a body is read to tell private from group sends
+const maxPeekBody = 64 << 10
+
+// RateLimitMiddleware rejects requests over their route's budget with 429 and Retry-After. It
+// runs after AuthMiddleware, so authenticated routes are limited per user and public ones per IP.
+type RateLimitMiddleware struct {
+	limiter *ratelimit.Limiter
+	trusted []*net.IPNet
+}
+
+// NewRateLimitMiddleware takes the proxies whose X-Forwarded-For is believed, as IPs or CIDRs.
+func NewRateLimitMiddleware(limiter *ratelimit.Limiter, trustedProxies []string) (*RateLimitMiddleware, error) {
+	trusted := make([]*net.IPNet, 0, len(trustedProxies))
+	for _, p := range trustedProxies {
+		if !strings.Contains(p, "/") {
+			if ip := net.ParseIP(p); ip != nil && ip.To4() != nil {
+				p += "/32"
+			} else {
+				p += "/128"
+			}
+		}
+		_, ipNet, err := net.ParseCIDR(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", p, err)
+		}
+		trusted = append(trusted, ipNet)
+	}
+	return &RateLimitMiddleware{
+		limiter: limiter,
+		trusted: trusted,
+	}, nil
+}
+
+func (m *RateLimitMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userId, _ := r.Context().Value("user_id").(int64)
+		ip := m.clientIP(r)
+		allowed, retryAfter := m.limiter.Allow(r.Context(), ratelimit.Request{
+			Method: r.Method,
+			Path:   r.URL.Path,
+			UserId: userId,
+			IP:     ip,
+			Kind:   func() string { return sendKind(r) },
+		})
+		if !allowed {
+			response.TooManyRequests(w, retryAfter)
+			return
+		}
+		// Handlers pass the address on, e.g. to the user service's sign-in attempt limiting, which
+		// must only ever see this resolved address and never a raw header
+		next(w, r.WithContext(context.WithValue(r.Context(), "client_ip", ip)))
+	}
+}
+
+// clientIP is the peer address, unless the peer is a trusted proxy: then it is the right-most
+// X-Forwarded-For hop that is not one. Proxies append to the header, so the hops left of the
+// last trusted one are whatever the client sent and are never believed.
+func (m *RateLimitMiddleware) clientIP(r *http.Request) string {
+	ip := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		ip = host
+	}
+	if !m.isTrusted(ip) {
+		return ip
+	}
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		ip = hop
+		if !m.isTrusted(hop) {
+			break
+		}
+	}
+	return ip
+}
+
+func (m *RateLimitMiddleware) isTrusted(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range m.trusted {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// sendKind tells a group send from a private one by its body, which is put back for the handler.
+func sendKind(r *http.Request) string {
+	if r.Body == nil {
+		return ratelimit.KindPrivate
+	}
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxPeekBody))
+	r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(data), r.Body))
+	if err != nil {
+		return ratelimit.KindPrivate
+	}
+
+	var req struct {
+		ConversationId string `json:"conversation_id"`
+		GroupId        int64  `json:"group_id"`
+	}
+	_ = json.Unmarshal(data, &req)
+	return ratelimit.MessageKind(req.ConversationId, req.GroupId)
+}
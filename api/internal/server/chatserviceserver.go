@@ -0,0 +1,32 @@
+package server
+
+import (
+	"context"
+
+	"github.com/archyhsh/gochat/api/internal/logic/push"
+	"github.com/archyhsh/gochat/api/internal/svc"
+	"github.com/archyhsh/gochat/rpc/pb"
+)
+
+// ChatServiceServer is the push endpoint the message service calls on every gateway.
+// Only PushBatch and KickUser are served; the other ChatService methods stay unimplemented.
+type ChatServiceServer struct {
+	svcCtx *svc.ServiceContext
+	pb.UnimplementedChatServiceServer
+}
+
+func NewChatServiceServer(svcCtx *svc.ServiceContext) *ChatServiceServer {
+	return &ChatServiceServer{
+		svcCtx: svcCtx,
+	}
+}
+
+func (s *ChatServiceServer) PushBatch(ctx context.Context, in *pb.PushBatchRequest) (*pb.PushBatchResponse, error) {
+	l := push.NewPushBatchLogic(ctx, s.svcCtx)
+	return l.PushBatch(in)
+}
+
+func (s *ChatServiceServer) KickUser(ctx context.Context, in *pb.KickRequest) (*pb.KickResponse, error) {
+	l := push.NewKickUserLogic(ctx, s.svcCtx)
+	return l.KickUser(in)
+}
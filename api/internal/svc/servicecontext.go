@@ -4,15 +4,22 @@
 package svc
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net"
 	"os"
-	"sync"
+	"time"
 
 	"github.com/archyhsh/gochat/api/internal/config"
 	"github.com/archyhsh/gochat/api/internal/middleware"
 	"github.com/archyhsh/gochat/pkg/auth"
 	"github.com/archyhsh/gochat/pkg/kafka"
+	"github.com/archyhsh/gochat/pkg/manager"
 	"github.com/archyhsh/gochat/pkg/messaging"
+	"github.com/archyhsh/gochat/pkg/moderation"
+	"github.com/archyhsh/gochat/pkg/presence"
+	"github.com/archyhsh/gochat/pkg/ratelimit"
 	"github.com/archyhsh/gochat/pkg/router"
 	"github.com/archyhsh/gochat/pkg/snowflake"
 	"github.com/archyhsh/gochat/rpc/group/groupservice"
@@ -25,20 +32,31 @@ import (
 )
 
 type ServiceContext struct {
-	Config         config.Config
-	AuthMiddleware rest.Middleware
-	JwtManager     *auth.JWTManager
-	UserRpc        userservice.UserService
-	GroupRpc       groupservice.GroupService
-	MessageRpc     messageservice.MessageService
-	RelationRpc    relationservice.RelationService
-	KafkaProducer  *messaging.ReliableProducer
-	Router         *router.Router
-	Conns          sync.Map
+	Config                 config.Config
+	AuthMiddleware         rest.Middleware
+	InternalAuthMiddleware rest.Middleware
+	RateLimitMiddleware    rest.Middleware
+	RateLimiter            *ratelimit.Limiter
+	InternalVerifier       *auth.Verifier
+	JwtManager             *auth.JWTManager
+	Sessions               *auth.SessionStore
+	Status                 *auth.StatusStore
+	UserRpc                userservice.UserService
+	GroupRpc               groupservice.GroupService
+	MessageRpc             messageservice.MessageService
+	RelationRpc            relationservice.RelationService
+	KafkaProducer          *messaging.ReliableProducer
+	Router                 *router.Router
+	Presence               *presence.Tracker
+	Moderator              *moderation.Chain
+	InternalAddr           string           // advertised address of the internal http server
+	PushRpcAddr            string           // advertised address of the push rpc server, empty when disabled
+	Manager                *manager.Manager // WebSocket connections on this gateway
+	ConnOptions            manager.ConnOptions
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
-	jwtManager := auth.NewJWTManager(c.JWT.JwtSecret, c.JWT.ExpireHours)
+	jwtManager := auth.NewJWTManagerWithKeys(c.JWT.JwtSecret, c.JWT.KeyConf, time.Duration(c.JWT.ExpireHours)*time.Hour)
 	_ = snowflake.Init(1)
 
 	rawProducer, err := kafka.NewProducer(c.Kafka.Brokers, c.Kafka.Topic)
@@ -61,16 +79,69 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	serverAddr := fmt.Sprintf("%s:%d", addr, c.Port)
 
 	rt := router.NewRouter(rdb, serverAddr)
+	sessions := auth.NewSessionStore(rdb)
+	userStatus := auth.NewStatusStore(rdb, c.BanCache)
+
+	// Internal routes and the push rpc only accept calls signed with the shared secret
+	internalVerifier := auth.NewVerifier(c.Internal.Secret, c.Internal.MaxSkew, auth.NewRedisNonceStore(rdb))
+	internalAddr := fmt.Sprintf("%s:%d", addr, c.Internal.Port)
+
+	var pushRpcAddr string
+	if c.PushRpc.ListenOn != "" {
+		if _, port, err := net.SplitHostPort(c.PushRpc.ListenOn); err == nil {
+			pushRpcAddr = net.JoinHostPort(addr, port)
+		}
+	}
+
+	limiter := ratelimit.NewLimiter(rdb, c.RateLimit.Rules)
+	rateLimitMiddleware, err := middleware.NewRateLimitMiddleware(limiter, c.TrustedProxies)
+	if err != nil {
+		panic("Failed to initialize rate limiting: " + err.Error())
+	}
+
+	moderator, err := moderation.NewChain(c.Moderation, moderation.NewReviewStore(rdb, ""))
+	if err != nil {
+		panic("Failed to initialize moderation: " + err.Error())
+	}
+	go moderator.Watch(context.Background(), c.Moderation.ReloadInterval)
+
+	presenceStore := presence.NewStore(rdb, c.Presence.StaleAfter)
+	tracker := presence.NewTracker(presenceStore, c.Presence.Debounce, func(ctx context.Context, userId int64, state string) error {
+		event := map[string]interface{}{
+			"type":      "presence_event",
+			"user_id":   userId,
+			"state":     state,
+			"timestamp": time.Now().Unix(),
+		}
+		data, _ := json.Marshal(event)
+		return producer.SendToTopic(ctx, c.Kafka.EventTopic, []byte(fmt.Sprintf("user_%d", userId)), data)
+	})
 
 	return &ServiceContext{
-		Config:         c,
-		AuthMiddleware: middleware.NewAuthMiddleware(jwtManager).Handle,
-		JwtManager:     jwtManager,
-		UserRpc:        userservice.NewUserService(zrpc.MustNewClient(c.UserRpc)),
-		GroupRpc:       groupservice.NewGroupService(zrpc.MustNewClient(c.GroupRpc)),
-		MessageRpc:     messageservice.NewMessageService(zrpc.MustNewClient(c.MessageRpc)),
-		RelationRpc:    relationservice.NewRelationService(zrpc.MustNewClient(c.RelationRpc)),
-		KafkaProducer:  producer,
-		Router:         rt,
+		Config:                 c,
+		AuthMiddleware:         middleware.NewAuthMiddleware(jwtManager, sessions, userStatus).Handle,
+		InternalAuthMiddleware: middleware.NewInternalAuthMiddleware(internalVerifier).Handle,
+		RateLimitMiddleware:    rateLimitMiddleware.Handle,
+		RateLimiter:            limiter,
+		InternalVerifier:       internalVerifier,
+		JwtManager:             jwtManager,
+		Sessions:               sessions,
+		Status:                 userStatus,
+		UserRpc:                userservice.NewUserService(zrpc.MustNewClient(c.UserRpc)),
+		GroupRpc:               groupservice.NewGroupService(zrpc.MustNewClient(c.GroupRpc)),
+		MessageRpc:             messageservice.NewMessageService(zrpc.MustNewClient(c.MessageRpc)),
+		RelationRpc:            relationservice.NewRelationService(zrpc.MustNewClient(c.RelationRpc)),
+		KafkaProducer:          producer,
+		Router:                 rt,
+		Presence:               tracker,
+		Moderator:              moderator,
+		Manager:                manager.NewManager(),
+		ConnOptions: manager.ConnOptions{
+			QueueSize:    c.Conn.SendQueue,
+			WriteTimeout: c.Conn.WriteTimeout,
+			Policy:       c.Conn.SlowConsumer,
+		},
+		InternalAddr: internalAddr,
+		PushRpcAddr:  pushRpcAddr,
 	}
 }
@@ -3,6 +3,10 @@
 
 package types
 
+type AckNotificationsRequest struct {
+	Ids []int64 `json:"ids"`
+}
+
 type ApplyInfo struct {
 	Id         int64  `json:"id"`
 	FromUserId int64  `json:"from_user_id"`
@@ -17,6 +21,15 @@ type ApplyListResponse struct {
 type ApplyRequest struct {
 	ToUserId int64  `json:"to_user_id"`
 	Message  string `json:"message,optional"`
+	Answer   string `json:"answer,optional"` // to the recipient's friend_question
+}
+
+type BatchPresenceRequest struct {
+	UserIds []int64 `json:"user_ids"`
+}
+
+type BatchPresenceResponse struct {
+	Presences []Presence `json:"presences"`
 }
 
 type BlockFriendRequest struct {
@@ -31,6 +44,16 @@ type CommonResponse struct {
 	Message string `json:"message"`
 }
 
+type ConfirmTotpRequest struct {
+	Code     string `json:"code"`
+	MfaToken string `json:"mfa_token,optional"` // when enrolling during sign-in
+}
+
+type ConfirmTotpResponse struct {
+	RecoveryCodes []string       `json:"recovery_codes"`  // shown once
+	Login         *LoginResponse `json:"login,omitempty"` // set when enrolling during sign-in
+}
+
 type Conversation struct {
 	ConversationId  string `json:"conversation_id"`
 	PeerId          int64  `json:"peer_id"`
@@ -51,6 +74,18 @@ type CreateGroupRequest struct {
 	Description string `json:"description,optional"`
 }
 
+type DeactivateAccountRequest struct {
+	Password string `json:"password"`
+}
+
+type DeleteAccountRequest struct {
+	Password string `json:"password"`
+}
+
+type DeleteAccountResponse struct {
+	DeleteAfter int64 `json:"delete_after"` // unix seconds; reactivating before cancels the deletion
+}
+
 type DeleteConversationRequest struct {
 	ConversationId string `json:"conversation_id"`
 }
@@ -59,6 +94,31 @@ type DeleteFriendRequest struct {
 	Id int64 `path:"id"`
 }
 
+type Device struct {
+	DeviceId     string `json:"device_id"`
+	Platform     string `json:"platform"`
+	HasPushToken bool   `json:"has_push_token"`
+	LastActiveAt int64  `json:"last_active_at"`
+	CreatedAt    int64  `json:"created_at"`
+	Current      bool   `json:"current"`
+}
+
+type DevicePresence struct {
+	DeviceId  string `json:"device_id"`
+	State     string `json:"state"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
+type DeviceListResponse struct {
+	Devices []Device `json:"devices"`
+}
+
+type DisableTotpRequest struct {
+	Password     string `json:"password"`
+	Code         string `json:"code,optional"`
+	RecoveryCode string `json:"recovery_code,optional"`
+}
+
 type DismissGroupRequest struct {
 	GroupId int64 `path:"id"`
 }
@@ -73,9 +133,18 @@ type DispatchResponse struct {
 	Data string `json:"data"` // JSON string of the real response
 }
 
+type EnrollTotpRequest struct {
+	MfaToken string `json:"mfa_token"`
+}
+
+type EnrollTotpResponse struct {
+	Secret     string `json:"secret"`      // base32, for manual entry
+	OtpauthUri string `json:"otpauth_uri"` // shown as a QR code
+}
+
 type ForgotPasswordRequest struct {
-	Username    string `json:"username"`
-	NewPassword string `json:"new_password"`
+	Username string `json:"username"`
+	Channel  string `json:"channel,optional,options=email|sms"` // defaults to the verified email, then phone
 }
 
 type FriendInfo struct {
@@ -119,6 +188,10 @@ type GetMessagesRequest struct {
 	LastSequence   int64  `form:"last_sequence,optional"`
 }
 
+type GetPresenceRequest struct {
+	UserId int64 `path:"id"`
+}
+
 type GetUserRequest struct {
 	Id int64 `path:"id"`
 }
@@ -183,14 +256,63 @@ type KickMemberRequest struct {
 	MemberId int64 `path:"member_id"`
 }
 
+type KickRequest struct {
+	UserId   int64  `json:"user_id"`
+	DeviceId string `json:"device_id,optional"`
+	Code     int32  `json:"code,optional"`
+	Reason   string `json:"reason,optional"`
+}
+
+type KickResponse struct {
+	Kicked int `json:"kicked"`
+}
+
+type ListNotificationsRequest struct {
+	AfterId int64 `form:"after_id,optional"`
+	Limit   int   `form:"limit,default=50"`
+}
+
+type LoginHistoryRequest struct {
+	BeforeId int64 `form:"before_id,optional"` // next page: the smallest id seen so far
+	Limit    int   `form:"limit,default=20"`
+}
+
+type LoginHistoryResponse struct {
+	Records []LoginRecord `json:"records"` // newest first
+}
+
+type LoginRecord struct {
+	Id        int64  `json:"id"`
+	DeviceId  string `json:"device_id"`
+	Platform  string `json:"platform"`
+	Ip        string `json:"ip"`
+	Success   bool   `json:"success"`
+	Reason    string `json:"reason,omitempty"` // bad_password, bad_mfa_code, locked_out, banned or deactivated
+	CreatedAt int64  `json:"created_at"`
+}
+
 type LoginRequest struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
+	DeviceId string `json:"device_id,optional"`                                // assigned by the server when empty
+	Platform string `json:"platform,optional,options=web|ios|android|desktop"` // defaults to web
 }
 
 type LoginResponse struct {
-	Token string `json:"token"`
-	User  User   `json:"user"`
+	Token        string `json:"token"`
+	User         User   `json:"user"`
+	DeviceId     string `json:"device_id"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"` // lifetime of token in seconds
+	// Set instead of the tokens when the account uses 2FA: send a code to /login/mfa with
+	// mfa_token, or set 2FA up through /login/mfa/enroll first when mfa_enrollment_required
+	MfaRequired           bool   `json:"mfa_required,omitempty"`
+	MfaToken              string `json:"mfa_token,omitempty"`
+	MfaEnrollmentRequired bool   `json:"mfa_enrollment_required,omitempty"`
+}
+
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token,optional"`
 }
 
 type Message struct {
@@ -206,6 +328,60 @@ type MessagesResponse struct {
 	Messages []Message `json:"messages"`
 }
 
+type Notification struct {
+	Id        int64   `json:"id"`
+	Message   Message `json:"message"`
+	Reason    string  `json:"reason"`
+	CreatedAt int64   `json:"created_at"`
+}
+
+type NotificationsResponse struct {
+	Notifications []Notification `json:"notifications"`
+	HasMore       bool           `json:"has_more"`
+}
+
+type NotifySetting struct {
+	DndEnabled bool   `json:"dnd_enabled"`
+	DndStart   int    `json:"dnd_start"` // minute of day
+	DndEnd     int    `json:"dnd_end"`   // minute of day, before dnd_start to span midnight
+	Timezone   string `json:"timezone,optional"`
+}
+
+type OidcCallbackRequest struct {
+	State string `json:"state"`
+	Code  string `json:"code"`
+}
+
+type OidcProvider struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+}
+
+type OidcProvidersResponse struct {
+	Providers []OidcProvider `json:"providers"`
+}
+
+type Presence struct {
+	UserId   int64            `json:"user_id"`
+	State    string           `json:"state"`     // online, away or offline
+	LastSeen int64            `json:"last_seen"` // 0 when hidden by the user
+	Devices  []DevicePresence `json:"devices"`   // only in your own presence
+}
+
+type PrivacySetting struct {
+	SearchableByUsername bool   `json:"searchable_by_username,default=true"`
+	SearchableByPhone    bool   `json:"searchable_by_phone,optional"` // exact number only
+	SearchableByEmail    bool   `json:"searchable_by_email,optional"` // exact address only
+	FriendRequest        string `json:"friend_request,default=anyone,options=anyone|friends_of_friends|nobody|question"`
+	FriendQuestion       string `json:"friend_question,optional"`                                      // asked when friend_request is question
+	FriendAnswer         string `json:"friend_answer,optional,omitempty"`                              // write only; empty keeps the current answer
+	GroupInvite          string `json:"group_invite,default=everyone,options=everyone|friends|nobody"` // who may add you to groups
+	Phone                string `json:"phone,default=friends,options=everyone|friends|nobody"`
+	Email                string `json:"email,default=friends,options=everyone|friends|nobody"`
+	Gender               string `json:"gender,default=everyone,options=everyone|friends|nobody"`
+	LastSeen             string `json:"last_seen,default=everyone,options=everyone|friends|nobody"`
+}
+
 type PushRequest struct {
 	UserIds           []int64         `json:"user_ids"`
 	ConversationId    string          `json:"conversation_id"`
@@ -229,12 +405,44 @@ type QuitGroupRequest struct {
 	GroupId int64 `path:"id"`
 }
 
+type ReactivateAccountRequest struct {
+	Username     string `json:"username"`
+	Password     string `json:"password"`
+	Code         string `json:"code,optional"`          // required when the account uses 2FA
+	RecoveryCode string `json:"recovery_code,optional"` // instead of code
+}
+
 type RegisterRequest struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
 	Nickname string `json:"nickname"`
 }
 
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type RefreshTokenResponse struct {
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"` // replaces the one sent, which is no longer valid
+	ExpiresIn    int64  `json:"expires_in"`
+}
+
+type RegisterDeviceRequest struct {
+	Platform  string `json:"platform,optional,options=web|ios|android|desktop"`
+	PushToken string `json:"push_token,optional"`
+}
+
+type RemoveDeviceRequest struct {
+	DeviceId string `path:"device_id"`
+}
+
+type ResetPasswordRequest struct {
+	Username    string `json:"username"`
+	Code        string `json:"code"`
+	NewPassword string `json:"new_password"`
+}
+
 type RestoreConversationRequest struct {
 	ConversationId string `json:"conversation_id"`
 }
@@ -265,6 +473,20 @@ type SendMessageResponse struct {
 	Timestamp int64  `json:"timestamp"`
 }
 
+type SendVerificationCodeRequest struct {
+	Channel string `json:"channel,options=email|sms"`
+}
+
+type StartOidcLoginRequest struct {
+	Provider string `path:"provider"`
+	DeviceId string `json:"device_id,optional"`
+	Platform string `json:"platform,optional,options=web|ios|android|desktop"`
+}
+
+type StartOidcLoginResponse struct {
+	AuthorizationUrl string `json:"authorization_url"` // send the browser here
+}
+
 type UnblockFriendRequest struct {
 	Id int64 `path:"id"`
 }
@@ -293,11 +515,29 @@ type UpdateUserRequest struct {
 }
 
 type User struct {
-	Id       int64  `json:"id"`
-	Username string `json:"username"`
-	Nickname string `json:"nickname"`
-	Avatar   string `json:"avatar"`
-	Phone    string `json:"phone"`
-	Email    string `json:"email"`
-	Gender   int    `json:"gender"`
+	Id            int64  `json:"id"`
+	Username      string `json:"username"`
+	Nickname      string `json:"nickname"`
+	Avatar        string `json:"avatar"`
+	Phone         string `json:"phone"`
+	Email         string `json:"email"`
+	Gender        int    `json:"gender"`
+	EmailVerified bool   `json:"email_verified,omitempty"` // only set for the current user
+	PhoneVerified bool   `json:"phone_verified,omitempty"`
+	MfaEnabled    bool   `json:"mfa_enabled,omitempty"`
+	// How to send a friend request, for other users: anyone, friends_of_friends, nobody or
+	// question, which friend_question asks
+	FriendRequest  string `json:"friend_request,omitempty"`
+	FriendQuestion string `json:"friend_question,omitempty"`
+}
+
+type VerifyContactRequest struct {
+	Channel string `json:"channel,options=email|sms"`
+	Code    string `json:"code"`
+}
+
+type VerifyMfaRequest struct {
+	MfaToken     string `json:"mfa_token"`
+	Code         string `json:"code,optional"`          // from the authenticator app
+	RecoveryCode string `json:"recovery_code,optional"` // instead of code
 }
@@ -9,4 +9,10 @@ kafka-topics --bootstrap-server kafka:9092 --create --if-not-exists --topic mess
 kafka-topics --bootstrap-server kafka:9092 --create --if-not-exists --topic user-topic --partitions 1 --replication-factor 1
 kafka-topics --bootstrap-server kafka:9092 --create --if-not-exists --topic group-topic --partitions 1 --replication-factor 1
 
+# Retry stages and dead-letter topic for message persistence (see Kafka.Retry in message.yaml)
+for i in 1 2 3; do
+  kafka-topics --bootstrap-server kafka:9092 --create --if-not-exists --topic message-topic-retry-$i --partitions 3 --replication-factor 1
+done
+kafka-topics --bootstrap-server kafka:9092 --create --if-not-exists --topic message-topic-dlt --partitions 1 --replication-factor 1
+
 echo "Topics created successfully."
@@ -14,6 +14,7 @@ CREATE TABLE IF NOT EXISTS `group` (
   `announcement` VARCHAR(1000) DEFAULT '',
   `status` TINYINT DEFAULT 1 COMMENT 'status: 0closed 1normal',
   `meta_version` BIGINT NOT NULL DEFAULT 0 COMMENT 'group name and announcement version',
+  `member_version` BIGINT NOT NULL DEFAULT 0 COMMENT 'group membership version',
   `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
   `updated_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
   PRIMARY KEY (`id`),
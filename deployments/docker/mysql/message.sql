@@ -14,6 +14,7 @@ CREATE TABLE IF NOT EXISTS `conversation` (
   `last_msg_type` TINYINT NOT NULL DEFAULT 0,
   `last_sender_id` BIGINT NOT NULL DEFAULT 0,
   `latest_seq` BIGINT NOT NULL DEFAULT 0 COMMENT 'latest msg sequence',
+  `seq_lease` BIGINT NOT NULL DEFAULT 0 COMMENT 'upper bound of sequences handed out by the redis allocator',
   `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
   PRIMARY KEY (`id`),
   UNIQUE KEY `uk_conversation_id` (`conversation_id`),
@@ -76,4 +77,19 @@ CREATE TABLE IF NOT EXISTS `message_read` (
   PRIMARY KEY (`id`),
   UNIQUE KEY `uk_msg_user` (`msg_id`, `user_id`),
   KEY `idx_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_notification` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT,
+  `user_id` BIGINT NOT NULL,
+  `msg_id` VARCHAR(64) NOT NULL,
+  `conversation_id` VARCHAR(64) NOT NULL DEFAULT '',
+  `sequence` BIGINT NOT NULL DEFAULT 0,
+  `payload` TEXT NOT NULL COMMENT 'JSON push event',
+  `reason` VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'why realtime delivery gave up',
+  `status` TINYINT NOT NULL DEFAULT 0 COMMENT 'status: 0pending 1delivered',
+  `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_user_msg` (`user_id`, `msg_id`),
+  KEY `idx_user_status` (`user_id`, `status`, `id`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
\ No newline at end of file
@@ -11,8 +11,10 @@ CREATE TABLE IF NOT EXISTS `user` (
   `avatar` VARCHAR(255) DEFAULT '' COMMENT 'avatar_URL',
   `phone` VARCHAR(20) DEFAULT '',
   `email` VARCHAR(100) DEFAULT '',
+  `email_verified` TINYINT NOT NULL DEFAULT 0 COMMENT '1 once the user proved they own email',
+  `phone_verified` TINYINT NOT NULL DEFAULT 0 COMMENT '1 once the user proved they own phone',
   `gender` TINYINT DEFAULT 0,
-  `status` TINYINT DEFAULT 1 COMMENT 'status: 0blacklisted 1normal',
+  `status` TINYINT DEFAULT 1 COMMENT 'status: 0blacklisted 1normal 2deactivated 3deleting',
   `info_version` BIGINT NOT NULL DEFAULT 0 COMMENT 'user info version',
   `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
   `updated_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
@@ -34,4 +36,102 @@ CREATE TABLE IF NOT EXISTS `user_device` (
   PRIMARY KEY (`id`),
   UNIQUE KEY `uk_user_device` (`user_id`, `device_id`),
   KEY `idx_user_id` (`user_id`)
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
\ No newline at end of file
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_notify_setting` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT,
+  `user_id` BIGINT NOT NULL,
+  `dnd_enabled` TINYINT NOT NULL DEFAULT 0,
+  `dnd_start` INT NOT NULL DEFAULT 0 COMMENT 'minute of day the quiet hours begin',
+  `dnd_end` INT NOT NULL DEFAULT 0 COMMENT 'minute of day the quiet hours end',
+  `timezone` VARCHAR(64) NOT NULL DEFAULT 'UTC' COMMENT 'IANA name, e.g. Asia/Shanghai',
+  `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+CREATE TABLE IF NOT EXISTS `user_privacy` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT,
+  `user_id` BIGINT NOT NULL,
+  `searchable_by_username` TINYINT NOT NULL DEFAULT 1 COMMENT 'found by username or nickname in user search',
+  `searchable_by_phone` TINYINT NOT NULL DEFAULT 0 COMMENT 'found by the exact phone number',
+  `searchable_by_email` TINYINT NOT NULL DEFAULT 0 COMMENT 'found by the exact email',
+  `friend_request` TINYINT NOT NULL DEFAULT 0 COMMENT 'who may send friend requests: 0anyone 1friends_of_friends 2nobody 3question',
+  `friend_question` VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'asked when friend_request is 3',
+  `friend_answer` VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'bcrypt hash of the normalized answer',
+  `group_invite` TINYINT NOT NULL DEFAULT 0 COMMENT 'who may add the user to groups: 0everyone 1friends 2nobody',
+  `phone_visibility` TINYINT NOT NULL DEFAULT 1 COMMENT 'who sees the phone: 0everyone 1friends 2nobody',
+  `email_visibility` TINYINT NOT NULL DEFAULT 1 COMMENT 'who sees the email: 0everyone 1friends 2nobody',
+  `gender_visibility` TINYINT NOT NULL DEFAULT 0 COMMENT 'who sees the gender: 0everyone 1friends 2nobody',
+  `last_seen_visibility` TINYINT NOT NULL DEFAULT 0 COMMENT 'who sees the last seen time: 0everyone 1friends 2nobody',
+  `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_login_history` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT,
+  `user_id` BIGINT NOT NULL,
+  `device_id` VARCHAR(100) NOT NULL DEFAULT '',
+  `platform` VARCHAR(20) NOT NULL DEFAULT '',
+  `ip` VARCHAR(64) NOT NULL DEFAULT '',
+  `success` TINYINT NOT NULL DEFAULT 0 COMMENT '1 signed in, 0 rejected',
+  `reason` VARCHAR(64) NOT NULL DEFAULT '' COMMENT 'why a sign-in was rejected',
+  `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `idx_user_id` (`user_id`, `id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_mfa` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT,
+  `user_id` BIGINT NOT NULL,
+  `secret` VARCHAR(64) NOT NULL COMMENT 'base32 TOTP secret',
+  `enabled` TINYINT NOT NULL DEFAULT 0 COMMENT '0 enrolment pending confirmation, 1 enabled',
+  `recovery_codes` TEXT NOT NULL COMMENT 'JSON array of sha256 hashes of the unused recovery codes',
+  `last_used_step` BIGINT NOT NULL DEFAULT 0 COMMENT 'TOTP time step of the last accepted code, which cannot be used again',
+  `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_identity` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT,
+  `user_id` BIGINT NOT NULL,
+  `provider` VARCHAR(50) NOT NULL COMMENT 'name of the configured identity provider',
+  `subject` VARCHAR(255) NOT NULL COMMENT 'sub claim, stable per user at the provider',
+  `email` VARCHAR(100) NOT NULL DEFAULT '' COMMENT 'email the provider reported at the last sign-in',
+  `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_provider_subject` (`provider`, `subject`),
+  KEY `idx_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_deletion` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT,
+  `user_id` BIGINT NOT NULL,
+  `status` TINYINT NOT NULL DEFAULT 0 COMMENT 'status: 0scheduled 1cleaning 2done 3cancelled',
+  `delete_after` TIMESTAMP NOT NULL COMMENT 'end of the grace period, until then the account can be reactivated',
+  `steps` VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'comma separated services that finished their cleanup',
+  `attempts` INT NOT NULL DEFAULT 0 COMMENT 'times the deletion was announced to the other services',
+  `next_attempt_at` TIMESTAMP NOT NULL COMMENT 'when the deletion is announced again unless every step finished',
+  `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_user_id` (`user_id`),
+  KEY `idx_status_next_attempt` (`status`, `next_attempt_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_ban` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT,
+  `user_id` BIGINT NOT NULL,
+  `reason` VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'told to the user when signing in is refused',
+  `operator_id` BIGINT NOT NULL DEFAULT 0 COMMENT 'admin who banned the user',
+  `expires_at` TIMESTAMP NULL DEFAULT NULL COMMENT 'end of a temporary ban, NULL for a permanent one',
+  `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -1,6 +1,8 @@
 package auth
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"time"
 
@@ -12,50 +14,113 @@ var (
 	ErrExpiredToken = errors.New("token has expired")
 )
 
-// Claims JWT claims
+// Claims JWT claims；每个 token 绑定一个设备，ID (jti) 用于按设备吊销，Version 低于用户当前版本的 token 全部失效
 type Claims struct {
 	UserID   int64  `json:"user_id"`
 	Username string `json:"username"`
+	DeviceID string `json:"device_id,omitempty"`
+	Platform string `json:"platform,omitempty"`
+	Version  int64  `json:"ver,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// SigningKey 签名密钥，Kid 写入 token 头部，轮换密钥时旧 token 仍可按 kid 校验
+type SigningKey struct {
+	Kid    string
+	Secret string
+}
+
+// KeyConf 密钥轮换配置：ActiveKid 用于签发，Keys 中的其他密钥只用于校验未过期的旧 token
+type KeyConf struct {
+	Keys      []SigningKey `json:",optional"`
+	ActiveKid string       `json:",optional"`
+}
+
 // JWTManager JWT 管理器
 type JWTManager struct {
-	secret     []byte
+	keys       map[string][]byte
+	activeKid  string
 	expireTime time.Duration
 }
 
-// NewJWTManager 创建 JWT 管理器
+// NewJWTManager 创建 JWT 管理器；secret 为不带 kid 的旧密钥
 func NewJWTManager(secret string, expireHours int) *JWTManager {
-	return &JWTManager{
-		secret:     []byte(secret),
-		expireTime: time.Duration(expireHours) * time.Hour,
+	return NewJWTManagerWithKeys(secret, KeyConf{}, time.Duration(expireHours)*time.Hour)
+}
+
+// NewJWTManagerWithKeys 创建支持密钥轮换的 JWT 管理器。secret 仍用于校验没有 kid 的旧 token，
+// 未配置 ActiveKid 时也用于签发
+func NewJWTManagerWithKeys(secret string, c KeyConf, expire time.Duration) *JWTManager {
+	m := &JWTManager{
+		keys:       make(map[string][]byte),
+		activeKid:  c.ActiveKid,
+		expireTime: expire,
+	}
+	if secret != "" {
+		m.keys[""] = []byte(secret)
 	}
+	for _, k := range c.Keys {
+		m.keys[k.Kid] = []byte(k.Secret)
+	}
+	if _, ok := m.keys[m.activeKid]; !ok {
+		m.activeKid = ""
+	}
+	return m
 }
 
-// GenerateToken 生成 JWT token
-func (m *JWTManager) GenerateToken(userID int64, username string) (string, error) {
-	now := time.Now()
-	claims := Claims{
+// ExpireTime token 有效期
+func (m *JWTManager) ExpireTime() time.Duration {
+	return m.expireTime
+}
+
+// GenerateToken 生成绑定设备的 JWT token，同时返回 token ID
+func (m *JWTManager) GenerateToken(userID int64, username, deviceID, platform string, version int64) (string, string, error) {
+	tokenID := NewTokenID()
+	token, err := m.sign(&Claims{
 		UserID:   userID,
 		Username: username,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(now.Add(m.expireTime)),
-			IssuedAt:  jwt.NewNumericDate(now),
-			NotBefore: jwt.NewNumericDate(now),
-			Issuer:    "gochat",
-		},
+		DeviceID: deviceID,
+		Platform: platform,
+		Version:  version,
+	}, tokenID)
+	return token, tokenID, err
+}
+
+func (m *JWTManager) sign(c *Claims, tokenID string) (string, error) {
+	now := time.Now()
+	claims := *c
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		ID:        tokenID,
+		ExpiresAt: jwt.NewNumericDate(now.Add(m.expireTime)),
+		IssuedAt:  jwt.NewNumericDate(now),
+		NotBefore: jwt.NewNumericDate(now),
+		Issuer:    "gochat",
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(m.secret)
+	if m.activeKid != "" {
+		token.Header["kid"] = m.activeKid
+	}
+	return token.SignedString(m.keys[m.activeKid])
 }
 
-// ParseToken 解析 JWT token
+// NewTokenID 生成随机 token ID，也用作未上报设备 ID 时的设备 ID
+func NewTokenID() string {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
+
+// ParseToken 解析 JWT token，按头部 kid 选择校验密钥
 func (m *JWTManager) ParseToken(tokenString string) (*Claims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		return m.secret, nil
-	})
+		kid, _ := token.Header["kid"].(string)
+		key, ok := m.keys[kid]
+		if !ok {
+			return nil, ErrInvalidToken
+		}
+		return key, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
@@ -70,18 +135,3 @@ func (m *JWTManager) ParseToken(tokenString string) (*Claims, error) {
 
 	return nil, ErrInvalidToken
 }
-
-// RefreshToken 刷新 token
-func (m *JWTManager) RefreshToken(tokenString string) (string, error) {
-	claims, err := m.ParseToken(tokenString)
-	if err != nil && !errors.Is(err, ErrExpiredToken) {
-		return "", err
-	}
-
-	// 如果 token 过期不超过 7 天，可以刷新
-	if claims != nil {
-		return m.GenerateToken(claims.UserID, claims.Username)
-	}
-
-	return "", ErrInvalidToken
-}
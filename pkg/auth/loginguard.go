@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	// LoginFailKeyPrefix counts recent failed sign-ins per account (lowercased username) and per IP
+	LoginFailKeyPrefix = "auth:login:fail:"
+	// LoginLockKeyPrefix is set while an account or IP has to wait before signing in again
+	LoginLockKeyPrefix = "auth:login:lock:"
+)
+
+// LoginGuardConf sets how failed sign-ins slow an account or IP down. After the free attempts
+// every failure doubles the wait, starting at BaseDelay; from LockoutAfter failures on, the
+// account or IP is locked out for Lockout.
+type LoginGuardConf struct {
+	Window         time.Duration `json:",default=15m"` // failures are forgotten this long after the last one
+	BaseDelay      time.Duration `json:",default=1s"`
+	Lockout        time.Duration `json:",default=15m"`
+	AccountFree    int           `json:",default=3"`
+	AccountLockout int           `json:",default=10"`
+	IPFree         int           `json:",default=20"`
+	IPLockout      int           `json:",default=100"`
+}
+
+// LoginGuard tracks failed sign-ins in Redis. Accounts are keyed by username whether or not it
+// exists, so a lockout does not tell which usernames are taken. IPs must be the address the
+// gateway resolved through its trusted proxies, or a client could pick a fresh one per attempt.
+type LoginGuard struct {
+	rdb *redis.Redis
+	c   LoginGuardConf
+}
+
+func NewLoginGuard(rdb *redis.Redis, c LoginGuardConf) *LoginGuard {
+	return &LoginGuard{rdb: rdb, c: c}
+}
+
+func accountScope(username string) string {
+	return "account:" + strings.ToLower(strings.TrimSpace(username))
+}
+
+func ipScope(ip string) string {
+	return "ip:" + ip
+}
+
+// Check returns how long the account or IP still has to wait before the next attempt.
+func (g *LoginGuard) Check(ctx context.Context, username, ip string) (time.Duration, error) {
+	var wait time.Duration
+	for _, scope := range g.scopes(username, ip) {
+		ttl, err := g.rdb.TtlCtx(ctx, LoginLockKeyPrefix+scope)
+		if err != nil {
+			return 0, err
+		}
+		if d := time.Duration(ttl) * time.Second; d > wait {
+			wait = d
+		}
+	}
+	return wait, nil
+}
+
+// Fail records a failed attempt and returns how long the account or IP now has to wait.
+func (g *LoginGuard) Fail(ctx context.Context, username, ip string) (time.Duration, error) {
+	var wait time.Duration
+	for i, scope := range g.scopes(username, ip) {
+		failKey := LoginFailKeyPrefix + scope
+		n, err := g.rdb.IncrCtx(ctx, failKey)
+		if err != nil {
+			return 0, err
+		}
+		if err := g.rdb.ExpireCtx(ctx, failKey, int(g.c.Window.Seconds())); err != nil {
+			return 0, err
+		}
+
+		free, lockout := g.c.AccountFree, g.c.AccountLockout
+		if i == 1 {
+			free, lockout = g.c.IPFree, g.c.IPLockout
+		}
+		d := g.delay(int(n), free, lockout)
+		if d <= 0 {
+			continue
+		}
+		if err := g.rdb.SetexCtx(ctx, LoginLockKeyPrefix+scope, "1", int((d+time.Second-1)/time.Second)); err != nil {
+			return 0, err
+		}
+		if d > wait {
+			wait = d
+		}
+	}
+	return wait, nil
+}
+
+// Succeed forgets the account's failures. Those of the IP are kept, since one IP guessing many
+// accounts may well get some right.
+func (g *LoginGuard) Succeed(ctx context.Context, username string) error {
+	scope := accountScope(username)
+	_, err := g.rdb.DelCtx(ctx, LoginFailKeyPrefix+scope, LoginLockKeyPrefix+scope)
+	return err
+}
+
+func (g *LoginGuard) scopes(username, ip string) []string {
+	scopes := []string{accountScope(username)}
+	if ip != "" {
+		scopes = append(scopes, ipScope(ip))
+	}
+	return scopes
+}
+
+func (g *LoginGuard) delay(failures, free, lockout int) time.Duration {
+	if failures >= lockout {
+		return g.c.Lockout
+	}
+	if failures <= free {
+		return 0
+	}
+	d := g.c.BaseDelay
+	for i := free + 1; i < failures && d < g.c.Lockout; i++ {
+		d *= 2
+	}
+	return min(d, g.c.Lockout)
+}
@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// MFAChallengeKeyPrefix holds the sign-ins waiting for their second factor, by token hash
+const MFAChallengeKeyPrefix = "auth:mfa:pending:"
+
+var ErrInvalidMFAToken = errors.New("invalid or expired mfa token")
+
+// MFAChallenge is a sign-in that passed the password check and waits for a TOTP or recovery
+// code. Enroll is set when the account must enrol in 2FA before it can finish signing in.
+type MFAChallenge struct {
+	UserID   int64  `json:"user_id"`
+	Username string `json:"username"`
+	DeviceID string `json:"device_id"`
+	Platform int32  `json:"platform"`
+	IP       string `json:"ip"`
+	Enroll   bool   `json:"enroll,omitempty"`
+}
+
+func mfaChallengeKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return MFAChallengeKeyPrefix + hex.EncodeToString(sum[:])
+}
+
+// CreateMFAChallenge stores the challenge and returns the short-lived token the client presents
+// with the code. It is not a JWT and grants nothing else.
+func (s *SessionStore) CreateMFAChallenge(ctx context.Context, c *MFAChallenge, ttl time.Duration) (string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	token := NewTokenID() + NewTokenID()
+	key := mfaChallengeKey(token)
+	if err := s.rdb.HmsetCtx(ctx, key, map[string]string{"challenge": string(data), "attempts": "0"}); err != nil {
+		return "", err
+	}
+	if err := s.rdb.ExpireCtx(ctx, key, int(ttl.Seconds())); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// attemptMFAScript counts an attempt on the challenge and drops it once attempts run out
+const attemptMFAScript = `
+	local challenge = redis.call("hget", KEYS[1], "challenge")
+	if not challenge then
+		return false
+	end
+	local attempts = redis.call("hincrby", KEYS[1], "attempts", 1)
+	if attempts > tonumber(ARGV[1]) then
+		redis.call("del", KEYS[1])
+		return false
+	end
+	return challenge
+`
+
+// AttemptMFAChallenge returns the challenge for a code check, counting the attempt; a token
+// is good for maxAttempts checks.
+func (s *SessionStore) AttemptMFAChallenge(ctx context.Context, token string, maxAttempts int) (*MFAChallenge, error) {
+	if token == "" {
+		return nil, ErrInvalidMFAToken
+	}
+	res, err := s.rdb.EvalCtx(ctx, attemptMFAScript, []string{mfaChallengeKey(token)}, maxAttempts)
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrInvalidMFAToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	data, _ := res.(string)
+	if data == "" {
+		return nil, ErrInvalidMFAToken
+	}
+	var c MFAChallenge
+	if err := json.Unmarshal([]byte(data), &c); err != nil {
+		return nil, ErrInvalidMFAToken
+	}
+	return &c, nil
+}
+
+// PeekMFAChallenge returns the challenge without counting an attempt.
+func (s *SessionStore) PeekMFAChallenge(ctx context.Context, token string) (*MFAChallenge, error) {
+	if token == "" {
+		return nil, ErrInvalidMFAToken
+	}
+	data, err := s.rdb.HgetCtx(ctx, mfaChallengeKey(token), "challenge")
+	if err != nil || data == "" {
+		return nil, ErrInvalidMFAToken
+	}
+	var c MFAChallenge
+	if err := json.Unmarshal([]byte(data), &c); err != nil {
+		return nil, ErrInvalidMFAToken
+	}
+	return &c, nil
+}
+
+// CompleteMFAChallenge consumes the token once the second factor was accepted.
+func (s *SessionStore) CompleteMFAChallenge(ctx context.Context, token string) error {
+	_, err := s.rdb.DelCtx(ctx, mfaChallengeKey(token))
+	return err
+}
+
+// NewRecoveryCodes returns n one-time recovery codes like "k3f9-x2pq".
+func NewRecoveryCodes(n int) ([]string, error) {
+	const alphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+	codes := make([]string, n)
+	buf := make([]byte, 8)
+	for i := range codes {
+		for j := range buf {
+			k, err := rand.Int(rand.Reader, big.NewInt(int64(len(alphabet))))
+			if err != nil {
+				return nil, err
+			}
+			buf[j] = alphabet[k.Int64()]
+		}
+		codes[i] = string(buf[:4]) + "-" + string(buf[4:])
+	}
+	return codes, nil
+}
+
+// HashRecoveryCode normalizes a recovery code as typed and hashes it for storage.
+func HashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), " ", ""))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
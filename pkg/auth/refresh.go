@@ -0,0 +1,148 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// RefreshFamilyKeyPrefix holds one hash per user: device_id -> hash of the device's current
+	// refresh token. Every refresh replaces it, so the device's tokens form one rotating family
+	RefreshFamilyKeyPrefix = "auth:refresh:"
+	// RefreshTokenKeyPrefix maps the hash of every refresh token issued to "<user>|<device>|<platform>";
+	// rotated tokens stay until they expire, so presenting one again is recognized as reuse
+	RefreshTokenKeyPrefix = "auth:refresh:token:"
+)
+
+var (
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	// ErrRefreshTokenReused means a rotated refresh token was presented again, so it was stolen or
+	// replayed; the device's session has been revoked
+	ErrRefreshTokenReused = errors.New("refresh token reused")
+)
+
+// RefreshSession is the device a refresh token was issued to.
+type RefreshSession struct {
+	UserID   int64
+	DeviceID string
+	Platform string
+}
+
+func refreshFamilyKey(userId int64) string {
+	return RefreshFamilyKeyPrefix + strconv.FormatInt(userId, 10)
+}
+
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// issueScript stores a refresh token and makes it the current one of its device
+const issueScript = `
+	redis.call("set", KEYS[1], ARGV[3], "EX", ARGV[4])
+	redis.call("hset", KEYS[2], ARGV[1], ARGV[2])
+	redis.call("expire", KEYS[2], ARGV[4])
+	return 1
+`
+
+// IssueRefresh starts a new refresh token family for the device, replacing any previous one.
+func (s *SessionStore) IssueRefresh(ctx context.Context, sess *RefreshSession, ttl time.Duration) (string, error) {
+	token := NewTokenID() + NewTokenID()
+	hash := hashRefreshToken(token)
+	record := fmt.Sprintf("%d|%s|%s", sess.UserID, sess.DeviceID, sess.Platform)
+	_, err := s.rdb.EvalCtx(ctx, issueScript, []string{RefreshTokenKeyPrefix + hash, refreshFamilyKey(sess.UserID)},
+		sess.DeviceID, hash, record, int(ttl.Seconds()))
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// RefreshOwner returns the device a refresh token was issued to, whether or not it is still current.
+func (s *SessionStore) RefreshOwner(ctx context.Context, token string) (*RefreshSession, error) {
+	if token == "" {
+		return nil, ErrInvalidRefreshToken
+	}
+	record, err := s.rdb.GetCtx(ctx, RefreshTokenKeyPrefix+hashRefreshToken(token))
+	if err != nil {
+		return nil, err
+	}
+	sess := parseRefreshRecord(record)
+	if sess == nil {
+		return nil, ErrInvalidRefreshToken
+	}
+	return sess, nil
+}
+
+func parseRefreshRecord(record string) *RefreshSession {
+	parts := strings.SplitN(record, "|", 3)
+	if len(parts) != 3 {
+		return nil
+	}
+	uid, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &RefreshSession{UserID: uid, DeviceID: parts[1], Platform: parts[2]}
+}
+
+// rotateScript replaces the device's current refresh token with a new one. A token that is not
+// the current one of its device was either rotated already (reuse: the whole session is revoked)
+// or signed out.
+const rotateScript = `
+	local record = redis.call("get", KEYS[1])
+	if not record then
+		return {"invalid", ""}
+	end
+	local uid, device = string.match(record, "^(%d+)|([^|]*)|")
+	local family = ARGV[4] .. uid
+	local current = redis.call("hget", family, device)
+	if current ~= ARGV[1] then
+		if current then
+			redis.call("hdel", family, device)
+			redis.call("hdel", ARGV[5] .. uid, device)
+			return {"reused", record}
+		end
+		return {"invalid", record}
+	end
+	redis.call("hset", family, device, ARGV[2])
+	redis.call("expire", family, ARGV[3])
+	redis.call("set", KEYS[2], record, "EX", ARGV[3])
+	return {"ok", record}
+`
+
+// Rotate exchanges a refresh token for a new one of the same family. The caller must issue a new
+// access token and Bind it to the returned device.
+func (s *SessionStore) Rotate(ctx context.Context, token string, ttl time.Duration) (*RefreshSession, string, error) {
+	if token == "" {
+		return nil, "", ErrInvalidRefreshToken
+	}
+	next := NewTokenID() + NewTokenID()
+	oldHash, newHash := hashRefreshToken(token), hashRefreshToken(next)
+	res, err := s.rdb.EvalCtx(ctx, rotateScript, []string{RefreshTokenKeyPrefix + oldHash, RefreshTokenKeyPrefix + newHash},
+		oldHash, newHash, int(ttl.Seconds()), RefreshFamilyKeyPrefix, SessionKeyPrefix)
+	if err != nil {
+		return nil, "", err
+	}
+	vals, _ := res.([]interface{})
+	if len(vals) != 2 {
+		return nil, "", ErrInvalidRefreshToken
+	}
+	result, _ := vals[0].(string)
+	record, _ := vals[1].(string)
+
+	sess := parseRefreshRecord(record)
+	switch result {
+	case "ok":
+		return sess, next, nil
+	case "reused":
+		return sess, "", ErrRefreshTokenReused
+	}
+	return nil, "", ErrInvalidRefreshToken
+}
@@ -0,0 +1,168 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	// SessionKeyPrefix holds one hash per user: device_id -> id of the token issued to that device
+	SessionKeyPrefix = "auth:session:"
+	// DeviceActiveKeyPrefix holds one hash per user: device_id -> unix time the device was last seen
+	DeviceActiveKeyPrefix = "auth:device:active:"
+	// TokenVersionKeyPrefix holds the user's token version; tokens issued with a lower one are revoked
+	TokenVersionKeyPrefix = "auth:token_version:"
+)
+
+var ErrSessionRevoked = errors.New("session revoked")
+
+// SessionStore tracks the token each device of a user currently holds. A token is only accepted
+// while it is the one bound to its device, so signing a device out, or signing in on it again,
+// revokes whatever was issued to it before.
+type SessionStore struct {
+	rdb *redis.Redis
+}
+
+func NewSessionStore(rdb *redis.Redis) *SessionStore {
+	return &SessionStore{rdb: rdb}
+}
+
+func sessionKey(userId int64) string {
+	return SessionKeyPrefix + strconv.FormatInt(userId, 10)
+}
+
+func activeKey(userId int64) string {
+	return DeviceActiveKeyPrefix + strconv.FormatInt(userId, 10)
+}
+
+func versionKey(userId int64) string {
+	return TokenVersionKeyPrefix + strconv.FormatInt(userId, 10)
+}
+
+// bindScript swaps the device's token and returns the one it replaces
+const bindScript = `
+	local prev = redis.call("hget", KEYS[1], ARGV[1])
+	redis.call("hset", KEYS[1], ARGV[1], ARGV[2])
+	redis.call("expire", KEYS[1], ARGV[3])
+	return prev
+`
+
+// Bind makes tokenId the only accepted token of the device and reports whether it replaced a
+// live one. The hash lives as long as the newest token in it.
+func (s *SessionStore) Bind(ctx context.Context, userId int64, deviceId, tokenId string, ttl time.Duration) (bool, error) {
+	prev, err := s.rdb.EvalCtx(ctx, bindScript, []string{sessionKey(userId)}, deviceId, tokenId, int(ttl.Seconds()))
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	replaced, _ := prev.(string)
+	return replaced != "" && replaced != tokenId, nil
+}
+
+// validateScript returns the token bound to the device and the user's token version
+const validateScript = `
+	local tokenId = redis.call("hget", KEYS[1], ARGV[1]) or ""
+	local version = redis.call("get", KEYS[2]) or "0"
+	return {tokenId, version}
+`
+
+// Validate checks that the token is still the one bound to its device and was issued at the
+// user's current token version.
+func (s *SessionStore) Validate(ctx context.Context, claims *Claims) error {
+	return s.validate(ctx, claims, true)
+}
+
+// ValidateDevice checks that the token's device is still signed in at the user's current token
+// version, whichever token it holds now. It suits connections authenticated once, which outlive
+// the short-lived token they were opened with as the client refreshes it.
+func (s *SessionStore) ValidateDevice(ctx context.Context, claims *Claims) error {
+	return s.validate(ctx, claims, false)
+}
+
+func (s *SessionStore) validate(ctx context.Context, claims *Claims, exact bool) error {
+	if claims.DeviceID == "" || claims.ID == "" {
+		// Issued before tokens were bound to devices; such a token could never be revoked
+		return ErrSessionRevoked
+	}
+	res, err := s.rdb.EvalCtx(ctx, validateScript, []string{sessionKey(claims.UserID), versionKey(claims.UserID)}, claims.DeviceID)
+	if err != nil {
+		return err
+	}
+	vals, _ := res.([]interface{})
+	if len(vals) != 2 {
+		return ErrSessionRevoked
+	}
+	tokenId, _ := vals[0].(string)
+	version, _ := vals[1].(string)
+	if tokenId == "" || exact && tokenId != claims.ID {
+		return ErrSessionRevoked
+	}
+	if v, _ := strconv.ParseInt(version, 10, 64); claims.Version < v {
+		return ErrSessionRevoked
+	}
+	return nil
+}
+
+// Version returns the user's current token version, which new tokens are issued with.
+func (s *SessionStore) Version(ctx context.Context, userId int64) (int64, error) {
+	val, err := s.rdb.GetCtx(ctx, versionKey(userId))
+	if err != nil || val == "" {
+		return 0, err
+	}
+	return strconv.ParseInt(val, 10, 64)
+}
+
+// Revoke signs the listed devices out, refresh tokens included.
+func (s *SessionStore) Revoke(ctx context.Context, userId int64, deviceIds ...string) error {
+	if len(deviceIds) == 0 {
+		return nil
+	}
+	if _, err := s.rdb.HdelCtx(ctx, sessionKey(userId), deviceIds...); err != nil {
+		return err
+	}
+	if _, err := s.rdb.HdelCtx(ctx, refreshFamilyKey(userId), deviceIds...); err != nil {
+		return err
+	}
+	_, err := s.rdb.HdelCtx(ctx, activeKey(userId), deviceIds...)
+	return err
+}
+
+// RevokeAll signs every device of the user out and bumps the token version, so no token issued
+// so far is accepted again.
+func (s *SessionStore) RevokeAll(ctx context.Context, userId int64) error {
+	if _, err := s.rdb.IncrCtx(ctx, versionKey(userId)); err != nil {
+		return err
+	}
+	_, err := s.rdb.DelCtx(ctx, sessionKey(userId), refreshFamilyKey(userId))
+	return err
+}
+
+// Touch records that the device was active just now.
+func (s *SessionStore) Touch(ctx context.Context, userId int64, deviceId string) error {
+	key := activeKey(userId)
+	if err := s.rdb.HsetCtx(ctx, key, deviceId, strconv.FormatInt(time.Now().Unix(), 10)); err != nil {
+		return err
+	}
+	return s.rdb.ExpireCtx(ctx, key, int((30 * 24 * time.Hour).Seconds()))
+}
+
+// LastActive returns the unix time each device of the user was last seen on a gateway.
+func (s *SessionStore) LastActive(ctx context.Context, userId int64) (map[string]int64, error) {
+	vals, err := s.rdb.HgetallCtx(ctx, activeKey(userId))
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]int64, len(vals))
+	for deviceId, v := range vals {
+		if ts, err := strconv.ParseInt(v, 10, 64); err == nil {
+			res[deviceId] = ts
+		}
+	}
+	return res, nil
+}
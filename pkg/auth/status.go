@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/collection"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// BanKeyPrefix is set while a user is banned, holding the reason; a temporary ban expires with it
+const BanKeyPrefix = "auth:ban:"
+
+// StatusStore shares which users are banned with the gateways. The user service keeps it in line
+// with the user table; gateways check it on every request, so they remember an answer for a
+// short while instead of asking Redis each time.
+type StatusStore struct {
+	rdb   *redis.Redis
+	cache *collection.Cache
+}
+
+// NewStatusStore returns a store that remembers answers for cacheTTL, or asks Redis every time
+// when cacheTTL is zero.
+func NewStatusStore(rdb *redis.Redis, cacheTTL time.Duration) *StatusStore {
+	s := &StatusStore{rdb: rdb}
+	if cacheTTL > 0 {
+		// Only fails for a non-positive expiry
+		s.cache, _ = collection.NewCache(cacheTTL, collection.WithName("user-status"))
+	}
+	return s
+}
+
+func banKey(userId int64) string {
+	return BanKeyPrefix + strconv.FormatInt(userId, 10)
+}
+
+// Ban marks the user as banned until the given time, or for good when it is zero.
+func (s *StatusStore) Ban(ctx context.Context, userId int64, reason string, until time.Time) error {
+	if until.IsZero() {
+		return s.rdb.SetCtx(ctx, banKey(userId), reason)
+	}
+	ttl := int(time.Until(until).Seconds())
+	if ttl <= 0 {
+		return s.Lift(ctx, userId)
+	}
+	return s.rdb.SetexCtx(ctx, banKey(userId), reason, ttl)
+}
+
+// Lift clears the user's ban.
+func (s *StatusStore) Lift(ctx context.Context, userId int64) error {
+	_, err := s.rdb.DelCtx(ctx, banKey(userId))
+	if s.cache != nil {
+		s.cache.Del(banKey(userId))
+	}
+	return err
+}
+
+// Banned reports whether the user is banned.
+func (s *StatusStore) Banned(ctx context.Context, userId int64) (bool, error) {
+	if s.cache == nil {
+		return s.rdb.ExistsCtx(ctx, banKey(userId))
+	}
+	val, err := s.cache.Take(banKey(userId), func() (any, error) {
+		return s.rdb.ExistsCtx(ctx, banKey(userId))
+	})
+	if err != nil {
+		return false, err
+	}
+	banned, _ := val.(bool)
+	return banned, nil
+}
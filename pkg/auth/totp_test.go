@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"testing"
+	"time"
+)
+
+// rfc6238Secret is the SHA-1 seed of RFC 6238 Appendix B, "12345678901234567890", in base32
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTPRFC6238Vectors(t *testing.T) {
+	// Appendix B SHA-1 codes, truncated to their last 6 digits
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, tt := range tests {
+		got, err := TOTP(rfc6238Secret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Fatalf("TOTP(%d): %v", tt.unix, err)
+		}
+		if got != tt.code {
+			t.Errorf("TOTP(%d) = %s, want %s", tt.unix, got, tt.code)
+		}
+	}
+}
+
+func TestHOTPAcceptsLooseSecret(t *testing.T) {
+	want, _ := HOTP(rfc6238Secret, 1)
+	got, err := HOTP("gezd gnbv gy3t qojq gezd gnbv gy3t qojq", 1)
+	if err != nil {
+		t.Fatalf("HOTP: %v", err)
+	}
+	if got != want {
+		t.Errorf("HOTP with spaced lowercase secret = %s, want %s", got, want)
+	}
+	if _, err := HOTP("not base32!", 1); err == nil {
+		t.Error("HOTP accepted an invalid secret")
+	}
+}
+
+func TestValidateTOTPSkew(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	step := TOTPStep(now)
+	code := func(s int64) string {
+		c, err := HOTP(rfc6238Secret, s)
+		if err != nil {
+			t.Fatalf("HOTP: %v", err)
+		}
+		return c
+	}
+
+	tests := []struct {
+		name string
+		step int64
+		skew int
+		ok   bool
+	}{
+		{"current step", step, 0, true},
+		{"previous step without skew", step - 1, 0, false},
+		{"previous step within skew", step - 1, 1, true},
+		{"next step within skew", step + 1, 1, true},
+		{"two steps back beyond skew", step - 2, 1, false},
+		{"two steps ahead beyond skew", step + 2, 1, false},
+	}
+	for _, tt := range tests {
+		got, ok := ValidateTOTP(rfc6238Secret, code(tt.step), now, tt.skew)
+		if ok != tt.ok {
+			t.Errorf("%s: ok = %v, want %v", tt.name, ok, tt.ok)
+			continue
+		}
+		if ok && got != tt.step {
+			t.Errorf("%s: matched step %d, want %d", tt.name, got, tt.step)
+		}
+	}
+
+	if _, ok := ValidateTOTP(rfc6238Secret, "12345", now, 1); ok {
+		t.Error("accepted a code of the wrong length")
+	}
+	if _, ok := ValidateTOTP(rfc6238Secret, code(step)[:3]+" "+code(step)[3:], now, 0); !ok {
+		t.Error("rejected a code typed with a space")
+	}
+}
+
+func TestValidateTOTPReplayMatchesSameStep(t *testing.T) {
+	// A replayed code, even later within the skew, matches the step it was first accepted for,
+	// which the caller then refuses to use twice
+	now := time.Unix(1234567890, 0)
+	code, _ := TOTP(rfc6238Secret, now)
+	first, ok := ValidateTOTP(rfc6238Secret, code, now, 1)
+	if !ok {
+		t.Fatal("code rejected")
+	}
+	again, ok := ValidateTOTP(rfc6238Secret, code, now.Add(TOTPPeriod), 1)
+	if !ok || again != first {
+		t.Errorf("replay matched step %d (ok %v), want %d", again, ok, first)
+	}
+}
@@ -0,0 +1,98 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/archyhsh/gochat/pkg/auth"
+	"github.com/archyhsh/gochat/pkg/router"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// Kicker closes a user's connections on every gateway they are connected to.
+type Kicker struct {
+	router *router.Router
+	pool   *Pool
+	signer *auth.Signer
+	client *http.Client
+}
+
+// NewKicker returns a kicker calling the gateways' push rpc, or their signed internal HTTP route
+// on gateways that serve no push rpc.
+func NewKicker(rt *router.Router, pool *Pool, signer *auth.Signer) *Kicker {
+	return &Kicker{
+		router: rt,
+		pool:   pool,
+		signer: signer,
+		client: &http.Client{Timeout: pool.timeout},
+	}
+}
+
+// Kick sends req to each gateway holding a route of the user. Every gateway is tried; the
+// first failure is returned.
+func (k *Kicker) Kick(ctx context.Context, req *pb.KickRequest) error {
+	gateways, err := k.router.FindGateways(ctx, req.UserId)
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+	for _, gwAddr := range gateways {
+		rpcAddr, err := k.router.FindRpcAddr(ctx, gwAddr)
+		if err == nil {
+			if rpcAddr != "" {
+				err = k.pool.KickUser(ctx, rpcAddr, req)
+			} else {
+				err = k.kickHttp(ctx, gwAddr, req)
+			}
+		}
+		if err != nil {
+			logx.WithContext(ctx).Errorf("[Kicker] failed to kick user %d on %s: %v", req.UserId, gwAddr, err)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+// kickHttp calls POST /internal/kick on the internal listener the gateway advertises.
+func (k *Kicker) kickHttp(ctx context.Context, gwAddr string, req *pb.KickRequest) error {
+	internalAddr, err := k.router.FindInternalAddr(ctx, gwAddr)
+	if err != nil {
+		return err
+	}
+	if internalAddr == "" {
+		return fmt.Errorf("no push rpc or internal address for gateway %s", gwAddr)
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"user_id":   req.UserId,
+		"device_id": req.DeviceId,
+		"code":      int32(req.Code),
+		"reason":    req.Reason,
+	})
+	if err != nil {
+		return err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("http://%s/internal/kick", internalAddr), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	k.signer.SignRequest(httpReq, body)
+
+	resp, err := k.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("internal kick on %s: status %d", internalAddr, resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package gateway
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/zrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const DefaultTimeout = 2 * time.Second
+
+// Pool keeps one ChatService client per gateway rpc address. Clients are created lazily and
+// dropped once the gateway becomes unreachable, so a restarted gateway gets a fresh connection.
+// The zrpc client middlewares propagate the trace context of every call.
+type Pool struct {
+	timeout time.Duration
+	opts    []zrpc.ClientOption
+	mu      sync.Mutex
+	clients map[string]zrpc.Client
+}
+
+func NewPool(timeout time.Duration, opts ...zrpc.ClientOption) *Pool {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Pool{
+		timeout: timeout,
+		opts:    opts,
+		clients: make(map[string]zrpc.Client),
+	}
+}
+
+func (p *Pool) client(addr string) (zrpc.Client, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if cli, ok := p.clients[addr]; ok {
+		return cli, nil
+	}
+	var c zrpc.RpcClientConf
+	if err := conf.FillDefault(&c); err != nil {
+		return nil, err
+	}
+	c.Endpoints = []string{addr}
+	c.Timeout = p.timeout.Milliseconds()
+	cli, err := zrpc.NewClient(c, p.opts...)
+	if err != nil {
+		return nil, err
+	}
+	p.clients[addr] = cli
+	return cli, nil
+}
+
+func (p *Pool) remove(addr string, cli zrpc.Client) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if cur, ok := p.clients[addr]; ok && cur == cli {
+		delete(p.clients, addr)
+		_ = cli.Conn().Close()
+	}
+}
+
+// PushBatch delivers an event to the users connected to the gateway listening on addr.
+func (p *Pool) PushBatch(ctx context.Context, addr string, req *pb.PushBatchRequest) error {
+	return p.call(ctx, addr, func(ctx context.Context, cli pb.ChatServiceClient) error {
+		_, err := cli.PushBatch(ctx, req)
+		return err
+	})
+}
+
+// KickUser closes the connections of a user, or of one of their devices, on the gateway at addr.
+func (p *Pool) KickUser(ctx context.Context, addr string, req *pb.KickRequest) error {
+	return p.call(ctx, addr, func(ctx context.Context, cli pb.ChatServiceClient) error {
+		_, err := cli.KickUser(ctx, req)
+		return err
+	})
+}
+
+func (p *Pool) call(ctx context.Context, addr string, fn func(context.Context, pb.ChatServiceClient) error) error {
+	cli, err := p.client(addr)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	err = fn(ctx, pb.NewChatServiceClient(cli.Conn()))
+	if status.Code(err) == codes.Unavailable {
+		p.remove(addr, cli)
+	}
+	return err
+}
+
+// Close releases every pooled connection.
+func (p *Pool) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for addr, cli := range p.clients {
+		_ = cli.Conn().Close()
+		delete(p.clients, addr)
+	}
+}
@@ -94,7 +94,16 @@ func (p *Producer) SafeSendToTopic(ctx context.Context, topic string, key, value
 	var headers []sarama.RecordHeader
 	carrier := &ProducerHeaderCarrier{Headers: &headers}
 	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	return p.send(topic, key, value, headers)
+}
+
+// SendWithHeaders sends a record carrying the given headers as is, e.g. when re-publishing a
+// consumed message whose headers already hold the tracing context.
+func (p *Producer) SendWithHeaders(ctx context.Context, topic string, key, value []byte, headers []sarama.RecordHeader) error {
+	return p.send(topic, key, value, headers)
+}
 
+func (p *Producer) send(topic string, key, value []byte, headers []sarama.RecordHeader) error {
 	msg := &sarama.ProducerMessage{
 		Topic:   topic,
 		Key:     sarama.ByteEncoder(key),
@@ -126,12 +135,28 @@ type Consumer struct {
 	consumer sarama.ConsumerGroup
 	topics   []string
 	handler  ConsumerHandler
+	batch    BatchConfig
+	retry    *retrier
 }
 
 type ConsumerHandler interface {
 	Handle(ctx context.Context, message *sarama.ConsumerMessage) error
 }
 
+// BatchConsumerHandler persists a run of messages claimed from a single partition at once.
+// Messages are passed in offset order; Handle is used as a per-message fallback when
+// HandleBatch fails so a single bad record cannot stall the whole batch.
+type BatchConsumerHandler interface {
+	ConsumerHandler
+	HandleBatch(ctx context.Context, messages []*sarama.ConsumerMessage) error
+}
+
+// BatchConfig bounds how long messages are accumulated per partition before flushing.
+type BatchConfig struct {
+	Size   int           // flush once this many messages are buffered
+	Linger time.Duration // flush buffered messages at least this often
+}
+
 func NewConsumer(brokers []string, groupID string, topics []string, handler ConsumerHandler) (*Consumer, error) {
 	config := sarama.NewConfig()
 	config.Consumer.Group.Rebalance.Strategy = sarama.NewBalanceStrategyRoundRobin()
@@ -149,8 +174,46 @@ func NewConsumer(brokers []string, groupID string, topics []string, handler Cons
 	}, nil
 }
 
+// NewBatchConsumer creates a consumer that hands messages to the handler in batches of up
+// to batch.Size per partition and commits offsets once per batch.
+func NewBatchConsumer(brokers []string, groupID string, topics []string, handler BatchConsumerHandler, batch BatchConfig) (*Consumer, error) {
+	if batch.Size <= 0 {
+		batch.Size = 1
+	}
+	if batch.Linger <= 0 {
+		batch.Linger = 50 * time.Millisecond
+	}
+	c, err := NewConsumer(brokers, groupID, topics, handler)
+	if err != nil {
+		return nil, err
+	}
+	c.batch = batch
+	return c, nil
+}
+
+// NewRetryConsumer creates the consumer draining the retry topics of the given source topics.
+// Each record is held back until its stage delay has elapsed and then handled as if it came
+// from its original topic; failing again moves it to the next stage or the dead-letter topic.
+func NewRetryConsumer(brokers []string, groupID string, topics []string, handler ConsumerHandler, producer *Producer, policy RetryPolicy) (*Consumer, error) {
+	c, err := NewConsumer(brokers, groupID, policy.RetryTopics(topics), &delayedHandler{handler: handler})
+	if err != nil {
+		return nil, err
+	}
+	c.EnableRetry(producer, policy)
+	return c, nil
+}
+
+// EnableRetry makes the consumer park failed messages on retry topics instead of retrying them
+// in place, which holds up the partition until they succeed.
+func (c *Consumer) EnableRetry(producer *Producer, policy RetryPolicy) {
+	c.retry = &retrier{producer: producer, policy: policy}
+}
+
 func (c *Consumer) Start(ctx context.Context) error {
-	handler := &consumerGroupHandler{handler: c.handler}
+	var handler sarama.ConsumerGroupHandler = &consumerGroupHandler{handler: c.handler, retry: c.retry}
+	if bh, ok := c.handler.(BatchConsumerHandler); ok && c.batch.Size > 1 {
+		handler = &batchConsumerGroupHandler{handler: bh, batch: c.batch, retry: c.retry}
+	}
 	for {
 		select {
 		case <-ctx.Done():
@@ -169,6 +232,7 @@ func (c *Consumer) Close() error {
 
 type consumerGroupHandler struct {
 	handler ConsumerHandler
+	retry   *retrier
 }
 
 func (h *consumerGroupHandler) Setup(_ sarama.ConsumerGroupSession) error { return nil }
@@ -177,13 +241,9 @@ func (h *consumerGroupHandler) Cleanup(_ sarama.ConsumerGroupSession) error { re
 
 func (h *consumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
 	for message := range claim.Messages() {
-		// Extract tracing context from Kafka headers
-		carrier := &ConsumerHeaderCarrier{Headers: message.Headers}
-		ctx := otel.GetTextMapPropagator().Extract(session.Context(), carrier)
-
-		if err := h.handler.Handle(ctx, message); err != nil {
-			// Log error but continue
-			continue
+		if !handleMessage(session, h.handler, h.retry, message) {
+			// Session ended before the message was handled: it is redelivered
+			return nil
 		}
 		session.MarkMessage(message, "")
 		// 手动提交位移，确保消息在被成功处理后才被标记为已消费
@@ -191,3 +251,74 @@ func (h *consumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession,
 	}
 	return nil
 }
+
+// ExtractContext restores the tracing context propagated in the message headers.
+func ExtractContext(ctx context.Context, message *sarama.ConsumerMessage) context.Context {
+	carrier := &ConsumerHeaderCarrier{Headers: message.Headers}
+	return otel.GetTextMapPropagator().Extract(ctx, carrier)
+}
+
+type batchConsumerGroupHandler struct {
+	handler BatchConsumerHandler
+	batch   BatchConfig
+	retry   *retrier
+}
+
+func (h *batchConsumerGroupHandler) Setup(_ sarama.ConsumerGroupSession) error { return nil }
+
+func (h *batchConsumerGroupHandler) Cleanup(_ sarama.ConsumerGroupSession) error { return nil }
+
+func (h *batchConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	ticker := time.NewTicker(h.batch.Linger)
+	defer ticker.Stop()
+
+	pending := make([]*sarama.ConsumerMessage, 0, h.batch.Size)
+	flush := func() {
+		if len(pending) == 0 {
+			return
+		}
+		h.flush(session, pending)
+		pending = make([]*sarama.ConsumerMessage, 0, h.batch.Size)
+	}
+
+	for {
+		select {
+		case message, ok := <-claim.Messages():
+			if !ok {
+				flush()
+				return nil
+			}
+			pending = append(pending, message)
+			if len(pending) >= h.batch.Size {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-session.Context().Done():
+			// Unflushed messages are not marked and will be redelivered after the rebalance.
+			return nil
+		}
+	}
+}
+
+func (h *batchConsumerGroupHandler) flush(session sarama.ConsumerGroupSession, messages []*sarama.ConsumerMessage) {
+	ctx := session.Context()
+	if err := h.handler.HandleBatch(ctx, messages); err == nil {
+		session.MarkMessage(messages[len(messages)-1], "")
+		session.Commit()
+		return
+	}
+
+	// Batch failed as a whole: replay it one by one with the regular semantics.
+	var last *sarama.ConsumerMessage
+	for _, message := range messages {
+		if !handleMessage(session, h.handler, h.retry, message) {
+			break
+		}
+		last = message
+	}
+	if last != nil {
+		session.MarkMessage(last, "")
+		session.Commit()
+	}
+}
@@ -0,0 +1,219 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/metric"
+)
+
+// Headers attached to records parked on retry and dead-letter topics.
+const (
+	HeaderOriginalTopic     = "x-original-topic"
+	HeaderOriginalPartition = "x-original-partition"
+	HeaderOriginalOffset    = "x-original-offset"
+	HeaderAttempt           = "x-retry-attempt"
+	HeaderNotBefore         = "x-retry-not-before" // unix millis
+	HeaderError             = "x-error"
+)
+
+var (
+	metricRetryForwarded = metric.NewCounterVec(&metric.CounterVecOpts{
+		Namespace: "kafka_consumer",
+		Subsystem: "retry",
+		Name:      "forwarded_total",
+		Help:      "kafka consumer failed messages forwarded to a retry topic.",
+		Labels:    []string{"topic", "attempt"},
+	})
+	metricDeadLettered = metric.NewCounterVec(&metric.CounterVecOpts{
+		Namespace: "kafka_consumer",
+		Subsystem: "retry",
+		Name:      "dead_lettered_total",
+		Help:      "kafka consumer messages quarantined on the dead-letter topic.",
+		Labels:    []string{"topic"},
+	})
+	metricForwardErrors = metric.NewCounterVec(&metric.CounterVecOpts{
+		Namespace: "kafka_consumer",
+		Subsystem: "retry",
+		Name:      "forward_errors_total",
+		Help:      "kafka consumer failures to publish to a retry or dead-letter topic.",
+		Labels:    []string{"topic"},
+	})
+)
+
+// RetryStage is one delayed redelivery of a failed message.
+type RetryStage struct {
+	Delay time.Duration
+}
+
+// RetryPolicy sends messages whose handler failed through len(Stages) delayed retry topics,
+// then to the dead-letter topic. An empty policy quarantines on the first failure.
+type RetryPolicy struct {
+	Stages []RetryStage `json:",optional"`
+}
+
+// RetryTopic is the topic holding the attempt-th retry of messages from topic, e.g. message-topic-retry-1.
+func RetryTopic(topic string, attempt int) string {
+	return fmt.Sprintf("%s-retry-%d", topic, attempt)
+}
+
+// DeadLetterTopic is the topic holding quarantined messages from topic.
+func DeadLetterTopic(topic string) string {
+	return topic + "-dlt"
+}
+
+// RetryTopics lists every retry topic of the policy for the given source topics.
+func (p RetryPolicy) RetryTopics(topics []string) []string {
+	var res []string
+	for _, topic := range topics {
+		for i := range p.Stages {
+			res = append(res, RetryTopic(topic, i+1))
+		}
+	}
+	return res
+}
+
+// HeaderValue returns the value of a record header, or "" when absent.
+func HeaderValue(message *sarama.ConsumerMessage, key string) string {
+	return (&ConsumerHeaderCarrier{Headers: message.Headers}).Get(key)
+}
+
+// OriginalTopic returns the topic a (possibly retried or quarantined) message was first produced to.
+func OriginalTopic(message *sarama.ConsumerMessage) string {
+	if topic := HeaderValue(message, HeaderOriginalTopic); topic != "" {
+		return topic
+	}
+	return message.Topic
+}
+
+// StripRetryHeaders drops the retry bookkeeping headers, keeping the original ones (e.g. tracing).
+func StripRetryHeaders(headers []*sarama.RecordHeader) []sarama.RecordHeader {
+	res := make([]sarama.RecordHeader, 0, len(headers))
+	for _, h := range headers {
+		if h == nil || strings.HasPrefix(string(h.Key), "x-original-") || strings.HasPrefix(string(h.Key), "x-retry-") || string(h.Key) == HeaderError {
+			continue
+		}
+		res = append(res, *h)
+	}
+	return res
+}
+
+// maxHandleBackoff caps the wait between attempts of a consumer without a retry pipeline
+const maxHandleBackoff = 30 * time.Second
+
+type retrier struct {
+	producer *Producer
+	policy   RetryPolicy
+}
+
+// forward republishes a failed message to its next retry stage, or to the dead-letter topic
+// once all stages are used up.
+func (r *retrier) forward(ctx context.Context, message *sarama.ConsumerMessage, cause error) error {
+	origTopic := OriginalTopic(message)
+	partition := HeaderValue(message, HeaderOriginalPartition)
+	offset := HeaderValue(message, HeaderOriginalOffset)
+	if partition == "" {
+		partition = strconv.FormatInt(int64(message.Partition), 10)
+		offset = strconv.FormatInt(message.Offset, 10)
+	}
+	attempt, _ := strconv.Atoi(HeaderValue(message, HeaderAttempt))
+	attempt++
+
+	headers := StripRetryHeaders(message.Headers)
+	headers = append(headers,
+		sarama.RecordHeader{Key: []byte(HeaderOriginalTopic), Value: []byte(origTopic)},
+		sarama.RecordHeader{Key: []byte(HeaderOriginalPartition), Value: []byte(partition)},
+		sarama.RecordHeader{Key: []byte(HeaderOriginalOffset), Value: []byte(offset)},
+		sarama.RecordHeader{Key: []byte(HeaderAttempt), Value: []byte(strconv.Itoa(attempt))},
+		sarama.RecordHeader{Key: []byte(HeaderError), Value: []byte(cause.Error())},
+	)
+
+	target := DeadLetterTopic(origTopic)
+	if attempt <= len(r.policy.Stages) {
+		target = RetryTopic(origTopic, attempt)
+		notBefore := time.Now().Add(r.policy.Stages[attempt-1].Delay).UnixMilli()
+		headers = append(headers, sarama.RecordHeader{Key: []byte(HeaderNotBefore), Value: []byte(strconv.FormatInt(notBefore, 10))})
+	}
+
+	if err := r.producer.SendWithHeaders(ctx, target, message.Key, message.Value, headers); err != nil {
+		metricForwardErrors.Inc(origTopic)
+		return err
+	}
+	if target == DeadLetterTopic(origTopic) {
+		metricDeadLettered.Inc(origTopic)
+		logx.WithContext(ctx).Errorf("[Kafka] message %s/%s/%s quarantined after %d attempts: %v", origTopic, partition, offset, attempt, cause)
+	} else {
+		metricRetryForwarded.Inc(origTopic, strconv.Itoa(attempt))
+	}
+	return nil
+}
+
+// handleMessage runs the handler and reports whether the message may be marked as consumed.
+// With a retrier, failed messages are parked on the next retry stage before being marked;
+// publishing is retried until it succeeds or the session ends. Without one, the handler itself
+// is retried with backoff. Either way the offset never moves past a message that is neither
+// handled nor parked, and false means the session ended.
+func handleMessage(session sarama.ConsumerGroupSession, handler ConsumerHandler, r *retrier, message *sarama.ConsumerMessage) bool {
+	ctx := ExtractContext(session.Context(), message)
+	err := handler.Handle(ctx, message)
+	if err == nil {
+		return true
+	}
+
+	backoff := time.Second
+	for {
+		if session.Context().Err() != nil {
+			// Rebalance or shutdown: leave it unmarked so it is redelivered.
+			return false
+		}
+		if r != nil {
+			ferr := r.forward(ctx, message, err)
+			if ferr == nil {
+				return true
+			}
+			logx.WithContext(ctx).Errorf("[Kafka] failed to park message %s/%d/%d: %v", message.Topic, message.Partition, message.Offset, ferr)
+		} else {
+			logx.WithContext(ctx).Errorf("[Kafka] failed to handle message %s/%d/%d, retrying in %s: %v", message.Topic, message.Partition, message.Offset, backoff, err)
+		}
+		select {
+		case <-session.Context().Done():
+			return false
+		case <-time.After(backoff):
+		}
+		if r == nil {
+			if err = handler.Handle(ctx, message); err == nil {
+				return true
+			}
+			backoff = min(backoff*2, maxHandleBackoff)
+		}
+	}
+}
+
+// delayedHandler waits until a retry record is due, then hands it to the wrapped handler under
+// its original topic so the handler's topic routing keeps working.
+type delayedHandler struct {
+	handler ConsumerHandler
+}
+
+func (h *delayedHandler) Handle(ctx context.Context, message *sarama.ConsumerMessage) error {
+	if ms, err := strconv.ParseInt(HeaderValue(message, HeaderNotBefore), 10, 64); err == nil {
+		if wait := time.Until(time.UnixMilli(ms)); wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+	}
+	// The consumed record is marked under its retry topic afterwards, so only a copy is renamed
+	m := *message
+	m.Topic = OriginalTopic(message)
+	return h.handler.Handle(ctx, &m)
+}
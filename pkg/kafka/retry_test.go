@@ -0,0 +1,135 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+type fakeSession struct {
+	ctx    context.Context
+	marked map[string]int64
+}
+
+func (s *fakeSession) Claims() map[string][]int32 { return nil }
+func (s *fakeSession) MemberID() string           { return "" }
+func (s *fakeSession) GenerationID() int32        { return 0 }
+func (s *fakeSession) Commit()                    {}
+func (s *fakeSession) Context() context.Context   { return s.ctx }
+
+func (s *fakeSession) MarkOffset(topic string, partition int32, offset int64, _ string) {
+	s.marked[topic] = offset
+}
+
+func (s *fakeSession) ResetOffset(string, int32, int64, string) {}
+
+func (s *fakeSession) MarkMessage(msg *sarama.ConsumerMessage, metadata string) {
+	s.MarkOffset(msg.Topic, msg.Partition, msg.Offset+1, metadata)
+}
+
+type fakeClaim struct {
+	topic    string
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c *fakeClaim) Topic() string                            { return c.topic }
+func (c *fakeClaim) Partition() int32                         { return 0 }
+func (c *fakeClaim) InitialOffset() int64                     { return 0 }
+func (c *fakeClaim) HighWaterMarkOffset() int64               { return 0 }
+func (c *fakeClaim) Messages() <-chan *sarama.ConsumerMessage { return c.messages }
+
+type recordingHandler struct {
+	topics []string
+}
+
+func (h *recordingHandler) Handle(_ context.Context, message *sarama.ConsumerMessage) error {
+	h.topics = append(h.topics, message.Topic)
+	return nil
+}
+
+func TestRetryConsumerMarksRetryTopic(t *testing.T) {
+	retryTopic := RetryTopic("message-topic", 1)
+	claim := &fakeClaim{topic: retryTopic, messages: make(chan *sarama.ConsumerMessage, 2)}
+	for offset := int64(0); offset < 2; offset++ {
+		claim.messages <- &sarama.ConsumerMessage{
+			Topic:  retryTopic,
+			Offset: offset,
+			Headers: []*sarama.RecordHeader{
+				{Key: []byte(HeaderOriginalTopic), Value: []byte("message-topic")},
+				{Key: []byte(HeaderNotBefore), Value: []byte(strconv.FormatInt(time.Now().UnixMilli(), 10))},
+			},
+		}
+	}
+	close(claim.messages)
+
+	inner := &recordingHandler{}
+	session := &fakeSession{ctx: context.Background(), marked: map[string]int64{}}
+	h := &consumerGroupHandler{handler: &delayedHandler{handler: inner}}
+	if err := h.ConsumeClaim(session, claim); err != nil {
+		t.Fatalf("ConsumeClaim: %v", err)
+	}
+
+	for _, topic := range inner.topics {
+		if topic != "message-topic" {
+			t.Errorf("handler saw topic %q, want the original topic", topic)
+		}
+	}
+	if got := session.marked[retryTopic]; got != 2 {
+		t.Errorf("offset marked on %s = %d, want 2", retryTopic, got)
+	}
+	if _, ok := session.marked["message-topic"]; ok {
+		t.Errorf("offset marked on the original topic, which the retry consumer does not own")
+	}
+}
+
+type flakyHandler struct {
+	failures int
+	calls    int
+}
+
+func (h *flakyHandler) Handle(context.Context, *sarama.ConsumerMessage) error {
+	h.calls++
+	if h.calls <= h.failures {
+		return errors.New("transient")
+	}
+	return nil
+}
+
+func TestConsumerWithoutRetryDoesNotSkipFailures(t *testing.T) {
+	claim := &fakeClaim{topic: "user-topic", messages: make(chan *sarama.ConsumerMessage, 1)}
+	claim.messages <- &sarama.ConsumerMessage{Topic: "user-topic", Offset: 0}
+	close(claim.messages)
+
+	inner := &flakyHandler{failures: 1}
+	session := &fakeSession{ctx: context.Background(), marked: map[string]int64{}}
+	h := &consumerGroupHandler{handler: inner}
+	if err := h.ConsumeClaim(session, claim); err != nil {
+		t.Fatalf("ConsumeClaim: %v", err)
+	}
+
+	if inner.calls != 2 {
+		t.Errorf("handler called %d times, want the failure retried once", inner.calls)
+	}
+	if got := session.marked["user-topic"]; got != 1 {
+		t.Errorf("offset marked = %d, want 1", got)
+	}
+
+	// A session that ends mid-retry leaves the message unmarked for redelivery
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	claim = &fakeClaim{topic: "user-topic", messages: make(chan *sarama.ConsumerMessage, 1)}
+	claim.messages <- &sarama.ConsumerMessage{Topic: "user-topic", Offset: 1}
+	close(claim.messages)
+	session = &fakeSession{ctx: ctx, marked: map[string]int64{}}
+	h = &consumerGroupHandler{handler: &flakyHandler{failures: 1}}
+	if err := h.ConsumeClaim(session, claim); err != nil {
+		t.Fatalf("ConsumeClaim: %v", err)
+	}
+	if _, ok := session.marked["user-topic"]; ok {
+		t.Errorf("offset marked past a failed message")
+	}
+}
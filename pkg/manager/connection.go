@@ -0,0 +1,247 @@
+package manager
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/archyhsh/gochat/pkg/wsproto"
+	"github.com/gorilla/websocket"
+	"github.com/zeromicro/go-zero/core/metric"
+)
+
+// Slow-consumer policies, applied when a connection's send queue is full
+const (
+	// PolicyDrop drops the frame that did not fit
+	PolicyDrop = "drop"
+	// PolicyCoalesce lets keyed frames replace their queued predecessor, and makes room for a new
+	// frame by evicting the oldest keyed one; frames that still do not fit are dropped
+	PolicyCoalesce = "coalesce"
+	// PolicyDisconnect closes the connection so the client reconnects and resyncs
+	PolicyDisconnect = "disconnect"
+
+	DefaultQueueSize    = 256
+	DefaultWriteTimeout = 10 * time.Second
+)
+
+var (
+	ErrConnectionClosed = errors.New("connection closed")
+	ErrQueueFull        = errors.New("send queue full")
+)
+
+var (
+	metricQueueDepth = metric.NewGaugeVec(&metric.GaugeVecOpts{
+		Namespace: "gateway",
+		Subsystem: "conn",
+		Name:      "queue_depth",
+		Help:      "frames waiting in the send queues of all connections.",
+		Labels:    []string{"platform"},
+	})
+	metricQueueDepthAtSend = metric.NewHistogramVec(&metric.HistogramVecOpts{
+		Namespace: "gateway",
+		Subsystem: "conn",
+		Name:      "queue_depth_at_send",
+		Help:      "send queue length of a connection when a frame is queued.",
+		Labels:    []string{"platform"},
+		Buckets:   []float64{0, 1, 4, 16, 64, 128, 256, 1024},
+	})
+	metricFrames = metric.NewCounterVec(&metric.CounterVecOpts{
+		Namespace: "gateway",
+		Subsystem: "conn",
+		Name:      "frames_total",
+		Help:      "frames handed to connections, by outcome.",
+		Labels:    []string{"result"},
+	})
+)
+
+// Frame is one encoded WebSocket message.
+type Frame struct {
+	Type int // websocket.TextMessage or websocket.BinaryMessage
+	Data []byte
+	// Key marks frames that only matter in their latest version (typing, presence); under
+	// PolicyCoalesce a queued frame with the same key is replaced instead of queueing another
+	Key string
+}
+
+type ConnOptions struct {
+	QueueSize    int
+	WriteTimeout time.Duration
+	Policy       string
+}
+
+// WsConnection is a WebSocket connection whose writes all go through one writer goroutine, as
+// gorilla/websocket allows a single concurrent writer. Senders only append to a bounded queue,
+// so a slow client never blocks a push.
+type WsConnection struct {
+	id       string
+	userId   int64
+	deviceId string
+	platform string
+	codec    wsproto.Codec
+	conn     *websocket.Conn
+	opts     ConnOptions
+
+	mu     sync.Mutex
+	queue  []Frame
+	closed bool
+
+	wake      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func NewWsConnection(id string, userId int64, deviceId, platform string, codec wsproto.Codec, conn *websocket.Conn, opts ConnOptions) *WsConnection {
+	if opts.QueueSize <= 0 {
+		opts.QueueSize = DefaultQueueSize
+	}
+	if opts.WriteTimeout <= 0 {
+		opts.WriteTimeout = DefaultWriteTimeout
+	}
+	c := &WsConnection{
+		id:       id,
+		userId:   userId,
+		deviceId: deviceId,
+		platform: platform,
+		codec:    codec,
+		conn:     conn,
+		opts:     opts,
+		wake:     make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+	go c.writeLoop()
+	return c
+}
+
+func (c *WsConnection) GetID() string           { return c.id }
+func (c *WsConnection) GetUserID() int64        { return c.userId }
+func (c *WsConnection) GetDeviceID() string     { return c.deviceId }
+func (c *WsConnection) GetPlatform() string     { return c.platform }
+func (c *WsConnection) GetCodec() wsproto.Codec { return c.codec }
+
+// Done is closed once the connection is closed.
+func (c *WsConnection) Done() <-chan struct{} { return c.done }
+
+// SendMessage queues a Frame for the writer.
+func (c *WsConnection) SendMessage(msg interface{}) error {
+	var f Frame
+	switch m := msg.(type) {
+	case Frame:
+		f = m
+	case *Frame:
+		f = *m
+	default:
+		return errors.New("unsupported message type")
+	}
+
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return ErrConnectionClosed
+	}
+	metricQueueDepthAtSend.Observe(int64(len(c.queue)), c.platform)
+
+	if c.opts.Policy == PolicyCoalesce && f.Key != "" {
+		for i := range c.queue {
+			if c.queue[i].Key == f.Key {
+				c.queue[i] = f
+				c.mu.Unlock()
+				metricFrames.Inc("coalesced")
+				return nil
+			}
+		}
+	}
+
+	if len(c.queue) >= c.opts.QueueSize {
+		switch c.opts.Policy {
+		case PolicyDisconnect:
+			c.mu.Unlock()
+			metricFrames.Inc("disconnected")
+			_ = c.CloseWithReason(websocket.CloseTryAgainLater, "slow consumer")
+			return ErrQueueFull
+		case PolicyCoalesce:
+			if !c.evictKeyed() {
+				c.mu.Unlock()
+				metricFrames.Inc("dropped")
+				return ErrQueueFull
+			}
+		default:
+			c.mu.Unlock()
+			metricFrames.Inc("dropped")
+			return ErrQueueFull
+		}
+	}
+
+	c.queue = append(c.queue, f)
+	c.mu.Unlock()
+	metricQueueDepth.Inc(c.platform)
+	metricFrames.Inc("queued")
+
+	select {
+	case c.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// evictKeyed removes the oldest keyed frame from the queue; the caller holds mu.
+func (c *WsConnection) evictKeyed() bool {
+	for i := range c.queue {
+		if c.queue[i].Key != "" {
+			c.queue = append(c.queue[:i], c.queue[i+1:]...)
+			metricQueueDepth.Dec(c.platform)
+			metricFrames.Inc("evicted")
+			return true
+		}
+	}
+	return false
+}
+
+func (c *WsConnection) writeLoop() {
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-c.wake:
+		}
+
+		c.mu.Lock()
+		batch := c.queue
+		c.queue = nil
+		c.mu.Unlock()
+		metricQueueDepth.Sub(float64(len(batch)), c.platform)
+
+		for _, f := range batch {
+			_ = c.conn.SetWriteDeadline(time.Now().Add(c.opts.WriteTimeout))
+			if err := c.conn.WriteMessage(f.Type, f.Data); err != nil {
+				metricFrames.Inc("write_error")
+				_ = c.Close()
+				return
+			}
+		}
+	}
+}
+
+// CloseWithReason sends a close frame with the code and reason, then closes the connection.
+// Control frames may be written concurrently with the writer goroutine.
+func (c *WsConnection) CloseWithReason(code int, reason string) error {
+	_ = c.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(time.Second))
+	return c.Close()
+}
+
+// Close stops the writer and closes the socket; frames still queued are discarded. The read loop
+// then fails and runs the disconnect handling.
+func (c *WsConnection) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		c.mu.Lock()
+		c.closed = true
+		pending := len(c.queue)
+		c.queue = nil
+		c.mu.Unlock()
+		metricQueueDepth.Sub(float64(pending), c.platform)
+
+		close(c.done)
+		err = c.conn.Close()
+	})
+	return err
+}
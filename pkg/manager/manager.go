@@ -3,6 +3,8 @@ package manager
 import (
 	"errors"
 	"sync"
+
+	"github.com/archyhsh/gochat/pkg/wsproto"
 )
 
 var (
@@ -13,14 +15,19 @@ var (
 type Connection interface {
 	GetID() string
 	GetUserID() int64
+	GetDeviceID() string
 	GetPlatform() string
+	GetCodec() wsproto.Codec // nil for legacy clients that negotiated no subprotocol
 	SendMessage(msg interface{}) error
+	CloseWithReason(code int, reason string) error
 	Close() error
 }
 
 type Manager struct {
 	connections sync.Map // connID -> Connection
 	userConns   sync.Map // userID -> map[connID]Connection
+
+	mu sync.Mutex // serializes Register and Unregister, which add and drop the per-user maps
 }
 
 func NewManager() *Manager {
@@ -28,6 +35,9 @@ func NewManager() *Manager {
 }
 
 func (m *Manager) Register(conn Connection) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	m.connections.Store(conn.GetID(), conn)
 
 	userConnsInterface, _ := m.userConns.LoadOrStore(conn.GetUserID(), &sync.Map{})
@@ -35,8 +45,13 @@ func (m *Manager) Register(conn Connection) {
 	userConns.Store(conn.GetID(), conn)
 }
 
-func (m *Manager) Unregister(conn Connection) {
-	if _, ok := m.connections.LoadAndDelete(conn.GetID()); ok {
+// Unregister forgets the connection and reports whether it was registered.
+func (m *Manager) Unregister(conn Connection) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, ok := m.connections.LoadAndDelete(conn.GetID())
+	if ok {
 		if userConnsInterface, ok := m.userConns.Load(conn.GetUserID()); ok {
 			userConns := userConnsInterface.(*sync.Map)
 			userConns.Delete(conn.GetID())
@@ -50,6 +65,7 @@ func (m *Manager) Unregister(conn Connection) {
 			}
 		}
 	}
+	return ok
 }
 
 func (m *Manager) SendToUser(userID int64, msg interface{}) error {
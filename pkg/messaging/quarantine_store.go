@@ -0,0 +1,104 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const DefaultQuarantineKey = "queue:kafka:quarantine"
+
+// QuarantinedMessage is a record that exhausted its retries and was parked on a dead-letter topic.
+type QuarantinedMessage struct {
+	Id        string            `json:"id"`    // dead-letter topic/partition/offset
+	Topic     string            `json:"topic"` // original topic
+	Partition int32             `json:"partition"`
+	Offset    int64             `json:"offset"`
+	Key       []byte            `json:"key"`
+	Value     []byte            `json:"value"`
+	Headers   map[string]string `json:"headers"` // original headers, retry bookkeeping stripped
+	Error     string            `json:"error"`
+	Attempts  int               `json:"attempts"`
+	FailedAt  int64             `json:"failed_at"`
+}
+
+// RedisQuarantineStore keeps quarantined records in a hash indexed by a sorted set on failed_at.
+type RedisQuarantineStore struct {
+	rdb *redis.Redis
+	key string
+}
+
+func NewRedisQuarantineStore(rdb *redis.Redis, key string) *RedisQuarantineStore {
+	if key == "" {
+		key = DefaultQuarantineKey
+	}
+	return &RedisQuarantineStore{
+		rdb: rdb,
+		key: key,
+	}
+}
+
+func (s *RedisQuarantineStore) indexKey() string {
+	return s.key + ":index"
+}
+
+func (s *RedisQuarantineStore) Save(ctx context.Context, msg *QuarantinedMessage) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if err = s.rdb.HsetCtx(ctx, s.key, msg.Id, string(data)); err != nil {
+		return err
+	}
+	_, err = s.rdb.ZaddCtx(ctx, s.indexKey(), msg.FailedAt, msg.Id)
+	return err
+}
+
+// List returns quarantined records, newest first, together with the total count.
+func (s *RedisQuarantineStore) List(ctx context.Context, offset, limit int64) ([]*QuarantinedMessage, int64, error) {
+	total, err := s.rdb.ZcardCtx(ctx, s.indexKey())
+	if err != nil {
+		return nil, 0, err
+	}
+	ids, err := s.rdb.ZrevrangeCtx(ctx, s.indexKey(), offset, offset+limit-1)
+	if err != nil || len(ids) == 0 {
+		return nil, int64(total), err
+	}
+
+	vals, err := s.rdb.HmgetCtx(ctx, s.key, ids...)
+	if err != nil {
+		return nil, 0, err
+	}
+	res := make([]*QuarantinedMessage, 0, len(vals))
+	for _, v := range vals {
+		if v == "" {
+			continue
+		}
+		var msg QuarantinedMessage
+		if err := json.Unmarshal([]byte(v), &msg); err == nil {
+			res = append(res, &msg)
+		}
+	}
+	return res, int64(total), nil
+}
+
+func (s *RedisQuarantineStore) Get(ctx context.Context, id string) (*QuarantinedMessage, error) {
+	val, err := s.rdb.HgetCtx(ctx, s.key, id)
+	if err != nil {
+		return nil, err
+	}
+	var msg QuarantinedMessage
+	if err := json.Unmarshal([]byte(val), &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+func (s *RedisQuarantineStore) Delete(ctx context.Context, id string) error {
+	if _, err := s.rdb.HdelCtx(ctx, s.key, id); err != nil {
+		return err
+	}
+	_, err := s.rdb.ZremCtx(ctx, s.indexKey(), id)
+	return err
+}
@@ -0,0 +1,110 @@
+package moderation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/archyhsh/gochat/pkg/auth"
+)
+
+type ClassifierConf struct {
+	Url    string `json:",optional"` // e.g. the local stub in scripts/moderation_stub
+	Secret string `json:",optional"` // signs requests like internal calls when set
+	// Threshold is the score from which a classification counts as a hit
+	Threshold float64       `json:",default=0.8"`
+	Action    string        `json:",default=flag"`
+	Timeout   time.Duration `json:",default=2s"`
+}
+
+// Classification is a classifier's opinion on a text; an empty label means nothing was found.
+type Classification struct {
+	Label string  `json:"label"`
+	Score float64 `json:"score"`
+}
+
+// Classifier is an external content classifier, e.g. a toxicity or spam model.
+type Classifier interface {
+	Classify(ctx context.Context, text string) (*Classification, error)
+}
+
+// HTTPClassifier posts {"text": ...} as JSON and expects a Classification back.
+type HTTPClassifier struct {
+	url    string
+	client *http.Client
+	signer *auth.Signer
+}
+
+func NewHTTPClassifier(c ClassifierConf, client *http.Client) *HTTPClassifier {
+	h := &HTTPClassifier{url: c.Url, client: client}
+	if c.Secret != "" {
+		h.signer = auth.NewSigner(c.Secret)
+	}
+	return h
+}
+
+func (h *HTTPClassifier) Classify(ctx context.Context, text string) (*Classification, error) {
+	body, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if h.signer != nil {
+		h.signer.SignRequest(req, body)
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("classifier: status %d", resp.StatusCode)
+	}
+	var res Classification
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ClassifierFilter turns classifications at or above the threshold into hits.
+type ClassifierFilter struct {
+	classifier Classifier
+	threshold  float64
+	action     string
+}
+
+func NewClassifierFilter(c ClassifierConf, classifier Classifier) (*ClassifierFilter, error) {
+	if err := validAction(c.Action); err != nil {
+		return nil, err
+	}
+	if c.Action == ActionMask {
+		return nil, fmt.Errorf("moderation: a classifier cannot mask, use block or flag")
+	}
+	return &ClassifierFilter{classifier: classifier, threshold: c.Threshold, action: c.Action}, nil
+}
+
+func (cf *ClassifierFilter) Name() string { return "classifier" }
+
+func (cf *ClassifierFilter) Check(ctx context.Context, content string) (*Hit, string, error) {
+	res, err := cf.classifier.Classify(ctx, content)
+	if err != nil {
+		return nil, "", err
+	}
+	if res == nil || res.Label == "" || res.Score < cf.threshold {
+		return nil, "", nil
+	}
+	return &Hit{
+		Filter:  cf.Name(),
+		Action:  cf.action,
+		Matches: []string{fmt.Sprintf("%s:%.2f", res.Label, res.Score)},
+	}, content, nil
+}
@@ -0,0 +1,211 @@
+// Package moderation checks message content before it is produced. A Chain runs filters in order
+// (sensitive words, URLs, an external classifier); every hit carries the action its rule is
+// configured with, and flagged messages are kept for admin review.
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/metric"
+)
+
+// Actions a rule can take on a hit
+const (
+	// ActionBlock rejects the message
+	ActionBlock = "block"
+	// ActionMask delivers the message with the matched text replaced by '*'
+	ActionMask = "mask"
+	// ActionFlag delivers the message unchanged and queues it for review
+	ActionFlag = "flag"
+)
+
+var metricHits = metric.NewCounterVec(&metric.CounterVecOpts{
+	Namespace: "moderation",
+	Subsystem: "hits",
+	Name:      "total",
+	Help:      "moderation hits, by filter and action.",
+	Labels:    []string{"filter", "action"},
+})
+
+// Message is the content under check and where it is going.
+type Message struct {
+	MsgId          string
+	ConversationId string
+	SenderId       int64
+	GroupId        int64
+	Content        string
+}
+
+// Hit is one filter matching a message.
+type Hit struct {
+	Filter  string   `json:"filter"`
+	Action  string   `json:"action"`
+	Matches []string `json:"matches,omitempty"`
+}
+
+// Verdict is the outcome of a Chain. Content is what should be delivered, masked where rules
+// asked for it.
+type Verdict struct {
+	Blocked bool
+	Flagged bool
+	Content string
+	Hits    []Hit
+}
+
+// Reason describes the hits that blocked a message.
+func (v *Verdict) Reason() string {
+	var filters []string
+	for _, h := range v.Hits {
+		if h.Action == ActionBlock {
+			filters = append(filters, h.Filter)
+		}
+	}
+	return strings.Join(filters, ", ")
+}
+
+// Filter checks one piece of content. It returns nil when nothing matched; for ActionMask the
+// hit's masked content is returned as well.
+type Filter interface {
+	Name() string
+	Check(ctx context.Context, content string) (hit *Hit, masked string, err error)
+}
+
+// Conf configures the filters; the chain runs word lists first, then URLs, then the classifier.
+type Conf struct {
+	Words      []WordListConf `json:",optional"`
+	Urls       UrlConf        `json:",optional"`
+	Classifier ClassifierConf `json:",optional"`
+	// ReloadInterval is how often word list files are checked for changes
+	ReloadInterval time.Duration `json:",default=10s"`
+}
+
+func validAction(action string) error {
+	switch action {
+	case ActionBlock, ActionMask, ActionFlag:
+		return nil
+	}
+	return fmt.Errorf("moderation: unknown action %q", action)
+}
+
+// Chain runs filters in order. A block stops the chain, masks apply to what later filters see.
+type Chain struct {
+	filters []Filter
+	words   []*WordFilter
+	review  *ReviewStore
+}
+
+// NewChain builds the filters of c; review may be nil to only log flagged messages.
+func NewChain(c Conf, review *ReviewStore) (*Chain, error) {
+	ch := &Chain{review: review}
+	for _, wc := range c.Words {
+		wf, err := NewWordFilter(wc)
+		if err != nil {
+			return nil, err
+		}
+		ch.words = append(ch.words, wf)
+		ch.filters = append(ch.filters, wf)
+	}
+	if len(c.Urls.Allow) > 0 || len(c.Urls.Deny) > 0 {
+		uf, err := NewUrlFilter(c.Urls)
+		if err != nil {
+			return nil, err
+		}
+		ch.filters = append(ch.filters, uf)
+	}
+	if c.Classifier.Url != "" {
+		cf, err := NewClassifierFilter(c.Classifier,
+			NewHTTPClassifier(c.Classifier, &http.Client{Timeout: c.Classifier.Timeout}))
+		if err != nil {
+			return nil, err
+		}
+		ch.filters = append(ch.filters, cf)
+	}
+	return ch, nil
+}
+
+// Use appends a filter, e.g. a custom classifier.
+func (ch *Chain) Use(f Filter) {
+	ch.filters = append(ch.filters, f)
+}
+
+// Watch reloads word lists whose files changed, every interval, until ctx is done.
+func (ch *Chain) Watch(ctx context.Context, interval time.Duration) {
+	if len(ch.words) == 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, wf := range ch.words {
+				if err := wf.Reload(); err != nil {
+					logx.Errorf("[Moderation] failed to reload word list %s: %v", wf.Name(), err)
+				}
+			}
+		}
+	}
+}
+
+// Check runs the chain over msg. Filters that fail are skipped, so an unreachable classifier
+// does not stop messages. Flagged messages are queued for review.
+func (ch *Chain) Check(ctx context.Context, msg *Message) *Verdict {
+	v := &Verdict{Content: msg.Content}
+	if ch == nil {
+		return v
+	}
+	for _, f := range ch.filters {
+		hit, masked, err := f.Check(ctx, v.Content)
+		if err != nil {
+			logx.WithContext(ctx).Errorf("[Moderation] filter %s failed on %s: %v", f.Name(), msg.MsgId, err)
+			continue
+		}
+		if hit == nil {
+			continue
+		}
+		metricHits.Inc(hit.Filter, hit.Action)
+		v.Hits = append(v.Hits, *hit)
+
+		switch hit.Action {
+		case ActionBlock:
+			v.Blocked = true
+		case ActionMask:
+			v.Content = masked
+		case ActionFlag:
+			v.Flagged = true
+		}
+		if v.Blocked {
+			break
+		}
+	}
+
+	switch {
+	case v.Blocked:
+		logx.WithContext(ctx).Infof("[Moderation] blocked %s from user %d in %s: %s", msg.MsgId, msg.SenderId, msg.ConversationId, v.Reason())
+	case v.Flagged:
+		logx.WithContext(ctx).Infof("[Moderation] flagged %s from user %d in %s for review", msg.MsgId, msg.SenderId, msg.ConversationId)
+		if ch.review != nil {
+			if err := ch.review.Save(ctx, &FlaggedMessage{
+				MsgId:            msg.MsgId,
+				ConversationId:   msg.ConversationId,
+				SenderId:         msg.SenderId,
+				GroupId:          msg.GroupId,
+				Content:          msg.Content,
+				DeliveredContent: v.Content,
+				Hits:             v.Hits,
+				FlaggedAt:        time.Now().UnixMilli(),
+			}); err != nil {
+				logx.WithContext(ctx).Errorf("[Moderation] failed to queue %s for review: %v", msg.MsgId, err)
+			}
+		}
+	}
+	return v
+}
@@ -0,0 +1,99 @@
+package moderation
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const DefaultReviewKey = "queue:moderation:review"
+
+// FlaggedMessage is a delivered message waiting for an admin's review.
+type FlaggedMessage struct {
+	MsgId            string `json:"msg_id"`
+	ConversationId   string `json:"conversation_id"`
+	SenderId         int64  `json:"sender_id"`
+	GroupId          int64  `json:"group_id,omitempty"`
+	Content          string `json:"content"`           // as sent
+	DeliveredContent string `json:"delivered_content"` // after masking
+	Hits             []Hit  `json:"hits"`
+	FlaggedAt        int64  `json:"flagged_at"` // unix millis
+}
+
+// ReviewStore keeps flagged messages in a hash indexed by a sorted set on flagged_at.
+type ReviewStore struct {
+	rdb *redis.Redis
+	key string
+}
+
+func NewReviewStore(rdb *redis.Redis, key string) *ReviewStore {
+	if key == "" {
+		key = DefaultReviewKey
+	}
+	return &ReviewStore{
+		rdb: rdb,
+		key: key,
+	}
+}
+
+func (s *ReviewStore) indexKey() string {
+	return s.key + ":index"
+}
+
+func (s *ReviewStore) Save(ctx context.Context, msg *FlaggedMessage) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if err = s.rdb.HsetCtx(ctx, s.key, msg.MsgId, string(data)); err != nil {
+		return err
+	}
+	_, err = s.rdb.ZaddCtx(ctx, s.indexKey(), msg.FlaggedAt, msg.MsgId)
+	return err
+}
+
+// List returns flagged messages, oldest first, together with the total count.
+func (s *ReviewStore) List(ctx context.Context, offset, limit int64) ([]*FlaggedMessage, int64, error) {
+	total, err := s.rdb.ZcardCtx(ctx, s.indexKey())
+	if err != nil {
+		return nil, 0, err
+	}
+	ids, err := s.rdb.ZrangeCtx(ctx, s.indexKey(), offset, offset+limit-1)
+	if err != nil || len(ids) == 0 {
+		return nil, int64(total), err
+	}
+
+	vals, err := s.rdb.HmgetCtx(ctx, s.key, ids...)
+	if err != nil {
+		return nil, 0, err
+	}
+	res := make([]*FlaggedMessage, 0, len(vals))
+	for _, v := range vals {
+		if v == "" {
+			continue
+		}
+		var msg FlaggedMessage
+		if err := json.Unmarshal([]byte(v), &msg); err == nil {
+			res = append(res, &msg)
+		}
+	}
+	return res, int64(total), nil
+}
+
+// Resolve takes reviewed messages off the queue and reports how many were on it.
+func (s *ReviewStore) Resolve(ctx context.Context, msgIds ...string) (int, error) {
+	if len(msgIds) == 0 {
+		return 0, nil
+	}
+	members := make([]any, len(msgIds))
+	for i, id := range msgIds {
+		members[i] = id
+	}
+	n, err := s.rdb.ZremCtx(ctx, s.indexKey(), members...)
+	if err != nil {
+		return 0, err
+	}
+	_, err = s.rdb.HdelCtx(ctx, s.key, msgIds...)
+	return n, err
+}
@@ -0,0 +1,105 @@
+package moderation
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+type UrlConf struct {
+	// Allow, when set, lists the only domains links may point to (subdomains included)
+	Allow []string `json:",optional"`
+	// Deny lists domains links may never point to, checked before Allow
+	Deny   []string `json:",optional"`
+	Action string   `json:",default=block"`
+}
+
+var urlPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>"'，。]+`)
+
+// UrlFilter matches links to denied domains, or to domains missing from the allow list.
+type UrlFilter struct {
+	allow  []string
+	deny   []string
+	action string
+}
+
+func NewUrlFilter(c UrlConf) (*UrlFilter, error) {
+	if err := validAction(c.Action); err != nil {
+		return nil, err
+	}
+	return &UrlFilter{allow: normalizeDomains(c.Allow), deny: normalizeDomains(c.Deny), action: c.Action}, nil
+}
+
+func normalizeDomains(domains []string) []string {
+	res := make([]string, 0, len(domains))
+	for _, d := range domains {
+		if d = strings.Trim(strings.ToLower(strings.TrimSpace(d)), "."); d != "" {
+			res = append(res, d)
+		}
+	}
+	return res
+}
+
+func (uf *UrlFilter) Name() string { return "urls" }
+
+func (uf *UrlFilter) Check(_ context.Context, content string) (*Hit, string, error) {
+	locs := urlPattern.FindAllStringIndex(content, -1)
+	if len(locs) == 0 {
+		return nil, "", nil
+	}
+
+	var matches []string
+	var b strings.Builder
+	last := 0
+	for _, loc := range locs {
+		link := content[loc[0]:loc[1]]
+		if uf.permitted(hostOf(link)) {
+			continue
+		}
+		matches = append(matches, link)
+		b.WriteString(content[last:loc[0]])
+		b.WriteString(strings.Repeat("*", 3))
+		last = loc[1]
+	}
+	if len(matches) == 0 {
+		return nil, "", nil
+	}
+	b.WriteString(content[last:])
+	return &Hit{Filter: uf.Name(), Action: uf.action, Matches: matches}, b.String(), nil
+}
+
+func hostOf(link string) string {
+	if !strings.Contains(link, "://") {
+		link = "http://" + link
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(u.Hostname())
+}
+
+func (uf *UrlFilter) permitted(host string) bool {
+	if host == "" {
+		return false
+	}
+	for _, d := range uf.deny {
+		if matchDomain(host, d) {
+			return false
+		}
+	}
+	if len(uf.allow) == 0 {
+		return true
+	}
+	for _, d := range uf.allow {
+		if matchDomain(host, d) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchDomain(host, domain string) bool {
+	return host == domain || strings.HasSuffix(host, "."+domain)
+}
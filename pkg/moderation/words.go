@@ -0,0 +1,200 @@
+package moderation
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode"
+)
+
+type WordListConf struct {
+	Name   string `json:",optional"` // defaults to the file name
+	File   string // one word per line, # starts a comment
+	Action string `json:",default=mask"`
+}
+
+// Matcher finds every occurrence of a set of words in one pass over the text (Aho-Corasick).
+// Matching is case-insensitive and works on runes, so CJK words match as well.
+type Matcher struct {
+	nodes []acNode
+}
+
+type acNode struct {
+	next map[rune]int32
+	fail int32
+	// lengths, in runes, of the words ending here, including through fail links
+	out []int
+}
+
+// NewMatcher builds the automaton of words; empty words are ignored.
+func NewMatcher(words []string) *Matcher {
+	m := &Matcher{nodes: []acNode{{next: make(map[rune]int32)}}}
+	for _, w := range words {
+		runes := []rune(normalize(w))
+		if len(runes) == 0 {
+			continue
+		}
+		cur := int32(0)
+		for _, r := range runes {
+			nxt, ok := m.nodes[cur].next[r]
+			if !ok {
+				nxt = int32(len(m.nodes))
+				m.nodes = append(m.nodes, acNode{next: make(map[rune]int32)})
+				m.nodes[cur].next[r] = nxt
+			}
+			cur = nxt
+		}
+		m.nodes[cur].out = append(m.nodes[cur].out, len(runes))
+	}
+
+	// Fail links, breadth first so a node's fail target is final before its children need it
+	queue := make([]int32, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[cur].next {
+			f := m.nodes[cur].fail
+			for f > 0 {
+				if _, ok := m.nodes[f].next[r]; ok {
+					break
+				}
+				f = m.nodes[f].fail
+			}
+			if nxt, ok := m.nodes[f].next[r]; ok && nxt != child {
+				m.nodes[child].fail = nxt
+			}
+			m.nodes[child].out = append(m.nodes[child].out, m.nodes[m.nodes[child].fail].out...)
+			queue = append(queue, child)
+		}
+	}
+	return m
+}
+
+// Span is a match as rune offsets [Start, End) into the text.
+type Span struct {
+	Start, End int
+}
+
+// Find returns every match in text, overlapping ones included.
+func (m *Matcher) Find(text string) []Span {
+	if m == nil || len(m.nodes) == 1 {
+		return nil
+	}
+	var spans []Span
+	cur := int32(0)
+	for i, r := range []rune(normalize(text)) {
+		for cur > 0 {
+			if _, ok := m.nodes[cur].next[r]; ok {
+				break
+			}
+			cur = m.nodes[cur].fail
+		}
+		if nxt, ok := m.nodes[cur].next[r]; ok {
+			cur = nxt
+		}
+		for _, n := range m.nodes[cur].out {
+			spans = append(spans, Span{Start: i + 1 - n, End: i + 1})
+		}
+	}
+	return spans
+}
+
+// normalize folds case rune by rune, keeping rune offsets aligned with the original text.
+func normalize(s string) string {
+	return strings.Map(unicode.ToLower, s)
+}
+
+// maskSpans replaces the runes covered by spans with '*'.
+func maskSpans(text string, spans []Span) string {
+	runes := []rune(text)
+	for _, s := range spans {
+		for i := s.Start; i < s.End && i < len(runes); i++ {
+			runes[i] = '*'
+		}
+	}
+	return string(runes)
+}
+
+// WordFilter matches a sensitive-word list loaded from a file, which is reloaded when it changes.
+type WordFilter struct {
+	name    string
+	path    string
+	action  string
+	matcher atomic.Pointer[Matcher]
+
+	mu      sync.Mutex
+	modTime time.Time
+}
+
+func NewWordFilter(c WordListConf) (*WordFilter, error) {
+	if err := validAction(c.Action); err != nil {
+		return nil, err
+	}
+	name := c.Name
+	if name == "" {
+		name = "words:" + strings.TrimSuffix(filepath.Base(c.File), filepath.Ext(c.File))
+	}
+	wf := &WordFilter{name: name, path: c.File, action: c.Action}
+	if err := wf.Reload(); err != nil {
+		return nil, err
+	}
+	return wf, nil
+}
+
+func (wf *WordFilter) Name() string { return wf.name }
+
+// Reload rebuilds the matcher when the file changed since it was last loaded.
+func (wf *WordFilter) Reload() error {
+	wf.mu.Lock()
+	defer wf.mu.Unlock()
+
+	info, err := os.Stat(wf.path)
+	if err != nil {
+		return err
+	}
+	if info.ModTime().Equal(wf.modTime) {
+		return nil
+	}
+	data, err := os.ReadFile(wf.path)
+	if err != nil {
+		return err
+	}
+
+	var words []string
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			words = append(words, line)
+		}
+	}
+	wf.matcher.Store(NewMatcher(words))
+	wf.modTime = info.ModTime()
+	return nil
+}
+
+func (wf *WordFilter) Check(_ context.Context, content string) (*Hit, string, error) {
+	spans := wf.matcher.Load().Find(content)
+	if len(spans) == 0 {
+		return nil, "", nil
+	}
+	runes := []rune(content)
+	seen := make(map[string]bool)
+	var matches []string
+	for _, s := range spans {
+		if w := string(runes[s.Start:s.End]); !seen[w] {
+			seen[w] = true
+			matches = append(matches, w)
+		}
+	}
+	return &Hit{Filter: wf.name, Action: wf.action, Matches: matches}, maskSpans(content, spans), nil
+}
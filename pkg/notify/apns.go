@@ -0,0 +1,130 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// APNs accepts a provider token for up to an hour and rejects refreshes more often than every 20 minutes
+const apnsTokenTTL = 50 * time.Minute
+
+type APNsConf struct {
+	Endpoint string `json:",default=https://api.push.apple.com"` // https://api.sandbox.push.apple.com for development builds
+	KeyFile  string `json:",optional"`                           // .p8 token signing key
+	KeyId    string `json:",optional"`
+	TeamId   string `json:",optional"`
+	Topic    string `json:",optional"` // app bundle id
+}
+
+// APNsNotifier talks to the APNs HTTP/2 API with token-based authentication.
+type APNsNotifier struct {
+	c      APNsConf
+	client *http.Client
+	key    *ecdsa.PrivateKey
+
+	mu       sync.Mutex
+	token    string
+	issuedAt time.Time
+}
+
+func NewAPNsNotifier(c APNsConf, client *http.Client) (*APNsNotifier, error) {
+	pemBytes, err := os.ReadFile(c.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("read apns key: %w", err)
+	}
+	key, err := jwt.ParseECPrivateKeyFromPEM(pemBytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse apns key: %w", err)
+	}
+	return &APNsNotifier{c: c, client: client, key: key}, nil
+}
+
+func (a *APNsNotifier) providerToken() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token != "" && time.Since(a.issuedAt) < apnsTokenTTL {
+		return a.token, nil
+	}
+	now := time.Now()
+	t := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"iss": a.c.TeamId,
+		"iat": now.Unix(),
+	})
+	t.Header["kid"] = a.c.KeyId
+	signed, err := t.SignedString(a.key)
+	if err != nil {
+		return "", err
+	}
+	a.token, a.issuedAt = signed, now
+	return signed, nil
+}
+
+func (a *APNsNotifier) Notify(ctx context.Context, n *Notification) error {
+	aps := map[string]any{"badge": n.Badge}
+	if !n.Silent {
+		aps["alert"] = map[string]string{"title": n.Title, "body": n.Body}
+		aps["sound"] = "default"
+	}
+	if n.CollapseKey != "" {
+		aps["thread-id"] = n.CollapseKey
+	}
+	payload := map[string]any{"aps": aps}
+	for k, v := range n.Data {
+		payload[k] = v
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	token, err := a.providerToken()
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.c.Endpoint+"/3/device/"+n.Token, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "bearer "+token)
+	req.Header.Set("apns-topic", a.c.Topic)
+	req.Header.Set("apns-push-type", "alert")
+	if n.Silent {
+		req.Header.Set("apns-priority", "5")
+	} else {
+		req.Header.Set("apns-priority", "10")
+	}
+	if n.CollapseKey != "" && len(n.CollapseKey) <= 64 {
+		req.Header.Set("apns-collapse-id", n.CollapseKey)
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	var reason struct {
+		Reason string `json:"reason"`
+	}
+	respBody, _ := io.ReadAll(resp.Body)
+	_ = json.Unmarshal(respBody, &reason)
+	if resp.StatusCode == http.StatusGone || reason.Reason == "BadDeviceToken" || reason.Reason == "Unregistered" {
+		return ErrUnregistered
+	}
+	return fmt.Errorf("apns: status %d: %s", resp.StatusCode, reason.Reason)
+}
@@ -0,0 +1,167 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const fcmScope = "https://www.googleapis.com/auth/firebase.messaging"
+
+type FCMConf struct {
+	Endpoint        string `json:",default=https://fcm.googleapis.com"`
+	ProjectId       string `json:",optional"`
+	CredentialsFile string `json:",optional"` // service account json
+}
+
+type serviceAccount struct {
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenUri    string `json:"token_uri"`
+}
+
+// FCMNotifier sends through the FCM HTTP v1 API. The OAuth access token is obtained with the
+// service account's signed JWT and cached until shortly before it expires.
+type FCMNotifier struct {
+	c       FCMConf
+	client  *http.Client
+	account serviceAccount
+	key     *rsa.PrivateKey
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+func NewFCMNotifier(c FCMConf, client *http.Client) (*FCMNotifier, error) {
+	data, err := os.ReadFile(c.CredentialsFile)
+	if err != nil {
+		return nil, fmt.Errorf("read fcm credentials: %w", err)
+	}
+	var account serviceAccount
+	if err := json.Unmarshal(data, &account); err != nil {
+		return nil, fmt.Errorf("parse fcm credentials: %w", err)
+	}
+	key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(account.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("parse fcm key: %w", err)
+	}
+	return &FCMNotifier{c: c, client: client, account: account, key: key}, nil
+}
+
+func (f *FCMNotifier) accessToken(ctx context.Context) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.token != "" && time.Now().Before(f.expiresAt) {
+		return f.token, nil
+	}
+	now := time.Now()
+	assertion, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   f.account.ClientEmail,
+		"scope": fcmScope,
+		"aud":   f.account.TokenUri,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	}).SignedString(f.key)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {assertion},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.account.TokenUri, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("fcm token exchange: status %d", resp.StatusCode)
+	}
+
+	var tok struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tok); err != nil {
+		return "", err
+	}
+	f.token = tok.AccessToken
+	f.expiresAt = now.Add(time.Duration(tok.ExpiresIn)*time.Second - time.Minute)
+	return f.token, nil
+}
+
+func (f *FCMNotifier) Notify(ctx context.Context, n *Notification) error {
+	data := make(map[string]string, len(n.Data)+1)
+	for k, v := range n.Data {
+		data[k] = v
+	}
+	data["badge"] = strconv.FormatInt(n.Badge, 10)
+
+	message := map[string]any{
+		"token": n.Token,
+		"data":  data,
+	}
+	android := map[string]any{}
+	if n.CollapseKey != "" {
+		android["collapse_key"] = n.CollapseKey
+	}
+	if n.Silent {
+		android["priority"] = "NORMAL"
+	} else {
+		android["priority"] = "HIGH"
+		message["notification"] = map[string]string{"title": n.Title, "body": n.Body}
+		android["notification"] = map[string]any{"tag": n.CollapseKey, "notification_count": n.Badge}
+	}
+	message["android"] = android
+	body, err := json.Marshal(map[string]any{"message": message})
+	if err != nil {
+		return err
+	}
+
+	token, err := f.accessToken(ctx)
+	if err != nil {
+		return err
+	}
+	endpoint := fmt.Sprintf("%s/v1/projects/%s/messages:send", f.c.Endpoint, f.c.ProjectId)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	respBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode == http.StatusNotFound || bytes.Contains(respBody, []byte("UNREGISTERED")) {
+		return ErrUnregistered
+	}
+	return fmt.Errorf("fcm: status %d: %s", resp.StatusCode, respBody)
+}
@@ -0,0 +1,94 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// Platforms as stored in user_device.platform
+const (
+	PlatformIOS     = "ios"
+	PlatformAndroid = "android"
+)
+
+var (
+	// ErrUnregistered means the push token is no longer valid and should be dropped.
+	ErrUnregistered = errors.New("push token unregistered")
+	// ErrNoNotifier means no adapter is configured for the device's platform.
+	ErrNoNotifier = errors.New("no notifier for platform")
+)
+
+// Notification is one alert for one device.
+type Notification struct {
+	UserId      int64             `json:"user_id"`
+	DeviceId    string            `json:"device_id"`
+	Platform    string            `json:"platform"`
+	Token       string            `json:"token"`
+	Title       string            `json:"title"`
+	Body        string            `json:"body"`
+	Badge       int64             `json:"badge"`
+	CollapseKey string            `json:"collapse_key"` // a newer notification with the same key replaces the older one
+	Silent      bool              `json:"silent"`       // badge and data only, no alert or sound
+	Data        map[string]string `json:"data,omitempty"`
+}
+
+// Notifier delivers notifications to a push provider.
+type Notifier interface {
+	Notify(ctx context.Context, n *Notification) error
+}
+
+// Conf configures the adapters; an adapter without credentials stays disabled.
+type Conf struct {
+	APNs    APNsConf      `json:",optional"`
+	FCM     FCMConf       `json:",optional"`
+	Webhook WebhookConf   `json:",optional"`
+	Timeout time.Duration `json:",default=5s"`
+}
+
+// Dispatcher routes each notification to the adapter of its platform. The webhook adapter,
+// when configured, takes every platform without a dedicated one.
+type Dispatcher struct {
+	byPlatform map[string]Notifier
+	fallback   Notifier
+}
+
+func NewDispatcher(c Conf) (*Dispatcher, error) {
+	client := &http.Client{Timeout: c.Timeout}
+	d := &Dispatcher{byPlatform: make(map[string]Notifier)}
+
+	if c.APNs.KeyFile != "" {
+		apns, err := NewAPNsNotifier(c.APNs, client)
+		if err != nil {
+			return nil, err
+		}
+		d.byPlatform[PlatformIOS] = apns
+	}
+	if c.FCM.CredentialsFile != "" {
+		fcm, err := NewFCMNotifier(c.FCM, client)
+		if err != nil {
+			return nil, err
+		}
+		d.byPlatform[PlatformAndroid] = fcm
+	}
+	if c.Webhook.Url != "" {
+		d.fallback = NewWebhookNotifier(c.Webhook, client)
+	}
+	return d, nil
+}
+
+// Empty reports whether no adapter is configured at all.
+func (d *Dispatcher) Empty() bool {
+	return len(d.byPlatform) == 0 && d.fallback == nil
+}
+
+func (d *Dispatcher) Notify(ctx context.Context, n *Notification) error {
+	if notifier, ok := d.byPlatform[n.Platform]; ok {
+		return notifier.Notify(ctx, n)
+	}
+	if d.fallback != nil {
+		return d.fallback.Notify(ctx, n)
+	}
+	return ErrNoNotifier
+}
@@ -0,0 +1,60 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/archyhsh/gochat/pkg/auth"
+)
+
+type WebhookConf struct {
+	Url    string `json:",optional"`
+	Secret string `json:",optional"` // signs requests like internal calls when set
+}
+
+// WebhookNotifier posts every notification as JSON to a URL, e.g. a relay service or the
+// local stub in scripts/notify_stub. 410 Gone marks the token as unregistered.
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+	signer *auth.Signer
+}
+
+func NewWebhookNotifier(c WebhookConf, client *http.Client) *WebhookNotifier {
+	w := &WebhookNotifier{url: c.Url, client: client}
+	if c.Secret != "" {
+		w.signer = auth.NewSigner(c.Secret)
+	}
+	return w
+}
+
+func (w *WebhookNotifier) Notify(ctx context.Context, n *Notification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.signer != nil {
+		w.signer.SignRequest(req, body)
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusGone:
+		return ErrUnregistered
+	case resp.StatusCode >= 300:
+		return fmt.Errorf("webhook: status %d", resp.StatusCode)
+	}
+	return nil
+}
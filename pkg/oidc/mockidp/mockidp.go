@@ -0,0 +1,258 @@
+// Package mockidp is a minimal OpenID Connect provider for local development and tests. It
+// supports the authorization code flow with S256 PKCE only, signs ID tokens with a key generated
+// at start, and signs in whichever configured user is picked on its authorize page, or named by
+// login_hint.
+package mockidp
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/json"
+	"html/template"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/archyhsh/gochat/pkg/oidc"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// codeTTL is how long an authorization code can be redeemed
+const codeTTL = time.Minute
+
+type User struct {
+	Subject       string
+	Email         string `json:",optional"`
+	EmailVerified bool   `json:",default=true"`
+	Name          string `json:",optional"`
+}
+
+type Conf struct {
+	Listen   string `json:",optional"` // e.g. 127.0.0.1:9400; the provider is off when empty
+	Issuer   string `json:",optional"` // defaults to http://Listen
+	ClientId string `json:",optional"` // any client is accepted when empty
+	Users    []User `json:",optional"`
+}
+
+type grant struct {
+	user          User
+	clientId      string
+	redirectUri   string
+	codeChallenge string
+	nonce         string
+	expires       time.Time
+}
+
+type Server struct {
+	c   Conf
+	key *rsa.PrivateKey
+	kid string
+	mux *http.ServeMux
+
+	mu     sync.Mutex
+	grants map[string]*grant
+}
+
+func New(c Conf) (*Server, error) {
+	if c.Issuer == "" {
+		c.Issuer = "http://" + c.Listen
+	}
+	c.Issuer = strings.TrimSuffix(c.Issuer, "/")
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{
+		c:      c,
+		key:    key,
+		kid:    oidc.NewRandom()[:8],
+		mux:    http.NewServeMux(),
+		grants: make(map[string]*grant),
+	}
+	s.mux.HandleFunc("GET /.well-known/openid-configuration", s.discovery)
+	s.mux.HandleFunc("GET /authorize", s.authorize)
+	s.mux.HandleFunc("POST /token", s.token)
+	s.mux.HandleFunc("GET /jwks", s.jwks)
+	return s, nil
+}
+
+func (s *Server) Issuer() string {
+	return s.c.Issuer
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// Start serves on Listen in the background; browsers reach the authorize page there.
+func (s *Server) Start() {
+	go func() {
+		log.Printf("mock identity provider %s listening on %s", s.c.Issuer, s.c.Listen)
+		if err := http.ListenAndServe(s.c.Listen, s); err != nil {
+			log.Printf("mock identity provider stopped: %v", err)
+		}
+	}()
+}
+
+// Client returns an HTTP client that calls the provider in-process, without any network.
+func (s *Server) Client() *http.Client {
+	return &http.Client{Transport: roundTripper{s}}
+}
+
+type roundTripper struct {
+	h http.Handler
+}
+
+func (t roundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	rec := httptest.NewRecorder()
+	t.h.ServeHTTP(rec, r)
+	return rec.Result(), nil
+}
+
+func (s *Server) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                s.c.Issuer,
+		"authorization_endpoint":                s.c.Issuer + "/authorize",
+		"token_endpoint":                        s.c.Issuer + "/token",
+		"jwks_uri":                              s.c.Issuer + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+		"scopes_supported":                      []string{"openid", "email", "profile"},
+	})
+}
+
+func (s *Server) jwks(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, oidc.JSONWebKeySet{Keys: []oidc.JSONWebKey{oidc.NewJSONWebKey(s.kid, &s.key.PublicKey)}})
+}
+
+var pickUserPage = template.Must(template.New("pick").Parse(`<!DOCTYPE html>
+<html><head><title>Mock identity provider</title></head>
+<body style="font-family: sans-serif; max-width: 30em; margin: 4em auto">
+<h2>Sign in to {{.Issuer}}</h2>
+{{range .Users}}<p><a href="{{.Link}}">{{.Name}} &lt;{{.Email}}&gt;</a></p>
+{{else}}<p>No users configured.</p>{{end}}
+</body></html>`))
+
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	redirectUri := q.Get("redirect_uri")
+	if _, err := url.ParseRequestURI(redirectUri); err != nil {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+	if q.Get("response_type") != "code" || q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+		http.Error(w, "only response_type=code with S256 PKCE is supported", http.StatusBadRequest)
+		return
+	}
+	if s.c.ClientId != "" && q.Get("client_id") != s.c.ClientId {
+		http.Error(w, "unknown client_id", http.StatusBadRequest)
+		return
+	}
+
+	hint := q.Get("login_hint")
+	for _, u := range s.c.Users {
+		if hint == "" || (u.Subject != hint && u.Email != hint) {
+			continue
+		}
+		code := oidc.NewRandom()
+		s.mu.Lock()
+		s.grants[code] = &grant{
+			user:          u,
+			clientId:      q.Get("client_id"),
+			redirectUri:   redirectUri,
+			codeChallenge: q.Get("code_challenge"),
+			nonce:         q.Get("nonce"),
+			expires:       time.Now().Add(codeTTL),
+		}
+		s.mu.Unlock()
+		back := url.Values{"code": {code}, "state": {q.Get("state")}}
+		sep := "?"
+		if strings.Contains(redirectUri, "?") {
+			sep = "&"
+		}
+		http.Redirect(w, r, redirectUri+sep+back.Encode(), http.StatusFound)
+		return
+	}
+
+	// No user picked yet: list them, each linking back here with its login_hint
+	type option struct{ Name, Email, Link string }
+	opts := make([]option, 0, len(s.c.Users))
+	for _, u := range s.c.Users {
+		q.Set("login_hint", u.Subject)
+		opts = append(opts, option{Name: u.Name, Email: u.Email, Link: "/authorize?" + q.Encode()})
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_ = pickUserPage.Execute(w, map[string]any{"Issuer": s.c.Issuer, "Users": opts})
+}
+
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "authorization_code" {
+		tokenError(w, "unsupported_grant_type", "only authorization_code is supported")
+		return
+	}
+	code := r.PostForm.Get("code")
+	s.mu.Lock()
+	g := s.grants[code]
+	delete(s.grants, code)
+	s.mu.Unlock()
+	if g == nil || time.Now().After(g.expires) {
+		tokenError(w, "invalid_grant", "unknown or expired code")
+		return
+	}
+	clientId := r.PostForm.Get("client_id")
+	if id, _, ok := r.BasicAuth(); ok {
+		clientId, _ = url.QueryUnescape(id)
+	}
+	if clientId != g.clientId || r.PostForm.Get("redirect_uri") != g.redirectUri {
+		tokenError(w, "invalid_grant", "client_id or redirect_uri does not match the authorization request")
+		return
+	}
+	challenge := oidc.CodeChallenge(r.PostForm.Get("code_verifier"))
+	if subtle.ConstantTimeCompare([]byte(challenge), []byte(g.codeChallenge)) != 1 {
+		tokenError(w, "invalid_grant", "code_verifier does not match code_challenge")
+		return
+	}
+
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":            s.c.Issuer,
+		"sub":            g.user.Subject,
+		"aud":            g.clientId,
+		"iat":            now.Unix(),
+		"exp":            now.Add(5 * time.Minute).Unix(),
+		"nonce":          g.nonce,
+		"email":          g.user.Email,
+		"email_verified": g.user.EmailVerified,
+		"name":           g.user.Name,
+	})
+	token.Header["kid"] = s.kid
+	idToken, err := token.SignedString(s.key)
+	if err != nil {
+		tokenError(w, "server_error", err.Error())
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": oidc.NewRandom(),
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     idToken,
+	})
+}
+
+func tokenError(w http.ResponseWriter, code, description string) {
+	writeJSON(w, http.StatusBadRequest, map[string]string{"error": code, "error_description": description})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
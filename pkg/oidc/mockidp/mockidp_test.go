@@ -0,0 +1,139 @@
+package mockidp_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/archyhsh/gochat/pkg/oidc"
+	"github.com/archyhsh/gochat/pkg/oidc/mockidp"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	issuer      = "http://idp.test"
+	clientId    = "gochat"
+	redirectUrl = "http://app.test/oidc/callback"
+)
+
+func newProvider(t *testing.T) (*mockidp.Server, *oidc.Provider) {
+	t.Helper()
+	idp, err := mockidp.New(mockidp.Conf{
+		Issuer:   issuer,
+		ClientId: clientId,
+		Users:    []mockidp.User{{Subject: "alice", Email: "alice@example.com", EmailVerified: true, Name: "Alice"}},
+	})
+	if err != nil {
+		t.Fatalf("mockidp.New: %v", err)
+	}
+	p := oidc.NewProvider(oidc.ProviderConf{
+		Name:        "mock",
+		Issuer:      issuer,
+		ClientId:    clientId,
+		RedirectUrl: redirectUrl,
+	}, idp.Client())
+	return idp, p
+}
+
+// authorize signs alice in at the provider and returns the code it redirects back with
+func authorize(t *testing.T, idp *mockidp.Server, p *oidc.Provider, verifier, nonce string) string {
+	t.Helper()
+	ctx := context.Background()
+	authUrl, err := p.AuthURL(ctx, "state-1", nonce, verifier)
+	if err != nil {
+		t.Fatalf("AuthURL: %v", err)
+	}
+	client := idp.Client()
+	client.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+	resp, err := client.Get(authUrl + "&login_hint=alice")
+	if err != nil {
+		t.Fatalf("authorize: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		t.Fatalf("authorize: status %d, want a redirect", resp.StatusCode)
+	}
+	back, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		t.Fatalf("authorize: %v", err)
+	}
+	if !strings.HasPrefix(back.String(), redirectUrl+"?") {
+		t.Fatalf("redirected to %s, want %s", back, redirectUrl)
+	}
+	if got := back.Query().Get("state"); got != "state-1" {
+		t.Fatalf("state = %q, want state-1", got)
+	}
+	return back.Query().Get("code")
+}
+
+func TestAuthorizationCodeFlow(t *testing.T) {
+	idp, p := newProvider(t)
+	ctx := context.Background()
+	verifier, nonce := oidc.NewRandom(), oidc.NewRandom()
+
+	code := authorize(t, idp, p, verifier, nonce)
+	token, err := p.Exchange(ctx, code, verifier, nonce)
+	if err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+	if token.Subject != "alice" || token.Email != "alice@example.com" || !bool(token.EmailVerified) {
+		t.Errorf("id token = %+v, want alice with a verified email", token)
+	}
+
+	if _, err := p.Exchange(ctx, code, verifier, nonce); err == nil {
+		t.Error("redeemed the same code twice")
+	}
+}
+
+func TestExchangeRejectsBadNonce(t *testing.T) {
+	idp, p := newProvider(t)
+	verifier := oidc.NewRandom()
+	code := authorize(t, idp, p, verifier, "nonce-1")
+
+	_, err := p.Exchange(context.Background(), code, verifier, "nonce-2")
+	if !errors.Is(err, oidc.ErrInvalidIDToken) {
+		t.Errorf("Exchange with another nonce: err = %v, want ErrInvalidIDToken", err)
+	}
+}
+
+func TestExchangeRejectsBadVerifier(t *testing.T) {
+	idp, p := newProvider(t)
+	nonce := oidc.NewRandom()
+	code := authorize(t, idp, p, oidc.NewRandom(), nonce)
+
+	_, err := p.Exchange(context.Background(), code, oidc.NewRandom(), nonce)
+	if err == nil || !strings.Contains(err.Error(), "invalid_grant") {
+		t.Errorf("Exchange with another verifier: err = %v, want invalid_grant", err)
+	}
+}
+
+func TestVerifyRejectsUnknownKid(t *testing.T) {
+	_, p := newProvider(t)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   issuer,
+		"sub":   "alice",
+		"aud":   clientId,
+		"exp":   time.Now().Add(time.Minute).Unix(),
+		"nonce": "nonce-1",
+	})
+	token.Header["kid"] = "forged"
+	raw, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("SignedString: %v", err)
+	}
+
+	_, err = p.Verify(context.Background(), raw, "nonce-1")
+	if !errors.Is(err, oidc.ErrInvalidIDToken) || !strings.Contains(err.Error(), "unknown signing key") {
+		t.Errorf("Verify with an unknown kid: err = %v, want unknown signing key", err)
+	}
+}
@@ -0,0 +1,260 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var ErrInvalidIDToken = errors.New("invalid id token")
+
+// ProviderConf is an OpenID Connect provider users can sign in with.
+type ProviderConf struct {
+	Name         string // in URLs and stored with linked identities, so keep it once users signed in
+	DisplayName  string `json:",optional"` // shown on the sign-in button; defaults to Name
+	Issuer       string // serves the discovery document under /.well-known/openid-configuration
+	ClientId     string
+	ClientSecret string   `json:",optional"` // empty for public clients, which rely on PKCE alone
+	RedirectUrl  string   // client page that sends code and state back to the gateway
+	Scopes       []string `json:",optional"` // defaults to openid, email and profile
+}
+
+// IDToken holds the claims of a verified ID token.
+type IDToken struct {
+	Email             string    `json:"email"`
+	EmailVerified     boolClaim `json:"email_verified"`
+	Name              string    `json:"name"`
+	PreferredUsername string    `json:"preferred_username"`
+	Nonce             string    `json:"nonce"`
+	AuthorizedParty   string    `json:"azp"`
+	jwt.RegisteredClaims
+}
+
+// boolClaim accepts "true" as well, which some providers send for email_verified
+type boolClaim bool
+
+func (b *boolClaim) UnmarshalJSON(data []byte) error {
+	*b = boolClaim(string(data) == "true" || string(data) == `"true"`)
+	return nil
+}
+
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksUri               string `json:"jwks_uri"`
+}
+
+// jwksRefreshInterval limits refetching the provider's keys when a token names an unknown kid
+const jwksRefreshInterval = time.Minute
+
+// Provider runs the authorization code flow with PKCE against one provider. The discovery
+// document and signing keys are fetched on first use.
+type Provider struct {
+	c      ProviderConf
+	client *http.Client
+
+	mu          sync.Mutex
+	meta        *discovery
+	keys        map[string]*rsa.PublicKey
+	keysFetched time.Time
+}
+
+func NewProvider(c ProviderConf, client *http.Client) *Provider {
+	if c.DisplayName == "" {
+		c.DisplayName = c.Name
+	}
+	if len(c.Scopes) == 0 {
+		c.Scopes = []string{"openid", "email", "profile"}
+	}
+	return &Provider{c: c, client: client}
+}
+
+func (p *Provider) Name() string {
+	return p.c.Name
+}
+
+func (p *Provider) DisplayName() string {
+	return p.c.DisplayName
+}
+
+// AuthURL is where the user's browser is sent to sign in at the provider.
+func (p *Provider) AuthURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	q := url.Values{}
+	q.Set("response_type", "code")
+	q.Set("client_id", p.c.ClientId)
+	q.Set("redirect_uri", p.c.RedirectUrl)
+	q.Set("scope", strings.Join(p.c.Scopes, " "))
+	q.Set("state", state)
+	q.Set("nonce", nonce)
+	q.Set("code_challenge", CodeChallenge(codeVerifier))
+	q.Set("code_challenge_method", "S256")
+	sep := "?"
+	if strings.Contains(meta.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return meta.AuthorizationEndpoint + sep + q.Encode(), nil
+}
+
+// Exchange redeems the authorization code and returns the verified ID token, which must carry
+// the nonce the flow started with.
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*IDToken, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.c.RedirectUrl)
+	form.Set("client_id", p.c.ClientId)
+	form.Set("code_verifier", codeVerifier)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, meta.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.c.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.c.ClientId), url.QueryEscape(p.c.ClientSecret))
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var body struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&body); err != nil {
+		return nil, fmt.Errorf("token response: %w", err)
+	}
+	if body.Error != "" {
+		return nil, fmt.Errorf("token endpoint: %s: %s", body.Error, body.ErrorDescription)
+	}
+	if resp.StatusCode != http.StatusOK || body.IDToken == "" {
+		return nil, fmt.Errorf("token endpoint: status %d without id_token", resp.StatusCode)
+	}
+	return p.Verify(ctx, body.IDToken, nonce)
+}
+
+// Verify checks the ID token's signature against the provider's keys, and its issuer, audience,
+// expiry and nonce.
+func (p *Provider) Verify(ctx context.Context, rawIDToken, nonce string) (*IDToken, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var claims IDToken
+	_, err = jwt.ParseWithClaims(rawIDToken, &claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.key(ctx, meta, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithIssuer(meta.Issuer),
+		jwt.WithAudience(p.c.ClientId),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: no subject", ErrInvalidIDToken)
+	}
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != p.c.ClientId {
+		return nil, fmt.Errorf("%w: issued to %q", ErrInvalidIDToken, claims.AuthorizedParty)
+	}
+	return &claims, nil
+}
+
+func (p *Provider) discover(ctx context.Context) (*discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.meta != nil {
+		return p.meta, nil
+	}
+	var meta discovery
+	if err := p.getJSON(ctx, strings.TrimSuffix(p.c.Issuer, "/")+"/.well-known/openid-configuration", &meta); err != nil {
+		return nil, fmt.Errorf("discovery of %s: %w", p.c.Name, err)
+	}
+	if meta.Issuer != p.c.Issuer {
+		return nil, fmt.Errorf("discovery of %s: issuer %q does not match %q", p.c.Name, meta.Issuer, p.c.Issuer)
+	}
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JwksUri == "" {
+		return nil, fmt.Errorf("discovery of %s: endpoints missing", p.c.Name)
+	}
+	p.meta = &meta
+	return p.meta, nil
+}
+
+// key returns the signing key kid, refetching the key set when the provider rotated its keys
+func (p *Provider) key(ctx context.Context, meta *discovery, kid string) (*rsa.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if k := lookupKey(p.keys, kid); k != nil {
+		return k, nil
+	}
+	if time.Since(p.keysFetched) < jwksRefreshInterval {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	keys, err := p.fetchKeys(ctx, meta.JwksUri)
+	if err != nil {
+		return nil, err
+	}
+	p.keys, p.keysFetched = keys, time.Now()
+	if k := lookupKey(keys, kid); k != nil {
+		return k, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// lookupKey finds kid in keys; a token without kid is fine as long as there is a single key
+func lookupKey(keys map[string]*rsa.PublicKey, kid string) *rsa.PublicKey {
+	if k, ok := keys[kid]; ok {
+		return k
+	}
+	if kid == "" && len(keys) == 1 {
+		for _, k := range keys {
+			return k
+		}
+	}
+	return nil
+}
+
+func (p *Provider) getJSON(ctx context.Context, u string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: status %d", u, resp.StatusCode)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(v)
+}
@@ -0,0 +1,92 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// StateKeyPrefix holds the sign-ins waiting for the provider to redirect back, by state
+const StateKeyPrefix = "auth:oidc:state:"
+
+var ErrInvalidState = errors.New("invalid or expired oidc state")
+
+// LoginState is what a sign-in needs once the provider redirects back with the code.
+type LoginState struct {
+	Provider     string `json:"provider"`
+	CodeVerifier string `json:"code_verifier"`
+	Nonce        string `json:"nonce"`
+	DeviceID     string `json:"device_id"`
+	Platform     int32  `json:"platform"`
+}
+
+// NewRandom returns a URL-safe random string for states, nonces and PKCE code verifiers.
+func NewRandom() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// CodeChallenge is the S256 PKCE challenge of a code verifier (RFC 7636).
+func CodeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// StateStore keeps the pending sign-ins in Redis; each state can be redeemed once.
+type StateStore struct {
+	rdb *redis.Redis
+	ttl time.Duration
+}
+
+func NewStateStore(rdb *redis.Redis, ttl time.Duration) *StateStore {
+	return &StateStore{rdb: rdb, ttl: ttl}
+}
+
+// Save stores s under a new random state, which is returned.
+func (s *StateStore) Save(ctx context.Context, st *LoginState) (string, error) {
+	data, err := json.Marshal(st)
+	if err != nil {
+		return "", err
+	}
+	state := NewRandom()
+	if err := s.rdb.SetexCtx(ctx, StateKeyPrefix+state, string(data), int(s.ttl.Seconds())); err != nil {
+		return "", err
+	}
+	return state, nil
+}
+
+// takeStateScript reads and deletes the state at once, so a replayed callback finds nothing
+const takeStateScript = `
+	local v = redis.call("get", KEYS[1])
+	if v then
+		redis.call("del", KEYS[1])
+	end
+	return v
+`
+
+// Take returns and forgets the sign-in of state.
+func (s *StateStore) Take(ctx context.Context, state string) (*LoginState, error) {
+	if state == "" {
+		return nil, ErrInvalidState
+	}
+	res, err := s.rdb.EvalCtx(ctx, takeStateScript, []string{StateKeyPrefix + state})
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrInvalidState
+	}
+	if err != nil {
+		return nil, err
+	}
+	data, _ := res.(string)
+	var st LoginState
+	if data == "" || json.Unmarshal([]byte(data), &st) != nil {
+		return nil, ErrInvalidState
+	}
+	return &st, nil
+}
@@ -0,0 +1,173 @@
+package presence
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	// DeviceKeyPrefix holds one hash per user: device_id -> "<state>:<unix>"
+	DeviceKeyPrefix = "presence:devices:"
+	// LastSeenKeyPrefix holds the unix time the user was last seen on any device
+	LastSeenKeyPrefix = "presence:last_seen:"
+
+	StateOnline  = "online"
+	StateAway    = "away"
+	StateOffline = "offline"
+
+	// DefaultStaleAfter bounds how long a device counts as connected without a heartbeat, which
+	// covers gateways that died without reporting their disconnects
+	DefaultStaleAfter = 2 * time.Minute
+
+	lastSeenExpire = 90 * 24 * 3600
+)
+
+// setScript stores (or, for offline, removes) the device state and returns the previous one; an
+// empty state keeps the stored one, or online when there is none
+const setScript = `
+	local prev = redis.call("hget", KEYS[1], ARGV[1]) or ""
+	local state = ARGV[2]
+	if state == "" then
+		state = string.match(prev, "^([^:]+)") or "online"
+	end
+	if state == "offline" then
+		redis.call("hdel", KEYS[1], ARGV[1])
+	else
+		redis.call("hset", KEYS[1], ARGV[1], state .. ":" .. ARGV[3])
+		redis.call("expire", KEYS[1], ARGV[4])
+	end
+	redis.call("set", KEYS[2], ARGV[3], "EX", ARGV[5])
+	return prev
+`
+
+type DeviceState struct {
+	DeviceId  string
+	State     string
+	UpdatedAt int64 // unix
+}
+
+// Presence is the state of a user over all devices: online if any device is, else away if any
+// device is, else offline.
+type Presence struct {
+	UserId   int64
+	State    string
+	LastSeen int64 // unix, 0 when never seen
+	Devices  []DeviceState
+}
+
+// Store keeps presence in Redis. Devices that stopped heartbeating for staleAfter count as offline.
+type Store struct {
+	rdb        *redis.Redis
+	staleAfter time.Duration
+}
+
+func NewStore(rdb *redis.Redis, staleAfter time.Duration) *Store {
+	if staleAfter <= 0 {
+		staleAfter = DefaultStaleAfter
+	}
+	return &Store{rdb: rdb, staleAfter: staleAfter}
+}
+
+func deviceKey(userId int64) string {
+	return DeviceKeyPrefix + strconv.FormatInt(userId, 10)
+}
+
+func lastSeenKey(userId int64) string {
+	return LastSeenKeyPrefix + strconv.FormatInt(userId, 10)
+}
+
+// SetDevice records the state of one device and reports whether it differs from what was stored.
+func (s *Store) SetDevice(ctx context.Context, userId int64, deviceId, state string) (bool, error) {
+	prevState, err := s.set(ctx, userId, deviceId, state)
+	if err != nil {
+		return false, err
+	}
+	return prevState != state, nil
+}
+
+// Touch keeps the device at its current state, and reports whether it had gone stale (and so
+// comes back online).
+func (s *Store) Touch(ctx context.Context, userId int64, deviceId string) (bool, error) {
+	prevState, err := s.set(ctx, userId, deviceId, "")
+	if err != nil {
+		return false, err
+	}
+	return prevState == StateOffline, nil
+}
+
+// set runs setScript and returns the effective previous state of the device
+func (s *Store) set(ctx context.Context, userId int64, deviceId, state string) (string, error) {
+	now := time.Now().Unix()
+	res, err := s.rdb.EvalCtx(ctx, setScript, []string{deviceKey(userId), lastSeenKey(userId)},
+		deviceId, state, now, int(s.staleAfter.Seconds()), lastSeenExpire)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return "", err
+	}
+	prev, _ := res.(string)
+	prevState, ts, _ := strings.Cut(prev, ":")
+	updatedAt, _ := strconv.ParseInt(ts, 10, 64)
+	if prevState == "" || updatedAt < now-int64(s.staleAfter.Seconds()) {
+		prevState = StateOffline
+	}
+	return prevState, nil
+}
+
+// Get returns the presence of a user.
+func (s *Store) Get(ctx context.Context, userId int64) (*Presence, error) {
+	res, err := s.BatchGet(ctx, []int64{userId})
+	if err != nil {
+		return nil, err
+	}
+	return res[userId], nil
+}
+
+// BatchGet returns the presence of every listed user, offline ones included.
+func (s *Store) BatchGet(ctx context.Context, userIds []int64) (map[int64]*Presence, error) {
+	res := make(map[int64]*Presence, len(userIds))
+	if len(userIds) == 0 {
+		return res, nil
+	}
+
+	lastSeenKeys := make([]string, len(userIds))
+	for i, uid := range userIds {
+		lastSeenKeys[i] = lastSeenKey(uid)
+	}
+	lastSeen, err := s.rdb.MgetCtx(ctx, lastSeenKeys...)
+	if err != nil {
+		return nil, err
+	}
+
+	staleBefore := time.Now().Add(-s.staleAfter).Unix()
+	for i, uid := range userIds {
+		p := &Presence{UserId: uid, State: StateOffline}
+		if i < len(lastSeen) {
+			p.LastSeen, _ = strconv.ParseInt(lastSeen[i], 10, 64)
+		}
+		devices, err := s.rdb.HgetallCtx(ctx, deviceKey(uid))
+		if err != nil {
+			return nil, err
+		}
+		for deviceId, v := range devices {
+			state, ts, _ := strings.Cut(v, ":")
+			updatedAt, _ := strconv.ParseInt(ts, 10, 64)
+			if updatedAt < staleBefore {
+				continue
+			}
+			p.Devices = append(p.Devices, DeviceState{DeviceId: deviceId, State: state, UpdatedAt: updatedAt})
+			switch {
+			case state == StateOnline:
+				p.State = StateOnline
+			case state == StateAway && p.State == StateOffline:
+				p.State = StateAway
+			}
+			p.LastSeen = max(p.LastSeen, updatedAt)
+		}
+		res[uid] = p
+	}
+	return res, nil
+}
@@ -0,0 +1,115 @@
+package presence
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	// PublishedKeyPrefix remembers the last state announced for a user, shared by all gateways
+	PublishedKeyPrefix = "presence:published:"
+	DefaultDebounce    = 5 * time.Second
+
+	publishedExpire = 24 * 3600
+)
+
+// Publisher announces a user's new state to whoever should see it.
+type Publisher func(ctx context.Context, userId int64, state string) error
+
+// Tracker records device state changes and announces the resulting user state once it has been
+// stable for the debounce window. A connection that drops and comes back within the window is
+// never announced, and a flapping one is announced at most once per window.
+type Tracker struct {
+	store    *Store
+	rdb      *redis.Redis
+	debounce time.Duration
+	publish  Publisher
+
+	mu      sync.Mutex
+	pending map[int64]struct{}
+}
+
+func NewTracker(store *Store, debounce time.Duration, publish Publisher) *Tracker {
+	if debounce <= 0 {
+		debounce = DefaultDebounce
+	}
+	return &Tracker{
+		store:    store,
+		rdb:      store.rdb,
+		debounce: debounce,
+		publish:  publish,
+		pending:  make(map[int64]struct{}),
+	}
+}
+
+// Update records the state of a device.
+func (t *Tracker) Update(ctx context.Context, userId int64, deviceId, state string) {
+	changed, err := t.store.SetDevice(ctx, userId, deviceId, state)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("[Presence] failed to set %s of device %s of user %d: %v", state, deviceId, userId, err)
+		return
+	}
+	if changed {
+		t.schedule(userId)
+	}
+}
+
+// Touch keeps a device fresh on heartbeats without changing its state.
+func (t *Tracker) Touch(ctx context.Context, userId int64, deviceId string) {
+	changed, err := t.store.Touch(ctx, userId, deviceId)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("[Presence] failed to touch device %s of user %d: %v", deviceId, userId, err)
+		return
+	}
+	if changed {
+		t.schedule(userId)
+	}
+}
+
+func (t *Tracker) schedule(userId int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.pending[userId]; ok {
+		return
+	}
+	t.pending[userId] = struct{}{}
+	time.AfterFunc(t.debounce, func() {
+		t.mu.Lock()
+		delete(t.pending, userId)
+		t.mu.Unlock()
+		t.flush(context.Background(), userId)
+	})
+}
+
+func (t *Tracker) flush(ctx context.Context, userId int64) {
+	logger := logx.WithContext(ctx)
+	p, err := t.store.Get(ctx, userId)
+	if err != nil {
+		logger.Errorf("[Presence] failed to read presence of user %d: %v", userId, err)
+		return
+	}
+
+	// Gateways share the announced state, so a change seen by several of them is published once
+	key := PublishedKeyPrefix + strconv.FormatInt(userId, 10)
+	prev, err := t.rdb.GetSetCtx(ctx, key, p.State)
+	if err != nil {
+		logger.Errorf("[Presence] failed to swap announced state of user %d: %v", userId, err)
+		return
+	}
+	_ = t.rdb.ExpireCtx(ctx, key, publishedExpire)
+	if prev == "" {
+		prev = StateOffline
+	}
+	if prev == p.State {
+		return
+	}
+	if err := t.publish(ctx, userId, p.State); err != nil {
+		logger.Errorf("[Presence] failed to announce %s of user %d: %v", p.State, userId, err)
+	}
+}
@@ -0,0 +1,218 @@
+// Package ratelimit limits requests with token buckets kept in Redis, so every gateway draws from
+// the same budget.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/metric"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	KeyPrefix = "ratelimit:"
+
+	ByUser = "user"
+	ByIP   = "ip"
+
+	// Kinds of message sends, so private and group messages get separate budgets
+	KindPrivate = "private"
+	KindGroup   = "group"
+)
+
+// bucketScript takes one token from the bucket in KEYS[1], refilled at ARGV[1] tokens per
+// ARGV[2] millis up to ARGV[3]; it returns {allowed, millis until a token is available}
+const bucketScript = `
+	local limit = tonumber(ARGV[1])
+	local window = tonumber(ARGV[2])
+	local burst = tonumber(ARGV[3])
+	local now = tonumber(ARGV[4])
+
+	local state = redis.call("hmget", KEYS[1], "tokens", "ts")
+	local tokens = tonumber(state[1]) or burst
+	local ts = tonumber(state[2]) or now
+	if now > ts then
+		tokens = math.min(burst, tokens + (now - ts) * limit / window)
+	end
+
+	local allowed = 0
+	local retry = 0
+	if tokens >= 1 then
+		tokens = tokens - 1
+		allowed = 1
+	else
+		retry = math.ceil((1 - tokens) * window / limit)
+	end
+	redis.call("hset", KEYS[1], "tokens", tostring(tokens), "ts", now)
+	redis.call("pexpire", KEYS[1], math.ceil(burst * window / limit))
+	return {allowed, retry}
+`
+
+var metricRequests = metric.NewCounterVec(&metric.CounterVecOpts{
+	Namespace: "ratelimit",
+	Subsystem: "requests",
+	Name:      "total",
+	Help:      "rate limited requests, by rule and outcome.",
+	Labels:    []string{"rule", "result"},
+})
+
+// Rule limits one route to Limit requests per Window for each user or client IP, with bursts of up
+// to Burst. Route is "<METHOD> <path>", where the method may be left out and path segments
+// starting with ":" match any value; "*" matches every route.
+type Rule struct {
+	Name   string        `json:",optional"` // key and metric label, defaults to the route and kind
+	Route  string        `json:",default=*"`
+	Kind   string        `json:",optional"` // only message sends of this kind, private or group
+	By     string        `json:",default=user"`
+	Limit  int           `json:",default=60"`
+	Window time.Duration `json:",default=1m"`
+	Burst  int           `json:",optional"`
+}
+
+// Request is what rules are matched against. Kind is asked for only when a matching rule
+// depends on it, as it may mean reading the body.
+type Request struct {
+	Method string
+	Path   string
+	UserId int64 // 0 for anonymous requests
+	IP     string
+	Kind   func() string
+}
+
+// MessageKind returns the kind of a message sent to a conversation.
+func MessageKind(conversationId string, groupId int64) string {
+	if groupId > 0 || strings.HasPrefix(conversationId, "group_") {
+		return KindGroup
+	}
+	return KindPrivate
+}
+
+type rule struct {
+	Rule
+	method string
+	parts  []string
+}
+
+type Limiter struct {
+	rdb   *redis.Redis
+	rules []rule
+}
+
+func NewLimiter(rdb *redis.Redis, rules []Rule) *Limiter {
+	l := &Limiter{rdb: rdb}
+	for _, r := range rules {
+		if r.Limit <= 0 || r.Window <= 0 {
+			continue
+		}
+		if r.Burst <= 0 {
+			r.Burst = r.Limit
+		}
+		if r.Name == "" {
+			r.Name = strings.TrimSpace(r.Route + " " + r.Kind)
+		}
+		compiled := rule{Rule: r}
+		route := strings.TrimSpace(r.Route)
+		if method, path, ok := strings.Cut(route, " "); ok {
+			compiled.method = strings.ToUpper(method)
+			route = strings.TrimSpace(path)
+		}
+		if route != "*" {
+			compiled.parts = strings.Split(strings.Trim(route, "/"), "/")
+		}
+		l.rules = append(l.rules, compiled)
+	}
+	return l
+}
+
+func (r *rule) match(method string, parts []string) bool {
+	if r.method != "" && r.method != method {
+		return false
+	}
+	if r.parts == nil {
+		return true
+	}
+	if len(r.parts) != len(parts) {
+		return false
+	}
+	for i, p := range r.parts {
+		if !strings.HasPrefix(p, ":") && p != parts[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Allow takes a token from every rule matching the request. It returns false with the time to
+// wait as soon as one of them is exhausted. Redis failures let the request through.
+func (l *Limiter) Allow(ctx context.Context, req Request) (bool, time.Duration) {
+	if l == nil || len(l.rules) == 0 {
+		return true, 0
+	}
+	parts := strings.Split(strings.Trim(req.Path, "/"), "/")
+	kind := ""
+	kindKnown := false
+
+	for i := range l.rules {
+		r := &l.rules[i]
+		if !r.match(req.Method, parts) {
+			continue
+		}
+		if r.Kind != "" {
+			if !kindKnown && req.Kind != nil {
+				kind, kindKnown = req.Kind(), true
+			}
+			if r.Kind != kind {
+				continue
+			}
+		}
+
+		var subject string
+		switch {
+		case r.By == ByIP:
+			if req.IP == "" {
+				continue
+			}
+			subject = "ip:" + req.IP
+		case req.UserId > 0:
+			subject = fmt.Sprintf("user:%d", req.UserId)
+		case req.IP != "":
+			// Anonymous routes fall back to the client address
+			subject = "ip:" + req.IP
+		default:
+			continue
+		}
+
+		key := KeyPrefix + r.Name + ":" + subject
+		allowed, retry, err := l.take(ctx, key, &r.Rule)
+		if err != nil {
+			logx.WithContext(ctx).Errorf("[RateLimit] failed to check %s: %v", key, err)
+			metricRequests.Inc(r.Name, "error")
+			continue
+		}
+		if !allowed {
+			metricRequests.Inc(r.Name, "limited")
+			return false, retry
+		}
+		metricRequests.Inc(r.Name, "allowed")
+	}
+	return true, 0
+}
+
+func (l *Limiter) take(ctx context.Context, key string, r *Rule) (bool, time.Duration, error) {
+	res, err := l.rdb.EvalCtx(ctx, bucketScript, []string{key},
+		r.Limit, r.Window.Milliseconds(), r.Burst, time.Now().UnixMilli())
+	if err != nil {
+		return false, 0, err
+	}
+	vals, ok := res.([]interface{})
+	if !ok || len(vals) != 2 {
+		return false, 0, fmt.Errorf("unexpected script result %v", res)
+	}
+	allowed, _ := vals[0].(int64)
+	retry, _ := vals[1].(int64)
+	return allowed == 1, time.Duration(retry) * time.Millisecond, nil
+}
@@ -2,7 +2,10 @@ package response
 
 import (
 	"encoding/json"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 // Response 统一响应结构
@@ -19,6 +22,7 @@ const (
 	CodeUnauthorized = 401
 	CodeForbidden    = 403
 	CodeNotFound     = 404
+	CodeTooMany      = 429
 	CodeServerError  = 500
 )
 
@@ -29,6 +33,7 @@ var messages = map[int]string{
 	CodeUnauthorized: "unauthorized",
 	CodeForbidden:    "forbidden",
 	CodeNotFound:     "not found",
+	CodeTooMany:      "too many requests",
 	CodeServerError:  "internal server error",
 }
 
@@ -88,6 +93,16 @@ func NotFound(w http.ResponseWriter, message string) {
 	Error(w, http.StatusNotFound, CodeNotFound, message)
 }
 
+// TooManyRequests 429 错误，Retry-After 为需要等待的秒数
+func TooManyRequests(w http.ResponseWriter, retryAfter time.Duration) {
+	secs := int64(math.Ceil(retryAfter.Seconds()))
+	if secs < 1 {
+		secs = 1
+	}
+	w.Header().Set("Retry-After", strconv.FormatInt(secs, 10))
+	Error(w, http.StatusTooManyRequests, CodeTooMany, messages[CodeTooMany])
+}
+
 // ServerError 500 错误
 func ServerError(w http.ResponseWriter, message string) {
 	if message == "" {
@@ -0,0 +1,225 @@
+package router
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/metric"
+)
+
+const (
+	// GatewayNodesKey is the node registry: gateway address -> lease expiry (unix millis)
+	GatewayNodesKey = "route:gateway:nodes"
+	// GatewayUsersPrefix holds the users with route entries on a gateway, so a dead node's routes
+	// can be found without scanning every user
+	GatewayUsersPrefix = "route:gateway:users:"
+	// gatewayJanitorPrefix locks the purge of a dead node to one janitor
+	gatewayJanitorPrefix = "route:gateway:janitor:"
+
+	DefaultNodeLease     = 15 * time.Second
+	DefaultNodeHeartbeat = 5 * time.Second
+
+	janitorLockExpire = 60
+)
+
+var metricJanitorRoutes = metric.NewCounterVec(&metric.CounterVecOpts{
+	Namespace: "router",
+	Subsystem: "janitor",
+	Name:      "routes_total",
+	Help:      "route entries of dead gateways purged by the janitor.",
+	Labels:    []string{"gateway"},
+})
+
+// DeviceRef is a device that lost its last connection when its gateway died
+type DeviceRef struct {
+	UserID   int64
+	DeviceID string
+}
+
+// HeartbeatNode renews the lease of this gateway in the node registry, and advertises its
+// addresses again in case a janitor purged them.
+func (r *Router) HeartbeatNode(ctx context.Context, lease time.Duration) error {
+	if _, err := r.rdb.ZaddCtx(ctx, GatewayNodesKey, time.Now().Add(lease).UnixMilli(), r.serverAddr); err != nil {
+		return err
+	}
+	var err error
+	r.adverts.Range(func(key, addr any) bool {
+		err = r.rdb.SetCtx(ctx, key.(string), addr.(string))
+		return err == nil
+	})
+	return err
+}
+
+// DeregisterNode expires the lease of this gateway right away on shutdown, so the janitors of the
+// other gateways purge whatever routes its connections left behind.
+func (r *Router) DeregisterNode(ctx context.Context) error {
+	_, err := r.rdb.ZaddCtx(ctx, GatewayNodesKey, 0, r.serverAddr)
+	return err
+}
+
+// deadNodeSet returns the gateways whose lease ran out. Gateways missing from the registry, e.g.
+// after it was flushed or while they start up, are not in it, and neither is anything when the
+// registry cannot be read, so routes are only dropped for gateways known to be gone.
+func (r *Router) deadNodeSet(ctx context.Context) map[string]bool {
+	nodes, err := r.DeadNodes(ctx)
+	if err != nil {
+		return nil
+	}
+	dead := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		dead[node] = true
+	}
+	return dead
+}
+
+// DeadNodes returns the gateways whose lease ran out.
+func (r *Router) DeadNodes(ctx context.Context) ([]string, error) {
+	pairs, err := r.rdb.ZrangebyscoreWithScoresCtx(ctx, GatewayNodesKey, 0, time.Now().UnixMilli()-1)
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]string, 0, len(pairs))
+	for _, p := range pairs {
+		nodes = append(nodes, p.Key)
+	}
+	return nodes, nil
+}
+
+// PurgeNode removes every route entry of a dead gateway along with its registry entry and
+// advertised addresses. It returns the devices left without any connection, and does nothing when
+// another janitor is already purging the node.
+func (r *Router) PurgeNode(ctx context.Context, addr string) ([]DeviceRef, error) {
+	ok, err := r.rdb.SetnxExCtx(ctx, gatewayJanitorPrefix+addr, r.serverAddr, janitorLockExpire)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	users, err := r.rdb.SmembersCtx(ctx, GatewayUsersPrefix+addr)
+	if err != nil {
+		return nil, err
+	}
+
+	deadNodes := r.deadNodeSet(ctx)
+	now := time.Now().Unix()
+	var offline []DeviceRef
+	for _, member := range users {
+		userID, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			continue
+		}
+		key := UserRoutePrefix + member
+		entries, err := r.rdb.HgetallCtx(ctx, key)
+		if err != nil {
+			return offline, err
+		}
+
+		var dead []string
+		lost := make(map[string]bool)
+		connected := make(map[string]bool)
+		for connID, value := range entries {
+			e, ok := parseEntry(value)
+			switch {
+			case !ok:
+			case e.addr == addr:
+				dead = append(dead, connID)
+				lost[e.deviceID] = true
+			case e.expiry >= now && !deadNodes[e.addr]:
+				connected[e.deviceID] = true
+			}
+		}
+		if len(dead) == 0 {
+			continue
+		}
+		if _, err := r.rdb.HdelCtx(ctx, key, dead...); err != nil {
+			return offline, err
+		}
+		metricJanitorRoutes.Add(float64(len(dead)), addr)
+
+		for deviceID := range lost {
+			if deviceID != "" && !connected[deviceID] {
+				offline = append(offline, DeviceRef{UserID: userID, DeviceID: deviceID})
+			}
+		}
+	}
+
+	// A node that only stalled heartbeats again, advertising its addresses anew, and re-registers
+	// its connections on their next ping
+	if _, err := r.rdb.DelCtx(ctx, GatewayUsersPrefix+addr, GatewayRpcPrefix+addr, GatewayInternalPrefix+addr); err != nil {
+		return offline, err
+	}
+	_, err = r.rdb.ZremCtx(ctx, GatewayNodesKey, addr)
+	return offline, err
+}
+
+// Janitor keeps this gateway's node lease alive and purges the routes of dead gateways, so pushes
+// stop going to them within a lease and their users count as offline (and get offline pushes).
+type Janitor struct {
+	router    *Router
+	lease     time.Duration
+	interval  time.Duration
+	onOffline func(ctx context.Context, device DeviceRef)
+	logx.Logger
+}
+
+func NewJanitor(rt *Router, lease, interval time.Duration, onOffline func(ctx context.Context, device DeviceRef)) *Janitor {
+	if lease <= 0 {
+		lease = DefaultNodeLease
+	}
+	if interval <= 0 || interval >= lease {
+		interval = lease / 3
+	}
+	return &Janitor{
+		router:    rt,
+		lease:     lease,
+		interval:  interval,
+		onOffline: onOffline,
+		Logger:    logx.WithContext(context.Background()),
+	}
+}
+
+func (j *Janitor) Start(ctx context.Context) {
+	j.runOnce(ctx)
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			j.runOnce(ctx)
+		}
+	}
+}
+
+func (j *Janitor) runOnce(ctx context.Context) {
+	if err := j.router.HeartbeatNode(ctx, j.lease); err != nil {
+		j.Errorf("[Janitor] failed to renew node lease of %s: %v", j.router.serverAddr, err)
+	}
+
+	dead, err := j.router.DeadNodes(ctx)
+	if err != nil {
+		j.Errorf("[Janitor] failed to list dead nodes: %v", err)
+		return
+	}
+	for _, addr := range dead {
+		if addr == j.router.serverAddr {
+			continue
+		}
+		offline, err := j.router.PurgeNode(ctx, addr)
+		if err != nil {
+			j.Errorf("[Janitor] failed to purge routes of %s: %v", addr, err)
+		}
+		if len(offline) > 0 {
+			j.Infof("[Janitor] purged dead gateway %s, %d device(s) went offline", addr, len(offline))
+		}
+		if j.onOffline != nil {
+			for _, d := range offline {
+				j.onOffline(ctx, d)
+			}
+		}
+	}
+}
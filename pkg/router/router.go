@@ -3,6 +3,9 @@ package router
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/zeromicro/go-zero/core/stores/redis"
@@ -10,12 +13,17 @@ import (
 
 const (
 	UserRoutePrefix = "route:user:"
-	DefaultExpiry   = 30 * time.Minute // Reduced TTL for better accuracy with heartbeats
+	// GatewayRpcPrefix maps a gateway address, as stored in user routes, to its push rpc address
+	GatewayRpcPrefix = "route:gateway:rpc:"
+	// GatewayInternalPrefix maps a gateway address to the listener serving its internal http routes
+	GatewayInternalPrefix = "route:gateway:internal:"
+	DefaultExpiry         = 30 * time.Minute // Reduced TTL for better accuracy with heartbeats
 )
 
 type Router struct {
 	rdb        *redis.Redis
 	serverAddr string
+	adverts    sync.Map // advert key -> address, written again on every node heartbeat
 }
 
 func NewRouter(rdb *redis.Redis, serverAddr string) *Router {
@@ -25,12 +33,51 @@ func NewRouter(rdb *redis.Redis, serverAddr string) *Router {
 	}
 }
 
-func (r *Router) Register(ctx context.Context, userID int64) error {
-	key := fmt.Sprintf("%s%d", UserRoutePrefix, userID)
+// A user's route is a hash of connection ID -> "<gateway addr>|<expiry unix>|<device id>", so
+// every connection on every gateway has its own entry and lease. The key itself expires with the
+// newest lease, expired entries are skipped on read and pruned on the next register. Entries of
+// gateways whose node lease ran out are skipped as well, see node.go.
+const (
+	registerScript = `
+		if redis.call("type", KEYS[1]).ok == "string" then
+			redis.call("del", KEYS[1]) -- single-address route of an older gateway
+		end
+		redis.call("hset", KEYS[1], ARGV[1], ARGV[2])
+		local entries = redis.call("hgetall", KEYS[1])
+		for i = 1, #entries, 2 do
+			local expiry = tonumber(string.match(entries[i + 1], "^[^|]*|(%d+)"))
+			if expiry ~= nil and expiry < tonumber(ARGV[3]) then
+				redis.call("hdel", KEYS[1], entries[i])
+			end
+		end
+		redis.call("expire", KEYS[1], ARGV[4])
+		redis.call("sadd", KEYS[2], ARGV[5])
+		redis.call("expire", KEYS[2], ARGV[4])
+		return 1
+	`
+	batchScript = `
+		local res = {}
+		for i, key in ipairs(KEYS) do
+			if redis.call("type", key).ok == "hash" then
+				res[i] = redis.call("hgetall", key)
+			else
+				res[i] = {}
+			end
+		end
+		return res
+	`
+)
+
+// Register adds or renews the route entry of one connection on this gateway; heartbeats call it
+// again to extend the lease.
+func (r *Router) Register(ctx context.Context, userID int64, connID, deviceID string) error {
+	keys := []string{fmt.Sprintf("%s%d", UserRoutePrefix, userID), GatewayUsersPrefix + r.serverAddr}
+	now := time.Now()
+	entry := fmt.Sprintf("%s|%d|%s", r.serverAddr, now.Add(DefaultExpiry).Unix(), deviceID)
 
 	var lastErr error
 	for i := 0; i < 3; i++ {
-		err := r.rdb.SetexCtx(ctx, key, r.serverAddr, int(DefaultExpiry.Seconds()))
+		_, err := r.rdb.EvalCtx(ctx, registerScript, keys, connID, entry, now.Unix(), int(DefaultExpiry.Seconds()), userID)
 		if err == nil {
 			return nil
 		}
@@ -40,18 +87,14 @@ func (r *Router) Register(ctx context.Context, userID int64) error {
 	return fmt.Errorf("failed to register route after retries: %v", lastErr)
 }
 
-func (r *Router) Unregister(ctx context.Context, userID int64) error {
+// Unregister removes the route entry of one connection; connection IDs are unique, so the
+// entries of the user's other connections, on this or other gateways, are left alone.
+func (r *Router) Unregister(ctx context.Context, userID int64, connID string) error {
 	key := fmt.Sprintf("%s%d", UserRoutePrefix, userID)
-	script := `
-		if redis.call("get", KEYS[1]) == ARGV[1] then
-			return redis.call("del", KEYS[1])
-		else
-			return 0
-		end
-	`
+
 	var lastErr error
 	for i := 0; i < 3; i++ {
-		_, err := r.rdb.EvalCtx(ctx, script, []string{key}, r.serverAddr)
+		_, err := r.rdb.HdelCtx(ctx, key, connID)
 		if err == nil {
 			return nil
 		}
@@ -61,24 +104,36 @@ func (r *Router) Unregister(ctx context.Context, userID int64) error {
 	return lastErr
 }
 
-func (r *Router) Find(ctx context.Context, userID int64) (string, error) {
+// FindGateways returns every gateway the user is connected to.
+func (r *Router) FindGateways(ctx context.Context, userID int64) ([]string, error) {
 	key := fmt.Sprintf("%s%d", UserRoutePrefix, userID)
+	deadNodes := r.deadNodeSet(ctx)
 
 	var lastErr error
 	for i := 0; i < 2; i++ {
-		addr, err := r.rdb.GetCtx(ctx, key)
+		entries, err := r.rdb.HgetallCtx(ctx, key)
 		if err == nil {
-			return addr, nil
+			return liveGateways(entries, time.Now().Unix(), deadNodes), nil
 		}
 		lastErr = err
 		time.Sleep(30 * time.Millisecond)
 	}
-	return "", lastErr
+	return nil, lastErr
+}
+
+// Find returns one of the gateways the user is connected to, "" when they are offline.
+func (r *Router) Find(ctx context.Context, userID int64) (string, error) {
+	gateways, err := r.FindGateways(ctx, userID)
+	if err != nil || len(gateways) == 0 {
+		return "", err
+	}
+	return gateways[0], nil
 }
 
-func (r *Router) BatchFind(ctx context.Context, userIDs []int64) (map[int64]string, error) {
+// BatchFind returns the gateways of every connected user; offline users are left out.
+func (r *Router) BatchFind(ctx context.Context, userIDs []int64) (map[int64][]string, error) {
 	if len(userIDs) == 0 {
-		return make(map[int64]string), nil
+		return make(map[int64][]string), nil
 	}
 
 	keys := make([]string, len(userIDs))
@@ -86,27 +141,108 @@ func (r *Router) BatchFind(ctx context.Context, userIDs []int64) (map[int64]stri
 		keys[i] = fmt.Sprintf("%s%d", UserRoutePrefix, uid)
 	}
 
-	var addrs []string
+	deadNodes := r.deadNodeSet(ctx)
+	var val interface{}
 	var lastErr error
 	for i := 0; i < 2; i++ {
-		vals, err := r.rdb.MgetCtx(ctx, keys...)
+		v, err := r.rdb.EvalCtx(ctx, batchScript, keys)
 		if err == nil {
-			addrs = vals
+			val, lastErr = v, nil
 			break
 		}
 		lastErr = err
 		time.Sleep(30 * time.Millisecond)
 	}
-
-	if lastErr != nil && len(addrs) == 0 {
+	if lastErr != nil {
 		return nil, lastErr
 	}
 
-	res := make(map[int64]string)
-	for i, addr := range addrs {
-		if addr != "" {
-			res[userIDs[i]] = addr
+	now := time.Now().Unix()
+	res := make(map[int64][]string)
+	hashes, _ := val.([]interface{})
+	for i, h := range hashes {
+		if i >= len(userIDs) {
+			break
+		}
+		flat, _ := h.([]interface{})
+		entries := make(map[string]string, len(flat)/2)
+		for j := 0; j+1 < len(flat); j += 2 {
+			field, _ := flat[j].(string)
+			value, _ := flat[j+1].(string)
+			entries[field] = value
+		}
+		if gateways := liveGateways(entries, now, deadNodes); len(gateways) > 0 {
+			res[userIDs[i]] = gateways
 		}
 	}
 	return res, nil
 }
+
+// routeEntry is one parsed value of a route hash
+type routeEntry struct {
+	addr     string
+	expiry   int64 // unix
+	deviceID string
+}
+
+func parseEntry(value string) (routeEntry, bool) {
+	parts := strings.SplitN(value, "|", 3)
+	if len(parts) < 2 || parts[0] == "" {
+		return routeEntry{}, false
+	}
+	expiry, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return routeEntry{}, false
+	}
+	e := routeEntry{addr: parts[0], expiry: expiry}
+	if len(parts) == 3 {
+		e.deviceID = parts[2]
+	}
+	return e, true
+}
+
+// liveGateways returns the distinct gateways of the unexpired entries of a route hash, leaving
+// out the dead gateways.
+func liveGateways(entries map[string]string, now int64, deadNodes map[string]bool) []string {
+	var gateways []string
+	seen := make(map[string]bool)
+	for _, value := range entries {
+		e, ok := parseEntry(value)
+		if !ok || e.expiry < now || deadNodes[e.addr] {
+			continue
+		}
+		addr := e.addr
+		if !seen[addr] {
+			seen[addr] = true
+			gateways = append(gateways, addr)
+		}
+	}
+	return gateways
+}
+
+// RegisterRpcAddr advertises the push rpc address of this gateway under its route address.
+func (r *Router) RegisterRpcAddr(ctx context.Context, rpcAddr string) error {
+	return r.advertise(ctx, GatewayRpcPrefix+r.serverAddr, rpcAddr)
+}
+
+// FindRpcAddr resolves the push rpc address of the gateway found in a user route.
+func (r *Router) FindRpcAddr(ctx context.Context, gatewayAddr string) (string, error) {
+	return r.rdb.GetCtx(ctx, GatewayRpcPrefix+gatewayAddr)
+}
+
+// RegisterInternalAddr advertises the internal http address of this gateway under its route address.
+func (r *Router) RegisterInternalAddr(ctx context.Context, internalAddr string) error {
+	return r.advertise(ctx, GatewayInternalPrefix+r.serverAddr, internalAddr)
+}
+
+// advertise publishes an address of this gateway and remembers it, so HeartbeatNode restores it
+// after a janitor purged the node while its heartbeats stalled.
+func (r *Router) advertise(ctx context.Context, key, addr string) error {
+	r.adverts.Store(key, addr)
+	return r.rdb.SetCtx(ctx, key, addr)
+}
+
+// FindInternalAddr resolves the internal http address of the gateway found in a user route.
+func (r *Router) FindInternalAddr(ctx context.Context, gatewayAddr string) (string, error) {
+	return r.rdb.GetCtx(ctx, GatewayInternalPrefix+gatewayAddr)
+}
@@ -0,0 +1,160 @@
+package verify
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// Purposes a code is issued for; a code only verifies the purpose it was issued for
+const (
+	PurposePasswordReset = "password_reset"
+	PurposeVerifyEmail   = "verify_email"
+	PurposeVerifyPhone   = "verify_phone"
+)
+
+const (
+	// CodeKeyPrefix holds one hash per purpose and subject: hash, target and attempts
+	CodeKeyPrefix = "verify:code:"
+	// CooldownKeyPrefix is set while a new code may not be requested yet
+	CooldownKeyPrefix = "verify:cooldown:"
+)
+
+var (
+	ErrInvalidCode     = errors.New("invalid or expired code")
+	ErrTooManyAttempts = errors.New("too many attempts")
+	ErrResendTooSoon   = errors.New("code requested too recently")
+)
+
+type CodeConf struct {
+	Length      int           `json:",default=6"`
+	TTL         time.Duration `json:",default=10m"`
+	MaxAttempts int           `json:",default=5"`
+	ResendAfter time.Duration `json:",default=1m"`
+}
+
+// CodeStore issues numeric one-time codes. A code is single-use, expires after TTL and is
+// dropped after MaxAttempts wrong guesses; only its hash is stored.
+type CodeStore struct {
+	rdb *redis.Redis
+	c   CodeConf
+}
+
+func NewCodeStore(rdb *redis.Redis, c CodeConf) *CodeStore {
+	return &CodeStore{rdb: rdb, c: c}
+}
+
+func codeKey(purpose string, subject int64) string {
+	return fmt.Sprintf("%s%s:%d", CodeKeyPrefix, purpose, subject)
+}
+
+func cooldownKey(purpose string, subject int64) string {
+	return fmt.Sprintf("%s%s:%d", CooldownKeyPrefix, purpose, subject)
+}
+
+func hashCode(key, code string) string {
+	sum := sha256.Sum256([]byte(key + "|" + code))
+	return hex.EncodeToString(sum[:])
+}
+
+// Issue creates a code for the subject (a user id), replacing any earlier one, and records the
+// target it is sent to.
+func (s *CodeStore) Issue(ctx context.Context, purpose string, subject int64, target string) (string, error) {
+	ok, err := s.rdb.SetnxExCtx(ctx, cooldownKey(purpose, subject), "1", int(s.c.ResendAfter.Seconds()))
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", ErrResendTooSoon
+	}
+
+	code, err := randomDigits(s.c.Length)
+	if err != nil {
+		return "", err
+	}
+	key := codeKey(purpose, subject)
+	if _, err := s.rdb.DelCtx(ctx, key); err != nil {
+		return "", err
+	}
+	if err := s.rdb.HmsetCtx(ctx, key, map[string]string{
+		"hash":     hashCode(key, code),
+		"target":   target,
+		"attempts": "0",
+	}); err != nil {
+		return "", err
+	}
+	if err := s.rdb.ExpireCtx(ctx, key, int(s.c.TTL.Seconds())); err != nil {
+		return "", err
+	}
+	return code, nil
+}
+
+// verifyScript counts the attempt and consumes the code on a match or on the last allowed attempt
+const verifyScript = `
+	local hash = redis.call("hget", KEYS[1], "hash")
+	if not hash then
+		return {"invalid", ""}
+	end
+	local attempts = redis.call("hincrby", KEYS[1], "attempts", 1)
+	if attempts > tonumber(ARGV[2]) then
+		redis.call("del", KEYS[1])
+		return {"locked", ""}
+	end
+	local target = redis.call("hget", KEYS[1], "target") or ""
+	if hash == ARGV[1] then
+		redis.call("del", KEYS[1])
+		return {"ok", target}
+	end
+	if attempts == tonumber(ARGV[2]) then
+		redis.call("del", KEYS[1])
+		return {"locked", ""}
+	end
+	return {"invalid", ""}
+`
+
+// Verify consumes the code and returns the target it was sent to.
+func (s *CodeStore) Verify(ctx context.Context, purpose string, subject int64, code string) (string, error) {
+	if code == "" {
+		return "", ErrInvalidCode
+	}
+	key := codeKey(purpose, subject)
+	res, err := s.rdb.EvalCtx(ctx, verifyScript, []string{key}, hashCode(key, code), s.c.MaxAttempts)
+	if err != nil {
+		return "", err
+	}
+	vals, _ := res.([]interface{})
+	if len(vals) != 2 {
+		return "", ErrInvalidCode
+	}
+	result, _ := vals[0].(string)
+	target, _ := vals[1].(string)
+	switch result {
+	case "ok":
+		return target, nil
+	case "locked":
+		return "", ErrTooManyAttempts
+	}
+	return "", ErrInvalidCode
+}
+
+func randomDigits(n int) (string, error) {
+	if n <= 0 {
+		n = 6
+	}
+	buf := make([]byte, n)
+	for i := range buf {
+		d, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+		buf[i] = byte('0' + d.Int64())
+	}
+	return string(buf), nil
+}
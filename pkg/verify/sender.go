@@ -0,0 +1,115 @@
+package verify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// Channels a code can be delivered through
+const (
+	ChannelEmail = "email"
+	ChannelSMS   = "sms"
+)
+
+// ErrNoSender means no adapter is configured for the message's channel.
+var ErrNoSender = errors.New("no sender for channel")
+
+// Message is one code delivery to one address.
+type Message struct {
+	Channel string `json:"channel"`
+	To      string `json:"to"` // email address or phone number
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+// Sender delivers verification messages.
+type Sender interface {
+	Send(ctx context.Context, m *Message) error
+}
+
+// Conf configures the adapters; SMTP and SMS stay disabled without a host or url. File, when
+// set, receives every message no real adapter takes; otherwise they are logged.
+type Conf struct {
+	SMTP    SMTPConf      `json:",optional"`
+	SMS     SMSConf       `json:",optional"`
+	File    string        `json:",optional"` // e.g. /tmp/gochat-codes.log for local use
+	Timeout time.Duration `json:",default=10s"`
+}
+
+// Dispatcher routes each message to the adapter of its channel, falling back to the file or log
+// sender so codes can still be read off a development setup.
+type Dispatcher struct {
+	byChannel map[string]Sender
+	fallback  Sender
+}
+
+func NewSender(c Conf) *Dispatcher {
+	d := &Dispatcher{byChannel: make(map[string]Sender)}
+	if c.SMTP.Host != "" {
+		d.byChannel[ChannelEmail] = NewSMTPSender(c.SMTP, c.Timeout)
+	}
+	if c.SMS.Url != "" {
+		d.byChannel[ChannelSMS] = NewSMSSender(c.SMS, &http.Client{Timeout: c.Timeout})
+	}
+	if c.File != "" {
+		d.fallback = NewFileSender(c.File)
+	} else {
+		d.fallback = LogSender{}
+	}
+	return d
+}
+
+func (d *Dispatcher) Send(ctx context.Context, m *Message) error {
+	if sender, ok := d.byChannel[m.Channel]; ok {
+		return sender.Send(ctx, m)
+	}
+	if d.fallback != nil {
+		return d.fallback.Send(ctx, m)
+	}
+	return ErrNoSender
+}
+
+// LogSender writes messages, codes included, to the service log. Only for local use.
+type LogSender struct{}
+
+func (LogSender) Send(ctx context.Context, m *Message) error {
+	logx.WithContext(ctx).Infof("[verify] %s to %s: %s | %s", m.Channel, m.To, m.Subject, m.Body)
+	return nil
+}
+
+// FileSender appends every message as a JSON line to a file. Only for local use.
+type FileSender struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileSender(path string) *FileSender {
+	return &FileSender{path: path}
+}
+
+func (f *FileSender) Send(_ context.Context, m *Message) error {
+	line, err := json.Marshal(struct {
+		*Message
+		SentAt int64 `json:"sent_at"`
+	}{m, time.Now().Unix()})
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = file.Write(append(line, '\n'))
+	return err
+}
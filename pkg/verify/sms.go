@@ -0,0 +1,57 @@
+package verify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/archyhsh/gochat/pkg/auth"
+)
+
+type SMSConf struct {
+	Url    string `json:",optional"` // SMS gateway accepting {"from", "to", "body"} as JSON
+	Secret string `json:",optional"` // signs requests like internal calls when set
+	From   string `json:",optional"` // sender id or number
+}
+
+// SMSSender posts text messages to an HTTP SMS gateway.
+type SMSSender struct {
+	c      SMSConf
+	client *http.Client
+	signer *auth.Signer
+}
+
+func NewSMSSender(c SMSConf, client *http.Client) *SMSSender {
+	s := &SMSSender{c: c, client: client}
+	if c.Secret != "" {
+		s.signer = auth.NewSigner(c.Secret)
+	}
+	return s
+}
+
+func (s *SMSSender) Send(ctx context.Context, m *Message) error {
+	body, err := json.Marshal(map[string]string{"from": s.c.From, "to": m.To, "body": m.Body})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.c.Url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.signer != nil {
+		s.signer.SignRequest(req, body)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("sms: status %d", resp.StatusCode)
+	}
+	return nil
+}
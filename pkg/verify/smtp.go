@@ -0,0 +1,86 @@
+package verify
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type SMTPConf struct {
+	Host     string `json:",optional"`
+	Port     int    `json:",default=587"`
+	Username string `json:",optional"`
+	Password string `json:",optional"`
+	From     string `json:",optional"` // e.g. GoChat <no-reply@example.com>
+}
+
+// SMTPSender sends plain text mail, upgrading to TLS whenever the server offers STARTTLS.
+type SMTPSender struct {
+	c       SMTPConf
+	timeout time.Duration
+}
+
+func NewSMTPSender(c SMTPConf, timeout time.Duration) *SMTPSender {
+	return &SMTPSender{c: c, timeout: timeout}
+}
+
+func (s *SMTPSender) Send(ctx context.Context, m *Message) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", net.JoinHostPort(s.c.Host, strconv.Itoa(s.c.Port)))
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	client, err := smtp.NewClient(conn, s.c.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.c.Host}); err != nil {
+			return err
+		}
+	}
+	if s.c.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.c.Username, s.c.Password, s.c.Host)); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(envelopeAddress(s.c.From)); err != nil {
+		return err
+	}
+	if err := client.Rcpt(m.To); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "From: %s\r\nTo: %s\r\nSubject: %s\r\nMIME-Version: 1.0\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n%s\r\n",
+		s.c.From, m.To, mime.QEncoding.Encode("utf-8", m.Subject), m.Body); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// envelopeAddress takes the bare address out of "Name <address>"
+func envelopeAddress(from string) string {
+	if i := strings.LastIndex(from, "<"); i >= 0 {
+		return strings.TrimSuffix(from[i+1:], ">")
+	}
+	return from
+}
@@ -0,0 +1,157 @@
+// Package wsproto is the WebSocket protocol between clients and gateways. Frames are
+// IncomingMessage (client -> server) and OutgoingMessage (server -> client) envelopes, encoded
+// as binary protobuf or as JSON depending on the subprotocol negotiated through
+// Sec-WebSocket-Protocol.
+package wsproto
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// SubprotocolProto carries protobuf envelopes in binary frames
+	SubprotocolProto = "gochat.v1.proto"
+	// SubprotocolJSON carries the same envelopes as protojson in text frames
+	SubprotocolJSON = "gochat.v1.json"
+
+	// MsgTypeTyping is the signal relaying TypingPayload through the push path
+	MsgTypeTyping = 20
+
+	// Error codes of ErrorPayload
+	ErrCodeBadFrame     = 400
+	ErrCodeUnauthorized = 401
+	ErrCodeForbidden    = 403
+	ErrCodeRateLimited  = 429
+	ErrCodeInternal     = 500
+)
+
+// Subprotocols lists the supported subprotocols, preferred first. Clients that offer none of
+// them get the legacy JSON push format.
+var Subprotocols = []string{SubprotocolProto, SubprotocolJSON}
+
+var ErrUnexpectedFrame = errors.New("unexpected frame type")
+
+// Codec encodes and decodes the envelopes of one subprotocol.
+type Codec interface {
+	Name() string
+	Decode(frameType int, data []byte) (*pb.IncomingMessage, error)
+	Encode(msg *pb.OutgoingMessage) (frameType int, data []byte, err error)
+}
+
+// CodecFor returns the codec of a negotiated subprotocol, nil when there is none.
+func CodecFor(subprotocol string) Codec {
+	switch subprotocol {
+	case SubprotocolProto:
+		return protoCodec{}
+	case SubprotocolJSON:
+		return jsonCodec{}
+	}
+	return nil
+}
+
+type protoCodec struct{}
+
+func (protoCodec) Name() string { return SubprotocolProto }
+
+func (protoCodec) Decode(frameType int, data []byte) (*pb.IncomingMessage, error) {
+	if frameType != websocket.BinaryMessage {
+		return nil, ErrUnexpectedFrame
+	}
+	msg := &pb.IncomingMessage{}
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func (protoCodec) Encode(msg *pb.OutgoingMessage) (int, []byte, error) {
+	data, err := proto.Marshal(msg)
+	return websocket.BinaryMessage, data, err
+}
+
+var (
+	jsonMarshal   = protojson.MarshalOptions{UseProtoNames: true}
+	jsonUnmarshal = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+
+type jsonCodec struct{}
+
+func (jsonCodec) Name() string { return SubprotocolJSON }
+
+func (jsonCodec) Decode(frameType int, data []byte) (*pb.IncomingMessage, error) {
+	if frameType != websocket.TextMessage {
+		return nil, ErrUnexpectedFrame
+	}
+	msg := &pb.IncomingMessage{}
+	if err := jsonUnmarshal.Unmarshal(data, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func (jsonCodec) Encode(msg *pb.OutgoingMessage) (int, []byte, error) {
+	data, err := jsonMarshal.Marshal(msg)
+	return websocket.TextMessage, data, err
+}
+
+// Error builds the reply to a frame that could not be handled.
+func Error(traceId string, code int32, message string) *pb.OutgoingMessage {
+	return &pb.OutgoingMessage{
+		Type:    pb.OutgoingMessage_TYPE_ERROR,
+		TraceId: traceId,
+		Payload: &pb.OutgoingMessage_ErrorMsg{ErrorMsg: &pb.ErrorPayload{Code: code, Message: message}},
+	}
+}
+
+// FromChat wraps a pushed message: user messages become TYPE_CHAT, typing signals TYPE_TYPING and
+// every other signal (msg_type >= 10) TYPE_SYSTEM with its fields in extra.
+func FromChat(m *pb.ChatMessage) *pb.OutgoingMessage {
+	switch {
+	case m.MsgType < 10:
+		return &pb.OutgoingMessage{
+			Type:    pb.OutgoingMessage_TYPE_CHAT,
+			Payload: &pb.OutgoingMessage_ChatMsg{ChatMsg: m},
+		}
+	case m.MsgType == MsgTypeTyping:
+		return &pb.OutgoingMessage{
+			Type: pb.OutgoingMessage_TYPE_TYPING,
+			Payload: &pb.OutgoingMessage_TypingMsg{TypingMsg: &pb.TypingPayload{
+				ConversationId: m.ConversationId,
+				IsTyping:       m.Content == "1",
+				UserId:         m.SenderId,
+			}},
+		}
+	}
+
+	extra := map[string]string{
+		"msg_type":  strconv.Itoa(int(m.MsgType)),
+		"msg_id":    m.MsgId,
+		"sender_id": strconv.FormatInt(m.SenderId, 10),
+		"timestamp": strconv.FormatInt(m.Timestamp, 10),
+	}
+	if m.ConversationId != "" {
+		extra["conversation_id"] = m.ConversationId
+	}
+	if m.GroupId > 0 {
+		extra["group_id"] = strconv.FormatInt(m.GroupId, 10)
+	}
+	if m.SenderInfoVersion > 0 {
+		extra["sender_info_version"] = strconv.FormatInt(m.SenderInfoVersion, 10)
+	}
+	if m.GroupMetaVersion > 0 {
+		extra["group_meta_version"] = strconv.FormatInt(m.GroupMetaVersion, 10)
+	}
+	if m.RelationVersion > 0 {
+		extra["relation_version"] = strconv.FormatInt(m.RelationVersion, 10)
+	}
+	return &pb.OutgoingMessage{
+		Type:    pb.OutgoingMessage_TYPE_SYSTEM,
+		Payload: &pb.OutgoingMessage_SystemMsg{SystemMsg: &pb.SystemPayload{Content: m.Content, Extra: extra}},
+	}
+}
@@ -26,6 +26,8 @@ type (
 	GetGroupInfoResponse        = pb.GetGroupInfoResponse
 	GetGroupListRequest         = pb.GetGroupListRequest
 	GetGroupListResponse        = pb.GetGroupListResponse
+	GetGroupMemberIdsRequest    = pb.GetGroupMemberIdsRequest
+	GetGroupMemberIdsResponse   = pb.GetGroupMemberIdsResponse
 	GetGroupMembersRequest      = pb.GetGroupMembersRequest
 	GetGroupMembersResponse     = pb.GetGroupMembersResponse
 	GetGroupRequestsRequest     = pb.GetGroupRequestsRequest
@@ -70,6 +72,8 @@ type (
 		GetGroupRequests(ctx context.Context, in *GetGroupRequestsRequest, opts ...grpc.CallOption) (*GetGroupRequestsResponse, error)
 		HandleGroupRequest(ctx context.Context, in *HandleGroupRequestRequest, opts ...grpc.CallOption) (*HandleGroupRequestResponse, error)
 		GetGroupsByIds(ctx context.Context, in *GetGroupsByIdsRequest, opts ...grpc.CallOption) (*GetGroupsByIdsResponse, error)
+		// Internal: compact member list for push fan-out, no membership check
+		GetGroupMemberIds(ctx context.Context, in *GetGroupMemberIdsRequest, opts ...grpc.CallOption) (*GetGroupMemberIdsResponse, error)
 	}
 
 	defaultGroupService struct {
@@ -167,3 +171,9 @@ func (m *defaultGroupService) GetGroupsByIds(ctx context.Context, in *GetGroupsB
 	client := pb.NewGroupServiceClient(m.cli.Conn())
 	return client.GetGroupsByIds(ctx, in, opts...)
 }
+
+// Internal: compact member list for push fan-out, no membership check
+func (m *defaultGroupService) GetGroupMemberIds(ctx context.Context, in *GetGroupMemberIdsRequest, opts ...grpc.CallOption) (*GetGroupMemberIdsResponse, error) {
+	client := pb.NewGroupServiceClient(m.cli.Conn())
+	return client.GetGroupMemberIds(ctx, in, opts...)
+}
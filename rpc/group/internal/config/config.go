@@ -14,6 +14,11 @@ type Config struct {
 	Kafka struct {
 		Brokers []string
 		Topic   string
+		// UserTopic carries the user service's events; deleted accounts are cleaned up from it
+		UserTopic string `json:",default=user-topic"`
+		GroupID   string `json:",default=group-rpc-consumer-group"`
 	}
 	UserRpc zrpc.RpcClientConf
+	// RelationRpc tells whether users are friends, for users accepting group invitations from friends only
+	RelationRpc zrpc.RpcClientConf
 }
@@ -46,17 +46,19 @@ func (l *CreateGroupLogic) CreateGroup(in *pb.CreateGroupRequest) (*pb.CreateGro
 		return nil, status.Error(codes.Unauthenticated, "invalid user_id in metadata")
 	}
 	var groupId int64
+	memberVersion := time.Now().UnixNano()
 
 	// Use TransactCtx to ensure atomicity of group creation and adding owner as member
 	err = l.svcCtx.SqlConn.TransactCtx(l.ctx, func(ctx context.Context, session sqlx.Session) error {
 		res, err := l.svcCtx.GroupModel.Insert(ctx, &model.Group{
-			Name:        in.Name,
-			Avatar:      in.Avatar,
-			Description: in.Description,
-			OwnerId:     ownerId,
-			MaxMembers:  500,
-			MemberCount: 1,
-			Status:      1,
+			Name:          in.Name,
+			Avatar:        in.Avatar,
+			Description:   in.Description,
+			OwnerId:       ownerId,
+			MaxMembers:    500,
+			MemberCount:   1,
+			Status:        1,
+			MemberVersion: memberVersion,
 		})
 		if err != nil {
 			return err
@@ -81,11 +83,12 @@ func (l *CreateGroupLogic) CreateGroup(in *pb.CreateGroupRequest) (*pb.CreateGro
 
 	if l.svcCtx.Producer != nil {
 		event := map[string]interface{}{
-			"type":      "group_event",
-			"action":    "create",
-			"group_id":  groupId,
-			"user_id":   ownerId,
-			"timestamp": time.Now().Unix(),
+			"type":           "group_event",
+			"action":         "create",
+			"group_id":       groupId,
+			"user_id":        ownerId,
+			"member_version": memberVersion,
+			"timestamp":      time.Now().Unix(),
 		}
 		data, _ := json.Marshal(event)
 		key := strconv.FormatInt(groupId, 10)
@@ -0,0 +1,53 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/archyhsh/gochat/rpc/group/internal/svc"
+	"github.com/archyhsh/gochat/rpc/group/model"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetGroupMemberIdsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetGroupMemberIdsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetGroupMemberIdsLogic {
+	return &GetGroupMemberIdsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Internal: compact member list for push fan-out, no membership check
+func (l *GetGroupMemberIdsLogic) GetGroupMemberIds(in *pb.GetGroupMemberIdsRequest) (*pb.GetGroupMemberIdsResponse, error) {
+	// Read the version first: if membership changes in between, the ids are newer than the
+	// version and the change event will still invalidate the caller's cache.
+	version, err := l.svcCtx.GroupModel.FindMemberVersion(l.ctx, in.GroupId)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, status.Error(codes.NotFound, "group not found")
+		}
+		l.Errorf("GetGroupMemberIds failed to query version: groupID=%d, error=%v", in.GroupId, err)
+		return nil, status.Error(codes.Internal, "failed to query group")
+	}
+
+	ids, err := l.svcCtx.GroupMemberModel.FindMemberIdsByGroupId(l.ctx, in.GroupId)
+	if err != nil {
+		l.Errorf("GetGroupMemberIds failed to query DB: groupID=%d, error=%v", in.GroupId, err)
+		return nil, status.Error(codes.Internal, "failed to query group members")
+	}
+
+	return &pb.GetGroupMemberIdsResponse{
+		Base:          &pb.BaseResponse{Code: 200, Message: "Success"},
+		MemberIds:     ids,
+		MemberVersion: version,
+	}, nil
+}
@@ -98,8 +98,8 @@ func (l *HandleGroupRequestLogic) HandleGroupRequest(in *pb.HandleGroupRequestRe
 			if err != nil {
 				return err
 			}
-			updateGroupSql := "update `group` set member_count = member_count + 1, meta_version = ? where id = ?"
-			_, err = session.ExecCtx(ctx, updateGroupSql, version, group.Id)
+			updateGroupSql := "update `group` set member_count = member_count + 1, meta_version = ?, member_version = ? where id = ?"
+			_, err = session.ExecCtx(ctx, updateGroupSql, version, version, group.Id)
 			if err != nil {
 				return err
 			}
@@ -116,12 +116,13 @@ func (l *HandleGroupRequestLogic) HandleGroupRequest(in *pb.HandleGroupRequestRe
 			action = "join"
 		}
 		event := map[string]interface{}{
-			"type":      "group_event",
-			"action":    action,
-			"group_id":  req.GroupId,
-			"user_id":   req.UserId,
-			"version":   finalMetaVersion,
-			"timestamp": time.Now().Unix(),
+			"type":           "group_event",
+			"action":         action,
+			"group_id":       req.GroupId,
+			"user_id":        req.UserId,
+			"version":        finalMetaVersion,
+			"member_version": finalMetaVersion,
+			"timestamp":      time.Now().Unix(),
 		}
 		data, _ := json.Marshal(event)
 		_ = l.svcCtx.Producer.Send(l.ctx, []byte(strconv.FormatInt(req.GroupId, 10)), data)
@@ -11,6 +11,7 @@ import (
 	"github.com/archyhsh/gochat/rpc/pb"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -31,6 +32,19 @@ func NewInviteMembersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Inv
 }
 
 func (l *InviteMembersLogic) InviteMembers(in *pb.InviteMembersRequest) (*pb.InviteMembersResponse, error) {
+	md, ok := metadata.FromIncomingContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	userIdStrs := md.Get("user_id")
+	if len(userIdStrs) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "user_id not found in metadata")
+	}
+	userId, err := strconv.ParseInt(userIdStrs[0], 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid user_id in metadata")
+	}
+
 	// 1. Fetch Group Details
 	group, err := l.svcCtx.GroupModel.FindOne(l.ctx, in.GroupId)
 	if err != nil {
@@ -49,7 +63,12 @@ func (l *InviteMembersLogic) InviteMembers(in *pb.InviteMembersRequest) (*pb.Inv
 		return nil, status.Error(codes.ResourceExhausted, "invitation would exceed group member limit")
 	}
 
-	// 3. Transactional Update
+	// 3. Privacy Check: invitees decide who may add them to groups
+	if err := l.checkPolicies(in.GroupId, userId, in.MemberIds); err != nil {
+		return nil, err
+	}
+
+	// 4. Transactional Update
 	err = l.svcCtx.SqlConn.TransactCtx(l.ctx, func(ctx context.Context, session sqlx.Session) error {
 		now := time.Now()
 		version := now.UnixNano()
@@ -82,6 +101,7 @@ func (l *InviteMembersLogic) InviteMembers(in *pb.InviteMembersRequest) (*pb.Inv
 			// Update Group Count and Version
 			group.MemberCount += int64(addedCount)
 			group.MetaVersion = version
+			group.MemberVersion = version
 			return l.svcCtx.GroupModel.Update(ctx, group)
 		}
 		return nil
@@ -92,16 +112,17 @@ func (l *InviteMembersLogic) InviteMembers(in *pb.InviteMembersRequest) (*pb.Inv
 		return nil, status.Error(codes.Internal, "internal database error")
 	}
 
-	// 4. Async Notification via Kafka
+	// 5. Async Notification via Kafka
 	if l.svcCtx.Producer != nil {
 		event := map[string]interface{}{
-			"type":       "group_event",
-			"action":     "invite",
-			"group_id":   in.GroupId,
-			"user_id":    0, // System/Inviter (could pass actor_id if available)
-			"member_ids": in.MemberIds,
-			"version":    group.MetaVersion,
-			"timestamp":  time.Now().Unix(),
+			"type":           "group_event",
+			"action":         "invite",
+			"group_id":       in.GroupId,
+			"user_id":        userId,
+			"member_ids":     in.MemberIds,
+			"version":        group.MetaVersion,
+			"member_version": group.MemberVersion,
+			"timestamp":      time.Now().Unix(),
 		}
 		data, _ := json.Marshal(event)
 		_ = l.svcCtx.Producer.Send(l.ctx, []byte(strconv.FormatInt(in.GroupId, 10)), data)
@@ -111,3 +132,46 @@ func (l *InviteMembersLogic) InviteMembers(in *pb.InviteMembersRequest) (*pb.Inv
 		Base: &pb.BaseResponse{Code: 200, Message: "Success"},
 	}, nil
 }
+
+// checkPolicies rejects the invitation when an invitee who is not a member yet does not let the
+// inviter add them to groups
+func (l *InviteMembersLogic) checkPolicies(groupId, inviterId int64, memberIds []int64) error {
+	var inviteeIds []int64
+	for _, memberId := range memberIds {
+		if memberId == inviterId {
+			continue
+		}
+		exists, err := l.svcCtx.GroupMemberModel.FindMemberByGroupIdAndUserId(l.ctx, groupId, memberId)
+		if err != nil && err != model.ErrNotFound {
+			return status.Error(codes.Internal, err.Error())
+		}
+		if exists == nil {
+			inviteeIds = append(inviteeIds, memberId)
+		}
+	}
+	if len(inviteeIds) == 0 {
+		return nil
+	}
+
+	resp, err := l.svcCtx.UserRpc.GetContactPolicies(l.ctx, &pb.GetContactPoliciesRequest{UserIds: inviteeIds})
+	if err != nil {
+		l.Errorf("failed to get contact policies: %v", err)
+		return status.Error(codes.Internal, "failed to check privacy settings")
+	}
+	for _, policy := range resp.Policies {
+		switch policy.GroupInvite {
+		case pb.Audience_AUDIENCE_NOBODY:
+			return status.Errorf(codes.PermissionDenied, "user %d cannot be added to groups", policy.UserId)
+		case pb.Audience_AUDIENCE_FRIENDS:
+			friend, err := l.svcCtx.RelationRpc.CheckFriend(l.ctx, &pb.CheckFriendRequest{UserId: policy.UserId, FriendId: inviterId})
+			if err != nil {
+				l.Errorf("failed to check friendship of %d and %d: %v", policy.UserId, inviterId, err)
+				return status.Error(codes.Internal, "failed to check privacy settings")
+			}
+			if !friend.IsFriend || friend.IsBlocked {
+				return status.Errorf(codes.PermissionDenied, "user %d can only be added to groups by friends", policy.UserId)
+			}
+		}
+	}
+	return nil
+}
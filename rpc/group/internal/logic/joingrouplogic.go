@@ -76,6 +76,7 @@ func (l *JoinGroupLogic) JoinGroup(in *pb.JoinGroupRequest) (*pb.JoinGroupRespon
 		}, nil
 	}
 
+	memberVersion := time.Now().UnixNano()
 	err = l.svcCtx.SqlConn.TransactCtx(l.ctx, func(ctx context.Context, session sqlx.Session) error {
 		_, err := l.svcCtx.GroupMemberModel.Insert(ctx, &model.GroupMember{
 			GroupId:  in.GroupId,
@@ -86,7 +87,7 @@ func (l *JoinGroupLogic) JoinGroup(in *pb.JoinGroupRequest) (*pb.JoinGroupRespon
 		if err != nil {
 			return err
 		}
-		_, err = session.ExecCtx(ctx, "update `group` set member_count = member_count + 1 where id = ?", in.GroupId)
+		_, err = session.ExecCtx(ctx, "update `group` set member_count = member_count + 1, member_version = ? where id = ?", memberVersion, in.GroupId)
 		return err
 	})
 
@@ -97,12 +98,13 @@ func (l *JoinGroupLogic) JoinGroup(in *pb.JoinGroupRequest) (*pb.JoinGroupRespon
 
 	if l.svcCtx.Producer != nil {
 		event := map[string]interface{}{
-			"type":      "group_event",
-			"action":    "join",
-			"group_id":  in.GroupId,
-			"user_id":   userId,
-			"intro":     in.Message,
-			"timestamp": time.Now().Unix(),
+			"type":           "group_event",
+			"action":         "join",
+			"group_id":       in.GroupId,
+			"user_id":        userId,
+			"intro":          in.Message,
+			"member_version": memberVersion,
+			"timestamp":      time.Now().Unix(),
 		}
 		data, _ := json.Marshal(event)
 		key := strconv.FormatInt(in.GroupId, 10)
@@ -51,6 +51,7 @@ func (l *KickGroupMemberLogic) KickGroupMember(in *pb.KickGroupMemberRequest) (*
 	if member == nil || member.Role != 2 {
 		return nil, status.Error(codes.PermissionDenied, "only group owner can kick members")
 	}
+	memberVersion := time.Now().UnixNano()
 	err = l.svcCtx.SqlConn.TransactCtx(l.ctx, func(ctx context.Context, session sqlx.Session) error {
 		member, err := l.svcCtx.GroupMemberModel.FindMemberByGroupIdAndUserId(ctx, in.GroupId, in.MemberId)
 		if err != nil {
@@ -60,7 +61,7 @@ func (l *KickGroupMemberLogic) KickGroupMember(in *pb.KickGroupMemberRequest) (*
 		if err != nil {
 			return err
 		}
-		_, err = session.ExecCtx(ctx, "update `group` set member_count = member_count - 1 where id = ?", in.GroupId)
+		_, err = session.ExecCtx(ctx, "update `group` set member_count = member_count - 1, member_version = ? where id = ?", memberVersion, in.GroupId)
 		return err
 	})
 
@@ -70,11 +71,12 @@ func (l *KickGroupMemberLogic) KickGroupMember(in *pb.KickGroupMemberRequest) (*
 	}
 	if l.svcCtx.Producer != nil {
 		event := map[string]interface{}{
-			"type":      "group_event",
-			"action":    "kick",
-			"group_id":  in.GroupId,
-			"user_id":   in.MemberId,
-			"timestamp": time.Now().Unix(),
+			"type":           "group_event",
+			"action":         "kick",
+			"member_version": memberVersion,
+			"group_id":       in.GroupId,
+			"user_id":        in.MemberId,
+			"timestamp":      time.Now().Unix(),
 		}
 		data, _ := json.Marshal(event)
 		key := strconv.FormatInt(in.GroupId, 10)
@@ -44,6 +44,7 @@ func (l *QuitGroupLogic) QuitGroup(in *pb.QuitGroupRequest) (*pb.QuitGroupRespon
 		return nil, status.Error(codes.Unauthenticated, "invalid user_id in metadata")
 	}
 
+	memberVersion := time.Now().UnixNano()
 	err = l.svcCtx.SqlConn.TransactCtx(l.ctx, func(ctx context.Context, session sqlx.Session) error {
 		member, err := l.svcCtx.GroupMemberModel.FindMemberByGroupIdAndUserId(ctx, in.GroupId, userID)
 		if err != nil {
@@ -55,7 +56,7 @@ func (l *QuitGroupLogic) QuitGroup(in *pb.QuitGroupRequest) (*pb.QuitGroupRespon
 			return err
 		}
 
-		_, err = session.ExecCtx(ctx, "update `group` set member_count = member_count - 1 where id = ?", in.GroupId)
+		_, err = session.ExecCtx(ctx, "update `group` set member_count = member_count - 1, member_version = ? where id = ?", memberVersion, in.GroupId)
 		return err
 	})
 
@@ -66,11 +67,12 @@ func (l *QuitGroupLogic) QuitGroup(in *pb.QuitGroupRequest) (*pb.QuitGroupRespon
 
 	if l.svcCtx.Producer != nil {
 		event := map[string]interface{}{
-			"type":      "group_event",
-			"action":    "quit",
-			"group_id":  in.GroupId,
-			"user_id":   userID,
-			"timestamp": time.Now().Unix(),
+			"type":           "group_event",
+			"action":         "quit",
+			"member_version": memberVersion,
+			"group_id":       in.GroupId,
+			"user_id":        userID,
+			"timestamp":      time.Now().Unix(),
 		}
 		data, _ := json.Marshal(event)
 		key := strconv.FormatInt(in.GroupId, 10)
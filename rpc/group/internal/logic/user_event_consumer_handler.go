@@ -0,0 +1,152 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/archyhsh/gochat/rpc/group/internal/svc"
+	"github.com/archyhsh/gochat/rpc/group/model"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/IBM/sarama"
+)
+
+// UserEventConsumerHandler takes deleted accounts out of their groups and confirms it to the user
+// service, which announces the deletion again until it got the confirmation. Groups they owned
+// pass to the next member in line, or are dismissed when nobody is left.
+type UserEventConsumerHandler struct {
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUserEventConsumerHandler(svcCtx *svc.ServiceContext) *UserEventConsumerHandler {
+	return &UserEventConsumerHandler{
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(context.Background()),
+	}
+}
+
+func (h *UserEventConsumerHandler) Handle(ctx context.Context, message *sarama.ConsumerMessage) error {
+	var event struct {
+		Type   string `json:"type"`
+		Action string `json:"action"`
+		UserId int64  `json:"user_id"`
+	}
+	if err := json.Unmarshal(message.Value, &event); err != nil {
+		h.Errorf("[UserEvent] drop undecodable event at offset %d: %v", message.Offset, err)
+		return nil
+	}
+	if event.Type != "user_event" || event.Action != "account_deleted" || event.UserId <= 0 {
+		return nil
+	}
+
+	if err := h.removeUser(ctx, event.UserId); err != nil {
+		h.Errorf("[UserEvent] failed to remove deleted user %d from groups: %v", event.UserId, err)
+		return err
+	}
+	if _, err := h.svcCtx.UserRpc.CompleteDeletionStep(ctx, &pb.CompleteDeletionStepRequest{
+		UserId: event.UserId,
+		Step:   "group",
+	}); err != nil {
+		h.Errorf("[UserEvent] failed to confirm deletion of user %d: %v", event.UserId, err)
+		return err
+	}
+	h.Infof("[UserEvent] removed deleted user %d from groups", event.UserId)
+	return nil
+}
+
+func (h *UserEventConsumerHandler) removeUser(ctx context.Context, userId int64) error {
+	groups, err := h.svcCtx.GroupModel.FindGroupsByUserId(ctx, userId)
+	if err != nil {
+		return err
+	}
+	for _, group := range groups {
+		if err := h.leaveGroup(ctx, group, userId); err != nil {
+			return err
+		}
+	}
+
+	requests, err := h.svcCtx.GroupRequestModel.FindByUserId(ctx, userId)
+	if err != nil {
+		return err
+	}
+	for _, r := range requests {
+		if err := h.svcCtx.GroupRequestModel.Delete(ctx, r.Id); err != nil && !errors.Is(err, model.ErrNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *UserEventConsumerHandler) leaveGroup(ctx context.Context, group *model.Group, userId int64) error {
+	members, err := h.svcCtx.GroupMemberModel.FindMembersByGroupId(ctx, group.Id)
+	if err != nil {
+		return err
+	}
+	var member, successor *model.GroupMember
+	for _, m := range members {
+		switch {
+		case m.UserId == userId:
+			member = m
+		case group.OwnerId == userId && nextOwner(m, successor):
+			successor = m
+		}
+	}
+	if member == nil {
+		return nil
+	}
+
+	memberVersion := time.Now().UnixNano()
+	removed, err := h.svcCtx.GroupModel.RemoveMember(ctx, group, member, successor, memberVersion)
+	if err != nil || !removed {
+		return err
+	}
+
+	event := map[string]interface{}{
+		"type":           "group_event",
+		"action":         "quit",
+		"member_version": memberVersion,
+		"group_id":       group.Id,
+		"user_id":        userId,
+		"timestamp":      time.Now().Unix(),
+	}
+	switch {
+	case group.OwnerId != userId:
+	case successor != nil:
+		event["owner_id"] = successor.UserId
+		h.Infof("[UserEvent] group %d passed from deleted user %d to %d", group.Id, userId, successor.UserId)
+	default:
+		event = map[string]interface{}{
+			"type":      "group_event",
+			"action":    "dismiss",
+			"group_id":  group.Id,
+			"timestamp": time.Now().Unix(),
+		}
+		h.Infof("[UserEvent] group %d of deleted user %d dismissed, no members left", group.Id, userId)
+	}
+	if h.svcCtx.Producer != nil {
+		data, _ := json.Marshal(event)
+		key := strconv.FormatInt(group.Id, 10)
+		_ = h.svcCtx.Producer.Send(ctx, []byte(key), data)
+	}
+	return nil
+}
+
+// nextOwner reports whether m takes over the group rather than current: admins before members,
+// then whoever joined first
+func nextOwner(m, current *model.GroupMember) bool {
+	if current == nil {
+		return true
+	}
+	if m.Role != current.Role {
+		return m.Role > current.Role
+	}
+	if !m.JoinedAt.Equal(current.JoinedAt) {
+		return m.JoinedAt.Before(current.JoinedAt)
+	}
+	return m.Id < current.Id
+}
@@ -107,3 +107,9 @@ func (s *GroupServiceServer) GetGroupsByIds(ctx context.Context, in *pb.GetGroup
 	l := logic.NewGetGroupsByIdsLogic(ctx, s.svcCtx)
 	return l.GetGroupsByIds(in)
 }
+
+// Internal: compact member list for push fan-out, no membership check
+func (s *GroupServiceServer) GetGroupMemberIds(ctx context.Context, in *pb.GetGroupMemberIdsRequest) (*pb.GetGroupMemberIdsResponse, error) {
+	l := logic.NewGetGroupMemberIdsLogic(ctx, s.svcCtx)
+	return l.GetGroupMemberIds(in)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/archyhsh/gochat/pkg/messaging"
 	"github.com/archyhsh/gochat/rpc/group/internal/config"
 	"github.com/archyhsh/gochat/rpc/group/model"
+	"github.com/archyhsh/gochat/rpc/relation/relationservice"
 	"github.com/archyhsh/gochat/rpc/user/userservice"
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
@@ -19,6 +20,7 @@ type ServiceContext struct {
 	GroupRequestModel model.GroupRequestModel
 	Producer          *messaging.ReliableProducer
 	UserRpc           userservice.UserService
+	RelationRpc       relationservice.RelationService
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -36,5 +38,6 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		GroupRequestModel: model.NewGroupRequestModel(sqlConn, c.Cache),
 		Producer:          producer,
 		UserRpc:           userservice.NewUserService(zrpc.MustNewClient(c.UserRpc)),
+		RelationRpc:       relationservice.NewRelationService(zrpc.MustNewClient(c.RelationRpc)),
 	}
 }
@@ -17,6 +17,7 @@ type (
 		FindMembersByGroupId(ctx context.Context, groupId int64) ([]*GroupMember, error)
 		FindMemberByGroupIdAndUserId(ctx context.Context, groupId int64, userId int64) (*GroupMember, error)
 		UpdateNickname(ctx context.Context, groupId int64, userId int64, nickname string) error
+		FindMemberIdsByGroupId(ctx context.Context, groupId int64) ([]int64, error)
 	}
 
 	customGroupMemberModel struct {
@@ -48,3 +49,9 @@ func (m *customGroupMemberModel) UpdateNickname(ctx context.Context, groupId int
 	_, err := m.ExecNoCacheCtx(ctx, query, nickname, groupId, userId)
 	return err
 }
+
+func (m *customGroupMemberModel) FindMemberIdsByGroupId(ctx context.Context, groupId int64) ([]int64, error) {
+	var ids []int64
+	err := m.QueryRowsNoCacheCtx(ctx, &ids, "select user_id from `group_member` where group_id = ?", groupId)
+	return ids, err
+}
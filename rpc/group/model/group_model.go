@@ -22,6 +22,8 @@ type (
 		CheckOwner(ctx context.Context, groupId int64) (int64, error)
 		FindGroupsByOwner(ctx context.Context, ownerId int64) ([]*Group, error)
 		FindByIds(ctx context.Context, ids []int64) ([]*Group, error)
+		FindMemberVersion(ctx context.Context, groupId int64) (int64, error)
+		RemoveMember(ctx context.Context, group *Group, member, successor *GroupMember, memberVersion int64) (bool, error)
 	}
 
 	customGroupModel struct {
@@ -89,3 +91,64 @@ func (m *customGroupModel) FindByIds(ctx context.Context, ids []int64) ([]*Group
 	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
 	return resp, err
 }
+
+func (m *customGroupModel) FindMemberVersion(ctx context.Context, groupId int64) (int64, error) {
+	query := fmt.Sprintf("SELECT `member_version` FROM %s WHERE id = ?", m.table)
+	var version int64
+	err := m.QueryRowNoCacheCtx(ctx, &version, query, groupId)
+	if err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+// RemoveMember takes member out of the group. When member owns it, the group passes to successor,
+// or is closed when there is none. It reports false when member had left already.
+func (m *customGroupModel) RemoveMember(ctx context.Context, group *Group, member, successor *GroupMember, memberVersion int64) (bool, error) {
+	removed := false
+	err := m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		res, err := session.ExecCtx(ctx, "delete from `group_member` where `id` = ?", member.Id)
+		if err != nil {
+			return err
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return nil
+		}
+		removed = true
+
+		var query string
+		var args []any
+		switch {
+		case group.OwnerId != member.UserId:
+			query = fmt.Sprintf("update %s set `member_count` = `member_count` - 1, `member_version` = ? where `id` = ?", m.table)
+			args = []any{memberVersion, group.Id}
+		case successor != nil:
+			if _, err := session.ExecCtx(ctx, "update `group_member` set `role` = 2 where `id` = ?", successor.Id); err != nil {
+				return err
+			}
+			query = fmt.Sprintf("update %s set `owner_id` = ?, `member_count` = `member_count` - 1, `member_version` = ? where `id` = ?", m.table)
+			args = []any{successor.UserId, memberVersion, group.Id}
+		default:
+			query = fmt.Sprintf("update %s set `status` = 0, `member_count` = 0, `member_version` = ? where `id` = ?", m.table)
+			args = []any{memberVersion, group.Id}
+		}
+		_, err = session.ExecCtx(ctx, query, args...)
+		return err
+	})
+	if err != nil || !removed {
+		return removed, err
+	}
+
+	keys := []string{
+		fmt.Sprintf("%s%v", cacheGroupIdPrefix, group.Id),
+		fmt.Sprintf("%s%v", cacheGroupNamePrefix, group.Name),
+		fmt.Sprintf("%s%v", cacheGroupMemberIdPrefix, member.Id),
+		fmt.Sprintf("%s%v:%v", cacheGroupMemberGroupIdUserIdPrefix, member.GroupId, member.UserId),
+	}
+	if successor != nil {
+		keys = append(keys,
+			fmt.Sprintf("%s%v", cacheGroupMemberIdPrefix, successor.Id),
+			fmt.Sprintf("%s%v:%v", cacheGroupMemberGroupIdUserIdPrefix, successor.GroupId, successor.UserId))
+	}
+	return true, m.DelCacheCtx(ctx, keys...)
+}
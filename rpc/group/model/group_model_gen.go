@@ -43,18 +43,19 @@ type (
 	}
 
 	Group struct {
-		Id           int64     `db:"id"`
-		Name         string    `db:"name"`
-		Avatar       string    `db:"avatar"` // avatur_url
-		Description  string    `db:"description"`
-		OwnerId      int64     `db:"owner_id"` // foreign key to user.id
-		MaxMembers   int64     `db:"max_members"`
-		MemberCount  int64     `db:"member_count"`
-		Announcement string    `db:"announcement"`
-		Status       int64     `db:"status"`       // status: 0closed 1normal
-		MetaVersion  int64     `db:"meta_version"` // group name and announcement version
-		CreatedAt    time.Time `db:"created_at"`
-		UpdatedAt    time.Time `db:"updated_at"`
+		Id            int64     `db:"id"`
+		Name          string    `db:"name"`
+		Avatar        string    `db:"avatar"` // avatur_url
+		Description   string    `db:"description"`
+		OwnerId       int64     `db:"owner_id"` // foreign key to user.id
+		MaxMembers    int64     `db:"max_members"`
+		MemberCount   int64     `db:"member_count"`
+		Announcement  string    `db:"announcement"`
+		Status        int64     `db:"status"`         // status: 0closed 1normal
+		MetaVersion   int64     `db:"meta_version"`   // group name and announcement version
+		MemberVersion int64     `db:"member_version"` // group membership version
+		CreatedAt     time.Time `db:"created_at"`
+		UpdatedAt     time.Time `db:"updated_at"`
 	}
 )
 
@@ -121,8 +122,8 @@ func (m *defaultGroupModel) Insert(ctx context.Context, data *Group) (sql.Result
 	groupIdKey := fmt.Sprintf("%s%v", cacheGroupIdPrefix, data.Id)
 	groupNameKey := fmt.Sprintf("%s%v", cacheGroupNamePrefix, data.Name)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, groupRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.Name, data.Avatar, data.Description, data.OwnerId, data.MaxMembers, data.MemberCount, data.Announcement, data.Status, data.MetaVersion)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, groupRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.Name, data.Avatar, data.Description, data.OwnerId, data.MaxMembers, data.MemberCount, data.Announcement, data.Status, data.MetaVersion, data.MemberVersion)
 	}, groupIdKey, groupNameKey)
 	return ret, err
 }
@@ -137,7 +138,7 @@ func (m *defaultGroupModel) Update(ctx context.Context, newData *Group) error {
 	groupNameKey := fmt.Sprintf("%s%v", cacheGroupNamePrefix, data.Name)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, groupRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.Name, newData.Avatar, newData.Description, newData.OwnerId, newData.MaxMembers, newData.MemberCount, newData.Announcement, newData.Status, newData.MetaVersion, newData.Id)
+		return conn.ExecCtx(ctx, query, newData.Name, newData.Avatar, newData.Description, newData.OwnerId, newData.MaxMembers, newData.MemberCount, newData.Announcement, newData.Status, newData.MetaVersion, newData.MemberVersion, newData.Id)
 	}, groupIdKey, groupNameKey)
 	return err
 }
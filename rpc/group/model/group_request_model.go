@@ -16,6 +16,7 @@ type (
 	GroupRequestModel interface {
 		groupRequestModel
 		FindPendingByGroupId(ctx context.Context, groupId int64) ([]*GroupRequest, error)
+		FindByUserId(ctx context.Context, userId int64) ([]*GroupRequest, error)
 	}
 
 	customGroupRequestModel struct {
@@ -36,3 +37,11 @@ func (m *customGroupRequestModel) FindPendingByGroupId(ctx context.Context, grou
 	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, groupId)
 	return resp, err
 }
+
+// FindByUserId returns every request the user made to join a group
+func (m *customGroupRequestModel) FindByUserId(ctx context.Context, userId int64) ([]*GroupRequest, error) {
+	query := fmt.Sprintf("select %s from %s where `user_id` = ?", groupRequestRows, m.table)
+	var resp []*GroupRequest
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userId)
+	return resp, err
+}
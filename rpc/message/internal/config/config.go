@@ -1,6 +1,10 @@
 package config
 
 import (
+	"time"
+
+	"github.com/archyhsh/gochat/pkg/kafka"
+	"github.com/archyhsh/gochat/pkg/notify"
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/zrpc"
 )
@@ -19,7 +23,43 @@ type Config struct {
 			User    string
 		}
 		GroupID string
+		// Batch enables batched persistence on the message topic; Size 1 keeps per-message handling
+		Batch struct {
+			Size   int           `json:",default=1"`
+			Linger time.Duration `json:",default=50ms"`
+		}
+		// Retry parks failed messages on delayed retry topics, then on <topic>-dlt
+		Retry kafka.RetryPolicy
+	}
+	// Sequence selects how per-conversation message sequences are allocated
+	Sequence struct {
+		Allocator string `json:",default=mysql,options=mysql|redis"`
+		Segment   int64  `json:",default=1000"` // lease extension step of the redis allocator
+	}
+	// MemberCacheExpire bounds how long cached group members are trusted without any group event
+	MemberCacheExpire time.Duration `json:",default=10m"`
+	// Push selects how events are delivered to gateways: grpc (ChatService.PushBatch) or the legacy http endpoint
+	Push struct {
+		Transport string        `json:",default=http,options=http|grpc"`
+		Timeout   time.Duration `json:",default=2s"`
+		Secret    string        // signs every push, shared with the gateways' Internal.Secret
 	}
+	// PushRecovery retries pushes parked on queue:push:failed until they are delivered, made
+	// redundant by a sync, or too old; the rest is kept as per-user notifications
+	PushRecovery struct {
+		Interval    time.Duration `json:",default=10s"`
+		Batch       int           `json:",default=100"`
+		MaxAge      time.Duration `json:",default=10m"`
+		MaxAttempts int           `json:",default=20"`
+	}
+	// Notify alerts offline recipients through APNs, FCM or a webhook; messages of one conversation
+	// arriving within CollapseWindow become a single notification
+	Notify struct {
+		Enabled        bool          `json:",default=false"`
+		CollapseWindow time.Duration `json:",default=3s"`
+		Providers      notify.Conf   `json:",optional"`
+	}
+
 	UserRpc     zrpc.RpcClientConf
 	GroupRpc    zrpc.RpcClientConf
 	RelationRpc zrpc.RpcClientConf
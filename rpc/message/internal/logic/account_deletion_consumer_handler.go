@@ -0,0 +1,88 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/IBM/sarama"
+	"github.com/archyhsh/gochat/rpc/message/internal/svc"
+	"github.com/archyhsh/gochat/rpc/message/model"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// deletedPeerName replaces the name of a deleted account on the bookmarks of its former peers
+const deletedPeerName = "Deleted account"
+
+// AccountDeletionConsumerHandler clears what the message service keeps about deleted accounts and
+// confirms it to the user service, which announces the deletion again until it got the
+// confirmation. Messages only reference their sender by id, so past messages show the deleted
+// account once its profile is gone; the names copied onto peers' bookmarks are replaced here.
+type AccountDeletionConsumerHandler struct {
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAccountDeletionConsumerHandler(svcCtx *svc.ServiceContext) *AccountDeletionConsumerHandler {
+	return &AccountDeletionConsumerHandler{
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(context.Background()),
+	}
+}
+
+func (h *AccountDeletionConsumerHandler) Handle(ctx context.Context, message *sarama.ConsumerMessage) error {
+	var event struct {
+		Type   string `json:"type"`
+		Action string `json:"action"`
+		UserId int64  `json:"user_id"`
+	}
+	if err := json.Unmarshal(message.Value, &event); err != nil {
+		h.Errorf("[AccountDeletion] drop undecodable event at offset %d: %v", message.Offset, err)
+		return nil
+	}
+	if event.Type != "user_event" || event.Action != "account_deleted" || event.UserId <= 0 {
+		return nil
+	}
+
+	if err := h.clearUser(ctx, event.UserId); err != nil {
+		h.Errorf("[AccountDeletion] failed to clear messages of deleted user %d: %v", event.UserId, err)
+		return err
+	}
+	if _, err := h.svcCtx.UserRpc.CompleteDeletionStep(ctx, &pb.CompleteDeletionStepRequest{
+		UserId: event.UserId,
+		Step:   "message",
+	}); err != nil {
+		h.Errorf("[AccountDeletion] failed to confirm deletion of user %d: %v", event.UserId, err)
+		return err
+	}
+	h.Infof("[AccountDeletion] cleared messages of deleted user %d", event.UserId)
+	return nil
+}
+
+func (h *AccountDeletionConsumerHandler) clearUser(ctx context.Context, userId int64) error {
+	if err := h.svcCtx.UserConversationModel.AnonymizePeer(ctx, userId, deletedPeerName, ""); err != nil {
+		return err
+	}
+
+	conversations, err := h.svcCtx.UserConversationModel.FindAllByUserId(ctx, userId)
+	if err != nil {
+		return err
+	}
+	for _, c := range conversations {
+		if err := h.svcCtx.UserConversationModel.Delete(ctx, c.Id); err != nil && !errors.Is(err, model.ErrNotFound) {
+			return err
+		}
+	}
+
+	notifications, err := h.svcCtx.NotificationModel.FindAllByUserId(ctx, userId)
+	if err != nil {
+		return err
+	}
+	for _, n := range notifications {
+		if err := h.svcCtx.NotificationModel.Delete(ctx, n.Id); err != nil && !errors.Is(err, model.ErrNotFound) {
+			return err
+		}
+	}
+	return nil
+}
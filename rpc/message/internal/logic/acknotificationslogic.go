@@ -0,0 +1,58 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/archyhsh/gochat/rpc/message/internal/svc"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const maxAckNotifications = 100
+
+type AckNotificationsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAckNotificationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AckNotificationsLogic {
+	return &AckNotificationsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// AckNotifications marks notifications of the caller as delivered; ids of other users are ignored.
+func (l *AckNotificationsLogic) AckNotifications(in *pb.AckNotificationsRequest) (*pb.AckNotificationsResponse, error) {
+	md, ok := metadata.FromIncomingContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	userIdStrs := md.Get("user_id")
+	if len(userIdStrs) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "user_id not found in metadata")
+	}
+	userId, err := strconv.ParseInt(userIdStrs[0], 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid user_id in metadata")
+	}
+	if len(in.Ids) > maxAckNotifications {
+		return nil, status.Error(codes.InvalidArgument, "too many ids")
+	}
+
+	acked, err := l.svcCtx.NotificationModel.MarkDelivered(l.ctx, userId, in.Ids)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to acknowledge notifications")
+	}
+	return &pb.AckNotificationsResponse{
+		Base:  &pb.BaseResponse{Code: 200, Message: "Success"},
+		Acked: int32(acked),
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/archyhsh/gochat/rpc/message/internal/svc"
 	"github.com/archyhsh/gochat/rpc/message/model"
@@ -47,6 +48,8 @@ func (l *GetConversationsLogic) GetConversations(in *pb.GetConversationsRequest)
 
 	var userConversations []*model.UserConversationWithSeq
 	if in.Keyword == "" {
+		// A full pull brings the user up to date: parked pushes from before now are redundant
+		_ = l.svcCtx.Redis.SetexCtx(l.ctx, UserSyncKeyPrefix+strconv.FormatInt(userId, 10), strconv.FormatInt(time.Now().UnixMilli(), 10), userSyncExpire)
 		userConversations, err = l.svcCtx.UserConversationModel.GetUserConversationsByUserId(l.ctx, userId)
 	} else {
 		userConversations, err = l.svcCtx.UserConversationModel.SearchUserConversationsByUserId(l.ctx, userId, in.Keyword)
@@ -0,0 +1,68 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/archyhsh/gochat/rpc/message/internal/svc"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListFlaggedMessagesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListFlaggedMessagesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListFlaggedMessagesLogic {
+	return &ListFlaggedMessagesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Admin: messages flagged by the gateway's moderation chain, oldest first
+func (l *ListFlaggedMessagesLogic) ListFlaggedMessages(in *pb.ListFlaggedMessagesRequest) (*pb.ListFlaggedMessagesResponse, error) {
+	limit := in.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	offset := in.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	records, total, err := l.svcCtx.ReviewStore.List(l.ctx, offset, limit)
+	if err != nil {
+		l.Errorf("ListFlaggedMessages failed: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list flagged messages")
+	}
+
+	messages := make([]*pb.FlaggedMessage, 0, len(records))
+	for _, r := range records {
+		hits := make([]*pb.ModerationHit, 0, len(r.Hits))
+		for _, h := range r.Hits {
+			hits = append(hits, &pb.ModerationHit{Filter: h.Filter, Action: h.Action, Matches: h.Matches})
+		}
+		messages = append(messages, &pb.FlaggedMessage{
+			MsgId:            r.MsgId,
+			ConversationId:   r.ConversationId,
+			SenderId:         r.SenderId,
+			GroupId:          r.GroupId,
+			Content:          r.Content,
+			DeliveredContent: r.DeliveredContent,
+			Hits:             hits,
+			FlaggedAt:        r.FlaggedAt,
+		})
+	}
+
+	return &pb.ListFlaggedMessagesResponse{
+		Base:     &pb.BaseResponse{Code: 200, Message: "Success"},
+		Messages: messages,
+		Total:    total,
+	}, nil
+}
@@ -0,0 +1,80 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/archyhsh/gochat/rpc/message/internal/svc"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListNotificationsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListNotificationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListNotificationsLogic {
+	return &ListNotificationsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListNotifications returns the messages the push recovery gave up delivering to the caller, so
+// the client can show them after reconnecting; they stay listed until acknowledged.
+func (l *ListNotificationsLogic) ListNotifications(in *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+	md, ok := metadata.FromIncomingContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	userIdStrs := md.Get("user_id")
+	if len(userIdStrs) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "user_id not found in metadata")
+	}
+	userId, err := strconv.ParseInt(userIdStrs[0], 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid user_id in metadata")
+	}
+
+	limit := int(in.Limit)
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+	// One extra row tells whether another page follows
+	rows, err := l.svcCtx.NotificationModel.FindPendingByUserId(l.ctx, userId, in.AfterId, limit+1)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list notifications")
+	}
+	hasMore := len(rows) > limit
+	if hasMore {
+		rows = rows[:limit]
+	}
+
+	notifications := make([]*pb.Notification, 0, len(rows))
+	for _, row := range rows {
+		var event pb.ChatMessageEvent
+		if err := json.Unmarshal([]byte(row.Payload), &event); err != nil {
+			l.Errorf("undecodable notification %d of user %d: %v", row.Id, userId, err)
+			continue
+		}
+		notifications = append(notifications, &pb.Notification{
+			Id:        row.Id,
+			Message:   &event,
+			Reason:    row.Reason,
+			CreatedAt: row.CreatedAt.Unix(),
+		})
+	}
+	return &pb.ListNotificationsResponse{
+		Base:          &pb.BaseResponse{Code: 200, Message: "Success"},
+		Notifications: notifications,
+		HasMore:       hasMore,
+	}, nil
+}
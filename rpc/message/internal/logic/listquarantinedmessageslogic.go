@@ -0,0 +1,66 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/archyhsh/gochat/rpc/message/internal/svc"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListQuarantinedMessagesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListQuarantinedMessagesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListQuarantinedMessagesLogic {
+	return &ListQuarantinedMessagesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Admin: inspect records quarantined on the dead-letter topic
+func (l *ListQuarantinedMessagesLogic) ListQuarantinedMessages(in *pb.ListQuarantinedMessagesRequest) (*pb.ListQuarantinedMessagesResponse, error) {
+	limit := in.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	offset := in.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	records, total, err := l.svcCtx.QuarantineStore.List(l.ctx, offset, limit)
+	if err != nil {
+		l.Errorf("ListQuarantinedMessages failed: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list quarantined messages")
+	}
+
+	messages := make([]*pb.QuarantinedMessage, 0, len(records))
+	for _, r := range records {
+		messages = append(messages, &pb.QuarantinedMessage{
+			Id:        r.Id,
+			Topic:     r.Topic,
+			Partition: r.Partition,
+			Offset:    r.Offset,
+			Key:       r.Key,
+			Value:     r.Value,
+			Headers:   r.Headers,
+			Error:     r.Error,
+			Attempts:  int32(r.Attempts),
+			FailedAt:  r.FailedAt,
+		})
+	}
+
+	return &pb.ListQuarantinedMessagesResponse{
+		Base:     &pb.BaseResponse{Code: 200, Message: "Success"},
+		Messages: messages,
+		Total:    total,
+	}, nil
+}
@@ -0,0 +1,36 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/IBM/sarama"
+)
+
+// LiveEventConsumerHandler relays presence and typing events from the user topic. Unlike cache
+// invalidation, which every replica has to see, each event must be pushed once, so this handler
+// runs in a group shared by all replicas.
+type LiveEventConsumerHandler struct {
+	handler *MessageConsumerHandler
+}
+
+func NewLiveEventConsumerHandler(handler *MessageConsumerHandler) *LiveEventConsumerHandler {
+	return &LiveEventConsumerHandler{handler: handler}
+}
+
+func (h *LiveEventConsumerHandler) Handle(ctx context.Context, message *sarama.ConsumerMessage) error {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(message.Value, &raw); err != nil {
+		h.handler.Errorf("[LiveEvent] drop undecodable event at offset %d: %v", message.Offset, err)
+		return nil
+	}
+
+	eventType, _ := raw["type"].(string)
+	switch eventType {
+	case "presence_event":
+		return h.handler.handlePresenceEvent(ctx, raw)
+	case "typing_event":
+		return h.handler.handleTypingEvent(ctx, raw)
+	}
+	return nil
+}
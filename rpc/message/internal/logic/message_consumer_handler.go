@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -13,16 +14,21 @@ import (
 	"sync"
 
 	"github.com/archyhsh/gochat/pkg/snowflake"
+	"github.com/archyhsh/gochat/pkg/wsproto"
 	"github.com/archyhsh/gochat/rpc/message/internal/svc"
 	"github.com/archyhsh/gochat/rpc/pb"
 	"github.com/zeromicro/go-zero/core/logx"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/IBM/sarama"
 )
 
+// errNotBatchable makes the consumer handle a batch of system events message by message
+var errNotBatchable = errors.New("only chat messages are handled in batches")
+
 type MessageConsumerHandler struct {
 	svcCtx *svc.ServiceContext
 	logx.Logger
@@ -67,10 +73,52 @@ func (h *MessageConsumerHandler) Handle(ctx context.Context, message *sarama.Con
 // --- Chat Message Handler ---
 
 func (h *MessageConsumerHandler) handleChatMessage(ctx context.Context, data []byte) error {
+	event, err := h.decodeChatMessage(ctx, data)
+	if err != nil {
+		return err
+	}
+
+	l := NewSaveMessageLogic(ctx, h.svcCtx)
+	resp, err := l.SaveMessage(&pb.SaveMessageRequest{Message: event})
+	if err == nil && resp != nil {
+		event.Sequence = resp.Sequence
+		h.pushToGateways(ctx, event)
+	}
+	return err
+}
+
+// HandleBatch persists a run of chat messages from one partition in a single transaction and
+// pushes them afterwards in their original order. A claim holds a single topic, so batches of
+// other topics and batches holding an undecodable record fail before any side effect; the
+// consumer then replays them one by one, which sends bad records to retry and dead-letter.
+func (h *MessageConsumerHandler) HandleBatch(ctx context.Context, messages []*sarama.ConsumerMessage) error {
+	events := make([]*pb.ChatMessageEvent, 0, len(messages))
+	for _, message := range messages {
+		if message.Topic != h.svcCtx.Config.Kafka.Topics.Message {
+			return errNotBatchable
+		}
+		event, err := h.decodeChatMessage(ctx, message.Value)
+		if err != nil {
+			return fmt.Errorf("undecodable message at %s/%d/%d: %w", message.Topic, message.Partition, message.Offset, err)
+		}
+		events = append(events, event)
+	}
+
+	l := NewSaveMessageBatchLogic(ctx, h.svcCtx)
+	if err := l.SaveMessageBatch(events); err != nil {
+		return err
+	}
+	for _, event := range events {
+		h.pushToGateways(ctx, event)
+	}
+	return nil
+}
+
+func (h *MessageConsumerHandler) decodeChatMessage(ctx context.Context, data []byte) (*pb.ChatMessageEvent, error) {
 	var event pb.ChatMessageEvent
 	if err := proto.Unmarshal(data, &event); err != nil {
 		if err := json.Unmarshal(data, &event); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -81,14 +129,7 @@ func (h *MessageConsumerHandler) handleChatMessage(ctx context.Context, data []b
 	} else if event.ReceiverId > 0 {
 		event.RelationVersion = h.getRelationVersion(ctx, event.SenderId, event.ReceiverId)
 	}
-
-	l := NewSaveMessageLogic(ctx, h.svcCtx)
-	resp, err := l.SaveMessage(&pb.SaveMessageRequest{Message: &event})
-	if err == nil && resp != nil {
-		event.Sequence = resp.Sequence
-		h.pushToGateways(ctx, &event)
-	}
-	return err
+	return &event, nil
 }
 
 // --- System Event Router ---
@@ -96,7 +137,9 @@ func (h *MessageConsumerHandler) handleChatMessage(ctx context.Context, data []b
 func (h *MessageConsumerHandler) handleSystemEvent(ctx context.Context, data []byte) error {
 	var raw map[string]interface{}
 	if err := json.Unmarshal(data, &raw); err != nil {
-		return err
+		// Failures are retried until they succeed, which a malformed event never does
+		h.Errorf("[handleSystemEvent] drop undecodable event: %v", err)
+		return nil
 	}
 
 	eventType, _ := raw["type"].(string)
@@ -108,6 +151,7 @@ func (h *MessageConsumerHandler) handleSystemEvent(ctx context.Context, data []b
 	case "group_event":
 		return h.handleGroupEvent(ctx, raw)
 	}
+	// Presence and typing events are relayed once by LiveEventConsumerHandler
 	return nil
 }
 
@@ -144,6 +188,114 @@ func (h *MessageConsumerHandler) handleUserEvent(ctx context.Context, event map[
 	return nil
 }
 
+// --- Presence Handler ---
+
+// handlePresenceEvent tells the user's friends and conversation peers that the user went online,
+// away or offline. Clients ask GetPresence for last seen, which honours the user's privacy.
+func (h *MessageConsumerHandler) handlePresenceEvent(ctx context.Context, event map[string]interface{}) error {
+	userId := h.toInt64(event["user_id"])
+	state, _ := event["state"].(string)
+	if userId <= 0 || state == "" {
+		h.Errorf("[handlePresenceEvent] invalid event: %v", event)
+		return nil
+	}
+
+	targets := make(map[int64]struct{})
+	rpcCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("user_id", strconv.FormatInt(userId, 10)))
+	if resp, err := h.svcCtx.RelationRpc.GetFriendList(rpcCtx, &pb.GetFriendListRequest{}); err != nil {
+		h.Errorf("[handlePresenceEvent] failed to get friends of user %d: %v", userId, err)
+	} else {
+		for _, f := range resp.Friends {
+			targets[f.UserId] = struct{}{}
+		}
+	}
+	if uids, err := h.svcCtx.UserConversationModel.GetUsersByPeerId(ctx, userId); err == nil {
+		for _, uid := range uids {
+			targets[uid] = struct{}{}
+		}
+	}
+	delete(targets, userId)
+	if len(targets) == 0 {
+		return nil
+	}
+
+	targetIds := make([]int64, 0, len(targets))
+	for uid := range targets {
+		targetIds = append(targetIds, uid)
+	}
+	sig := &pb.ChatMessageEvent{
+		MsgId:     strconv.FormatInt(time.Now().UnixNano(), 10),
+		SenderId:  userId,
+		MsgType:   19, // PRESENCE_CHANGED
+		Content:   state,
+		Timestamp: time.Now().UnixMilli(),
+		TargetIds: targetIds,
+	}
+	h.pushToGateways(ctx, sig)
+	return nil
+}
+
+// --- Typing Handler ---
+
+// handleTypingEvent relays a typing state sent over a gateway socket to the other members of the
+// conversation; the sender must belong to it.
+func (h *MessageConsumerHandler) handleTypingEvent(ctx context.Context, event map[string]interface{}) error {
+	userId := h.toInt64(event["user_id"])
+	convId, _ := event["conversation_id"].(string)
+	isTyping, _ := event["is_typing"].(bool)
+	if userId <= 0 || convId == "" {
+		h.Errorf("[handleTypingEvent] invalid event: %v", event)
+		return nil
+	}
+
+	var members []int64
+	var groupId int64
+	if strings.HasPrefix(convId, "group_") {
+		groupId, _ = strconv.ParseInt(strings.TrimPrefix(convId, "group_"), 10, 64)
+		ids, err := h.svcCtx.MemberCache.Members(ctx, groupId)
+		if err != nil {
+			h.Errorf("[handleTypingEvent] failed to resolve members of group %d: %v", groupId, err)
+			return nil
+		}
+		members = ids
+	} else {
+		var a, b int64
+		if _, err := fmt.Sscanf(convId, "conv_%d_%d", &a, &b); err == nil {
+			members = []int64{a, b}
+		}
+	}
+
+	var targets []int64
+	isMember := false
+	for _, uid := range members {
+		if uid == userId {
+			isMember = true
+		} else {
+			targets = append(targets, uid)
+		}
+	}
+	if !isMember || len(targets) == 0 {
+		return nil
+	}
+
+	content := "0"
+	if isTyping {
+		content = "1"
+	}
+	sig := &pb.ChatMessageEvent{
+		MsgId:          strconv.FormatInt(time.Now().UnixNano(), 10),
+		ConversationId: convId,
+		SenderId:       userId,
+		GroupId:        groupId,
+		MsgType:        wsproto.MsgTypeTyping,
+		Content:        content,
+		Timestamp:      time.Now().UnixMilli(),
+		TargetIds:      targets,
+	}
+	h.pushToGateways(ctx, sig)
+	return nil
+}
+
 // --- Friend/Relation Handlers ---
 
 func (h *MessageConsumerHandler) handleFriendEvent(ctx context.Context, event map[string]interface{}) error {
@@ -234,6 +386,15 @@ func (h *MessageConsumerHandler) handleGroupEvent(ctx context.Context, event map
 		_, _ = h.svcCtx.Redis.Del(key)
 	}
 
+	// Membership changed: drop cached member ids older than this event.
+	// A dismissed group is forgotten only after the farewell has reached its former members.
+	switch action {
+	case "create", "join", "invite", "quit", "kick":
+		h.svcCtx.MemberCache.Invalidate(groupId, h.toInt64(event["member_version"]))
+	case "dismiss":
+		defer h.svcCtx.MemberCache.Remove(groupId)
+	}
+
 	impact := &eventImpact{
 		ConversationId: fmt.Sprintf("group_%d", groupId),
 		GroupId:        groupId,
@@ -323,11 +484,9 @@ func (h *MessageConsumerHandler) processEventMessage(ctx context.Context, impact
 
 	// 2. Resolve broadcast members if needed
 	if impact.Broadcast && impact.GroupId > 0 {
-		resp, err := h.svcCtx.GroupRpc.GetGroupMembers(ctx, &pb.GetGroupMembersRequest{GroupId: impact.GroupId})
+		memberIds, err := h.svcCtx.MemberCache.Members(ctx, impact.GroupId)
 		if err == nil {
-			for _, m := range resp.Members {
-				evt.TargetIds = append(evt.TargetIds, m.UserId)
-			}
+			evt.TargetIds = append(evt.TargetIds, memberIds...)
 		}
 	}
 
@@ -361,12 +520,11 @@ func (h *MessageConsumerHandler) pushToGateways(ctx context.Context, event *pb.C
 	if len(event.TargetIds) > 0 {
 		targetUsers = event.TargetIds
 	} else if event.GroupId > 0 {
-		resp, err := h.svcCtx.GroupRpc.GetGroupMembers(ctx, &pb.GetGroupMembersRequest{GroupId: event.GroupId})
-		if err == nil {
-			for _, m := range resp.Members {
-				targetUsers = append(targetUsers, m.UserId)
-			}
+		memberIds, err := h.svcCtx.MemberCache.Members(ctx, event.GroupId)
+		if err != nil {
+			h.Errorf("Failed to resolve members of group %d: %v", event.GroupId, err)
 		}
+		targetUsers = memberIds
 	} else {
 		targetUsers = []int64{event.SenderId, event.ReceiverId}
 	}
@@ -382,8 +540,13 @@ func (h *MessageConsumerHandler) pushToGateways(ctx context.Context, event *pb.C
 	}
 
 	gwMap := make(map[string][]int64)
-	for uid, addr := range addrMap {
-		if uid <= 0 || addr == "" {
+	var offlineUsers []int64
+	for _, uid := range targetUsers {
+		if uid <= 0 {
+			continue
+		}
+		addrs := addrMap[uid]
+		if len(addrs) == 0 && h.svcCtx.OfflineNotifier == nil {
 			continue
 		}
 		// Block check for private messages
@@ -393,7 +556,18 @@ func (h *MessageConsumerHandler) pushToGateways(ctx context.Context, event *pb.C
 				continue
 			}
 		}
-		gwMap[addr] = append(gwMap[addr], uid)
+		if len(addrs) == 0 {
+			offlineUsers = append(offlineUsers, uid)
+			continue
+		}
+		// Users connected from several gateways get the push on each of them
+		for _, addr := range addrs {
+			gwMap[addr] = append(gwMap[addr], uid)
+		}
+	}
+
+	if len(offlineUsers) > 0 {
+		h.svcCtx.OfflineNotifier.Notify(ctx, event, offlineUsers)
 	}
 
 	for addr, uids := range gwMap {
@@ -409,8 +583,6 @@ func (h *MessageConsumerHandler) sendBatchPush(ctx context.Context, addr string,
 }
 
 func (h *MessageConsumerHandler) sendPushRequest(ctx context.Context, gwAddr string, userIds []int64, event *pb.ChatMessageEvent) {
-	url := fmt.Sprintf("http://%s/internal/push", gwAddr)
-
 	// In cluster mode, we send a rich payload to enable "Pull-free" UI updates.
 	// For private messages, we try to fetch the specific unread count from Redis for the receiver.
 	unreadMap := make(map[int64]int64)
@@ -423,6 +595,26 @@ func (h *MessageConsumerHandler) sendPushRequest(ctx context.Context, gwAddr str
 		}
 	}
 
+	if h.deliverPush(ctx, gwAddr, userIds, unreadMap, event) {
+		return
+	}
+	// Parked for the PushRecoveryWorker
+	h.parkPush(ctx, &failedPush{
+		Gateway:   gwAddr,
+		UserIds:   userIds,
+		Event:     event,
+		UnreadMap: unreadMap,
+		FailedAt:  time.Now().UnixMilli(),
+		Attempts:  1,
+	})
+}
+
+// deliverPush sends one event to users connected to the gateway at gwAddr over the configured transport.
+func (h *MessageConsumerHandler) deliverPush(ctx context.Context, gwAddr string, userIds []int64, unreadMap map[int64]int64, event *pb.ChatMessageEvent) bool {
+	if h.svcCtx.Config.Push.Transport == "grpc" {
+		return h.sendGrpcPush(ctx, gwAddr, userIds, unreadMap, event)
+	}
+
 	payload := map[string]interface{}{
 		"user_ids":            userIds,
 		"conversation_id":     event.ConversationId,
@@ -437,17 +629,61 @@ func (h *MessageConsumerHandler) sendPushRequest(ctx context.Context, gwAddr str
 		"sequence":            event.Sequence,
 		"unread_map":          unreadMap, // uid -> unread_count
 	}
-
 	data, _ := json.Marshal(payload)
+	return h.sendHttpPush(ctx, gwAddr, data)
+}
+
+func (h *MessageConsumerHandler) parkPush(ctx context.Context, fp *failedPush) {
+	data, err := json.Marshal(fp)
+	if err != nil {
+		h.Errorf("Failed to encode undeliverable push %s: %v", fp.Event.GetMsgId(), err)
+		return
+	}
+	if _, err := h.svcCtx.Redis.LpushCtx(ctx, PushFailedKey, string(data)); err != nil {
+		h.Errorf("Failed to park undeliverable push %s: %v", fp.Event.GetMsgId(), err)
+	}
+}
+
+// sendGrpcPush delivers through the gateway's ChatService; the pooled client bounds the call
+// with the configured deadline and carries the trace context.
+func (h *MessageConsumerHandler) sendGrpcPush(ctx context.Context, gwAddr string, userIds []int64, unreadMap map[int64]int64, event *pb.ChatMessageEvent) bool {
+	rpcAddr, err := h.svcCtx.Router.FindRpcAddr(ctx, gwAddr)
+	if err != nil || rpcAddr == "" {
+		h.Errorf("No push rpc address registered for gateway %s: %v", gwAddr, err)
+		return false
+	}
+
+	err = h.svcCtx.GatewayPool.PushBatch(ctx, rpcAddr, &pb.PushBatchRequest{
+		UserIds:   userIds,
+		Event:     event,
+		UnreadMap: unreadMap,
+	})
+	if err != nil {
+		h.Errorf("Failed to push to gateway %s (%s): %v", gwAddr, rpcAddr, err)
+		return false
+	}
+	return true
+}
+
+func (h *MessageConsumerHandler) sendHttpPush(ctx context.Context, gwAddr string, data []byte) bool {
+	// Internal routes are served on a separate listener, advertised by each gateway
+	internalAddr, err := h.svcCtx.Router.FindInternalAddr(ctx, gwAddr)
+	if err != nil || internalAddr == "" {
+		h.Errorf("No internal address registered for gateway %s: %v", gwAddr, err)
+		return false
+	}
+	url := fmt.Sprintf("http://%s/internal/push", internalAddr)
 
 	maxRetries := 3
 	for i := 0; i < maxRetries; i++ {
 		req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(data))
 		if err != nil {
 			h.Errorf("Failed to create push request: %v", err)
-			return
+			return false
 		}
 		req.Header.Set("Content-Type", "application/json")
+		// Signed per attempt: the gateway accepts every nonce only once
+		h.svcCtx.PushSigner.SignRequest(req, data)
 
 		// Inject Trace Context into HTTP headers
 		otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
@@ -456,15 +692,14 @@ func (h *MessageConsumerHandler) sendPushRequest(ctx context.Context, gwAddr str
 		if err == nil {
 			resp.Body.Close()
 			if resp.StatusCode == http.StatusOK {
-				return
+				return true
 			}
 		}
 		if i < maxRetries-1 {
 			time.Sleep(time.Duration(i+1) * 200 * time.Millisecond)
 		}
 	}
-	dlqKey := "queue:push:failed"
-	_, _ = h.svcCtx.Redis.Lpush(dlqKey, string(data))
+	return false
 }
 
 // --- Helpers & Caching ---
@@ -0,0 +1,240 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/archyhsh/gochat/rpc/message/internal/svc"
+	"github.com/archyhsh/gochat/rpc/message/model"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/metric"
+)
+
+const (
+	// PushFailedKey holds pushes no gateway accepted, newest first
+	PushFailedKey = "queue:push:failed"
+	// UserSyncKeyPrefix records when a user last pulled their conversation list (unix millis)
+	UserSyncKeyPrefix = "sync:user:"
+	userSyncExpire    = 24 * 3600
+)
+
+var (
+	metricPushRecoveryDepth = metric.NewGaugeVec(&metric.GaugeVecOpts{
+		Namespace: "push_recovery",
+		Subsystem: "queue",
+		Name:      "depth",
+		Help:      "push recovery queue length.",
+		Labels:    []string{"queue"},
+	})
+	metricPushRecoveryAge = metric.NewGaugeVec(&metric.GaugeVecOpts{
+		Namespace: "push_recovery",
+		Subsystem: "queue",
+		Name:      "oldest_age_seconds",
+		Help:      "age of the oldest parked push.",
+		Labels:    []string{"queue"},
+	})
+	metricPushRecoveryUsers = metric.NewCounterVec(&metric.CounterVecOpts{
+		Namespace: "push_recovery",
+		Subsystem: "users",
+		Name:      "total",
+		Help:      "push recovery outcome per target user.",
+		Labels:    []string{"result"},
+	})
+)
+
+// failedPush is a delivery parked on queue:push:failed
+type failedPush struct {
+	Gateway   string               `json:"gateway"` // gateway the first attempt went to
+	UserIds   []int64              `json:"user_ids"`
+	Event     *pb.ChatMessageEvent `json:"event"`
+	UnreadMap map[int64]int64      `json:"unread_map,omitempty"`
+	FailedAt  int64                `json:"failed_at"` // unix millis of the first failure
+	Attempts  int                  `json:"attempts"`
+}
+
+// PushRecoveryWorker drains queue:push:failed. Every entry is re-routed to the gateways its
+// users are connected to now; users who synced since the failure are dropped, and users still
+// unreachable once the entry is too old are written to their persistent notifications.
+type PushRecoveryWorker struct {
+	svcCtx  *svc.ServiceContext
+	handler *MessageConsumerHandler
+	logx.Logger
+}
+
+func NewPushRecoveryWorker(svcCtx *svc.ServiceContext, handler *MessageConsumerHandler) *PushRecoveryWorker {
+	return &PushRecoveryWorker{
+		svcCtx:  svcCtx,
+		handler: handler,
+		Logger:  logx.WithContext(context.Background()),
+	}
+}
+
+func (w *PushRecoveryWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.svcCtx.Config.PushRecovery.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.runOnce(ctx)
+		}
+	}
+}
+
+func (w *PushRecoveryWorker) runOnce(ctx context.Context) {
+	depth, err := w.svcCtx.Redis.LlenCtx(ctx, PushFailedKey)
+	if err != nil {
+		w.Errorf("[PushRecovery] failed to read queue depth: %v", err)
+		return
+	}
+	w.reportAge(ctx, depth)
+
+	// Requeued entries go back to the head, so each entry is looked at once per round
+	for i := 0; i < min(depth, w.svcCtx.Config.PushRecovery.Batch); i++ {
+		data, err := w.svcCtx.Redis.RpopCtx(ctx, PushFailedKey)
+		if err != nil || data == "" {
+			break
+		}
+		w.recover(ctx, data)
+	}
+}
+
+func (w *PushRecoveryWorker) reportAge(ctx context.Context, depth int) {
+	metricPushRecoveryDepth.Set(float64(depth), PushFailedKey)
+	if depth == 0 {
+		metricPushRecoveryAge.Set(0, PushFailedKey)
+		return
+	}
+	oldest, err := w.svcCtx.Redis.LindexCtx(ctx, PushFailedKey, -1)
+	if err != nil {
+		return
+	}
+	var fp failedPush
+	if json.Unmarshal([]byte(oldest), &fp) == nil && fp.FailedAt > 0 {
+		metricPushRecoveryAge.Set(time.Since(time.UnixMilli(fp.FailedAt)).Seconds(), PushFailedKey)
+	}
+}
+
+func (w *PushRecoveryWorker) recover(ctx context.Context, data string) {
+	var fp failedPush
+	if err := json.Unmarshal([]byte(data), &fp); err != nil || fp.Event == nil {
+		w.Errorf("[PushRecovery] dropping malformed entry: %s", data)
+		metricPushRecoveryUsers.Inc("invalid")
+		return
+	}
+
+	pending := w.dropSynced(ctx, &fp)
+	if len(pending) == 0 {
+		return
+	}
+
+	cfg := w.svcCtx.Config.PushRecovery
+	if time.Since(time.UnixMilli(fp.FailedAt)) > cfg.MaxAge || fp.Attempts >= cfg.MaxAttempts {
+		w.persist(ctx, &fp, pending, fmt.Sprintf("undelivered after %d attempts", fp.Attempts))
+		return
+	}
+
+	routes, err := w.svcCtx.Router.BatchFind(ctx, pending)
+	if err != nil {
+		w.Errorf("[PushRecovery] failed to resolve routes for %s: %v", fp.Event.MsgId, err)
+		routes = map[int64][]string{}
+	}
+	byGateway := make(map[string][]int64)
+	var remaining []int64
+	for _, uid := range pending {
+		if len(routes[uid]) == 0 {
+			remaining = append(remaining, uid) // offline: wait for a reconnect
+			continue
+		}
+		for _, addr := range routes[uid] {
+			byGateway[addr] = append(byGateway[addr], uid)
+		}
+	}
+	// A user stays parked while any of their gateways failed; clients drop the duplicates by
+	// msg_id when the retry reaches the gateways that already got it
+	failed := make(map[int64]bool)
+	for addr, uids := range byGateway {
+		if !w.handler.deliverPush(ctx, addr, uids, fp.UnreadMap, fp.Event) {
+			for _, uid := range uids {
+				failed[uid] = true
+			}
+		}
+	}
+	delivered := 0
+	for _, uid := range pending {
+		if failed[uid] {
+			remaining = append(remaining, uid)
+		} else if len(routes[uid]) > 0 {
+			delivered++
+		}
+	}
+	metricPushRecoveryUsers.Add(float64(delivered), "delivered")
+	if len(remaining) == 0 {
+		return
+	}
+
+	fp.UserIds = remaining
+	fp.Attempts++
+	metricPushRecoveryUsers.Add(float64(len(remaining)), "requeued")
+	w.handler.parkPush(ctx, &fp)
+}
+
+// dropSynced removes the users who no longer need the push: they read up to its sequence or
+// pulled their conversations after it failed, which already brought them up to date.
+func (w *PushRecoveryWorker) dropSynced(ctx context.Context, fp *failedPush) []int64 {
+	keys := make([]string, len(fp.UserIds))
+	for i, uid := range fp.UserIds {
+		keys[i] = UserSyncKeyPrefix + strconv.FormatInt(uid, 10)
+	}
+	syncedAt, _ := w.svcCtx.Redis.MgetCtx(ctx, keys...)
+
+	var readSeqs map[int64]int64
+	if fp.Event.Sequence > 0 && fp.Event.ConversationId != "" {
+		readSeqs, _ = w.svcCtx.UserConversationModel.FindReadSequences(ctx, fp.Event.ConversationId, fp.UserIds)
+	}
+
+	pending := make([]int64, 0, len(fp.UserIds))
+	for i, uid := range fp.UserIds {
+		if i < len(syncedAt) {
+			if ts, err := strconv.ParseInt(syncedAt[i], 10, 64); err == nil && ts >= fp.FailedAt {
+				metricPushRecoveryUsers.Inc("synced")
+				continue
+			}
+		}
+		if seq, ok := readSeqs[uid]; ok && seq >= fp.Event.Sequence {
+			metricPushRecoveryUsers.Inc("synced")
+			continue
+		}
+		pending = append(pending, uid)
+	}
+	return pending
+}
+
+func (w *PushRecoveryWorker) persist(ctx context.Context, fp *failedPush, userIds []int64, reason string) {
+	payload, _ := json.Marshal(fp.Event)
+	rows := make([]*model.UserNotification, 0, len(userIds))
+	for _, uid := range userIds {
+		rows = append(rows, &model.UserNotification{
+			UserId:         uid,
+			MsgId:          fp.Event.MsgId,
+			ConversationId: fp.Event.ConversationId,
+			Sequence:       fp.Event.Sequence,
+			Payload:        string(payload),
+			Reason:         reason,
+		})
+	}
+	if err := w.svcCtx.NotificationModel.InsertBatch(ctx, rows); err != nil {
+		// Keep it parked rather than lose it; the next round tries again
+		w.Errorf("[PushRecovery] failed to store notifications for %s: %v", fp.Event.MsgId, err)
+		fp.UserIds = userIds
+		w.handler.parkPush(ctx, fp)
+		return
+	}
+	metricPushRecoveryUsers.Add(float64(len(userIds)), "persisted")
+}
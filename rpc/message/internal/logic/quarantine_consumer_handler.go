@@ -0,0 +1,62 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/archyhsh/gochat/pkg/kafka"
+	"github.com/archyhsh/gochat/pkg/messaging"
+	"github.com/archyhsh/gochat/rpc/message/internal/svc"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// QuarantineConsumerHandler drains the dead-letter topic into the quarantine store so the
+// records can be inspected and replayed through the admin RPCs.
+type QuarantineConsumerHandler struct {
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewQuarantineConsumerHandler(svcCtx *svc.ServiceContext) *QuarantineConsumerHandler {
+	return &QuarantineConsumerHandler{
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(context.Background()),
+	}
+}
+
+func (h *QuarantineConsumerHandler) Handle(ctx context.Context, message *sarama.ConsumerMessage) error {
+	partition, _ := strconv.ParseInt(kafka.HeaderValue(message, kafka.HeaderOriginalPartition), 10, 32)
+	offset, _ := strconv.ParseInt(kafka.HeaderValue(message, kafka.HeaderOriginalOffset), 10, 64)
+	attempts, _ := strconv.Atoi(kafka.HeaderValue(message, kafka.HeaderAttempt))
+
+	headers := make(map[string]string)
+	for _, hdr := range kafka.StripRetryHeaders(message.Headers) {
+		headers[string(hdr.Key)] = string(hdr.Value)
+	}
+
+	failedAt := message.Timestamp.UnixMilli()
+	if message.Timestamp.IsZero() {
+		failedAt = time.Now().UnixMilli()
+	}
+
+	record := &messaging.QuarantinedMessage{
+		Id:        fmt.Sprintf("%s/%d/%d", message.Topic, message.Partition, message.Offset),
+		Topic:     kafka.OriginalTopic(message),
+		Partition: int32(partition),
+		Offset:    offset,
+		Key:       message.Key,
+		Value:     message.Value,
+		Headers:   headers,
+		Error:     kafka.HeaderValue(message, kafka.HeaderError),
+		Attempts:  attempts,
+		FailedAt:  failedAt,
+	}
+	if err := h.svcCtx.QuarantineStore.Save(ctx, record); err != nil {
+		h.Errorf("[Quarantine] failed to store %s: %v", record.Id, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+	"github.com/archyhsh/gochat/rpc/message/internal/svc"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReplayQuarantinedMessagesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReplayQuarantinedMessagesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReplayQuarantinedMessagesLogic {
+	return &ReplayQuarantinedMessagesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Admin: re-publish quarantined records to their original topic (or drop them when discard is set)
+func (l *ReplayQuarantinedMessagesLogic) ReplayQuarantinedMessages(in *pb.ReplayQuarantinedMessagesRequest) (*pb.ReplayQuarantinedMessagesResponse, error) {
+	if len(in.Ids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ids are required")
+	}
+	if !in.Discard && l.svcCtx.KafkaProducer == nil {
+		return nil, status.Error(codes.Unavailable, "kafka producer is not available")
+	}
+
+	resp := &pb.ReplayQuarantinedMessagesResponse{
+		Base: &pb.BaseResponse{Code: 200, Message: "Success"},
+	}
+	for _, id := range in.Ids {
+		record, err := l.svcCtx.QuarantineStore.Get(l.ctx, id)
+		if err != nil {
+			l.Errorf("[Replay] quarantined message %s not found: %v", id, err)
+			resp.FailedIds = append(resp.FailedIds, id)
+			continue
+		}
+
+		if !in.Discard {
+			// Original headers only, so the record starts over with a fresh retry budget
+			headers := make([]sarama.RecordHeader, 0, len(record.Headers))
+			for k, v := range record.Headers {
+				headers = append(headers, sarama.RecordHeader{Key: []byte(k), Value: []byte(v)})
+			}
+			if err := l.svcCtx.KafkaProducer.SendWithHeaders(l.ctx, record.Topic, record.Key, record.Value, headers); err != nil {
+				l.Errorf("[Replay] failed to re-publish %s to %s: %v", id, record.Topic, err)
+				resp.FailedIds = append(resp.FailedIds, id)
+				continue
+			}
+		}
+
+		if err := l.svcCtx.QuarantineStore.Delete(l.ctx, id); err != nil {
+			l.Errorf("[Replay] failed to remove %s from quarantine: %v", id, err)
+		}
+		resp.ReplayedIds = append(resp.ReplayedIds, id)
+	}
+
+	return resp, nil
+}
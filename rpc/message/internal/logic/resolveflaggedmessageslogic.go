@@ -0,0 +1,45 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/archyhsh/gochat/rpc/message/internal/svc"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ResolveFlaggedMessagesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewResolveFlaggedMessagesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ResolveFlaggedMessagesLogic {
+	return &ResolveFlaggedMessagesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Admin: take reviewed messages off the review queue
+func (l *ResolveFlaggedMessagesLogic) ResolveFlaggedMessages(in *pb.ResolveFlaggedMessagesRequest) (*pb.ResolveFlaggedMessagesResponse, error) {
+	if len(in.MsgIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "msg_ids is required")
+	}
+
+	n, err := l.svcCtx.ReviewStore.Resolve(l.ctx, in.MsgIds...)
+	if err != nil {
+		l.Errorf("ResolveFlaggedMessages failed: %v", err)
+		return nil, status.Error(codes.Internal, "failed to resolve flagged messages")
+	}
+	l.Infof("Resolved %d flagged message(s)", n)
+
+	return &pb.ResolveFlaggedMessagesResponse{
+		Base:     &pb.BaseResponse{Code: 200, Message: "Success"},
+		Resolved: int32(n),
+	}, nil
+}
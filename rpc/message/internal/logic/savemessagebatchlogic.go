@@ -0,0 +1,248 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/archyhsh/gochat/rpc/message/internal/sequence"
+	"github.com/archyhsh/gochat/rpc/message/internal/svc"
+	"github.com/archyhsh/gochat/rpc/message/model"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// SaveMessageBatchLogic persists a batch of chat events consumed from one partition in a
+// single transaction. Sequences are allocated per conversation in arrival order, so the
+// ordering guarantees of SaveMessage are preserved.
+type SaveMessageBatchLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+// bookmarkKey identifies one user_conversation row touched by the batch.
+type bookmarkKey struct {
+	UserId         int64
+	ConversationId string
+}
+
+type pendingBookmark struct {
+	conversationBookmark
+	lastMsg *model.MessageTemplate
+	unread  int64
+}
+
+func NewSaveMessageBatchLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SaveMessageBatchLogic {
+	return &SaveMessageBatchLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SaveMessageBatch persists events and fills in their Sequence. Events that were already stored
+// by an earlier delivery keep their original sequence and are not written again.
+func (l *SaveMessageBatchLogic) SaveMessageBatch(events []*pb.ChatMessageEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	// 1. Deduplicate by msg_id, both inside the batch and against rows already persisted
+	tableOf := func(e *pb.ChatMessageEvent) string {
+		return "message_" + time.UnixMilli(e.Timestamp).Format("200601")
+	}
+	firstByMsgId := make(map[string]*pb.ChatMessageEvent)
+	idsByTable := make(map[string][]string)
+	var unique []*pb.ChatMessageEvent
+	for _, e := range events {
+		if _, ok := firstByMsgId[e.MsgId]; ok {
+			continue
+		}
+		firstByMsgId[e.MsgId] = e
+		unique = append(unique, e)
+		idsByTable[tableOf(e)] = append(idsByTable[tableOf(e)], e.MsgId)
+	}
+
+	stored := make(map[string]int64)
+	for table, ids := range idsByTable {
+		if err := l.svcCtx.MessageTemplateModel.CheckTableExist(l.ctx, table); err != nil {
+			l.Errorf("Failed to ensure table %s exists: %v", table, err)
+			return status.Error(codes.Internal, "Internal database error")
+		}
+		seqs, err := l.svcCtx.MessageTemplateModel.FindSeqByMsgIds(l.ctx, table, ids)
+		if err != nil {
+			return status.Error(codes.Internal, "fail to check duplicated msgs: "+err.Error())
+		}
+		for msgId, seq := range seqs {
+			stored[msgId] = seq
+		}
+	}
+
+	// 2. Group new messages by conversation, keeping arrival order
+	var convOrder []string
+	byConv := make(map[string][]*pb.ChatMessageEvent)
+	var fresh []*pb.ChatMessageEvent
+	for _, e := range unique {
+		if seq, ok := stored[e.MsgId]; ok {
+			e.Sequence = seq
+			continue
+		}
+		if _, ok := byConv[e.ConversationId]; !ok {
+			convOrder = append(convOrder, e.ConversationId)
+		}
+		byConv[e.ConversationId] = append(byConv[e.ConversationId], e)
+		fresh = append(fresh, e)
+	}
+
+	if len(fresh) > 0 {
+		if err := l.persist(convOrder, byConv, fresh, tableOf); err != nil {
+			return err
+		}
+	}
+
+	// Copies of the same msg_id inside the batch share the sequence of the first one
+	for _, e := range events {
+		e.Sequence = firstByMsgId[e.MsgId].Sequence
+	}
+	return nil
+}
+
+func (l *SaveMessageBatchLogic) persist(convOrder []string, byConv map[string][]*pb.ChatMessageEvent, fresh []*pb.ChatMessageEvent, tableOf func(*pb.ChatMessageEvent) string) error {
+	// 获取冗余资料快照与拉黑关系，在事务外批量完成 RPC 调用
+	profiles := loadProfiles(l.ctx, l.svcCtx, fresh)
+	blocked := make(map[[2]int64]bool)
+	bookmarksOf := make(map[*pb.ChatMessageEvent][]conversationBookmark, len(fresh))
+	for _, e := range fresh {
+		bookmarksOf[e] = buildBookmarks(e, profiles, func(uid int64) bool {
+			pair := [2]int64{uid, e.SenderId}
+			if v, ok := blocked[pair]; ok {
+				return v
+			}
+			checkResp, err := l.svcCtx.RelationRpc.CheckFriend(l.ctx, &pb.CheckFriendRequest{UserId: uid, FriendId: e.SenderId})
+			blocked[pair] = err == nil && checkResp.IsBlocked
+			return blocked[pair]
+		})
+	}
+
+	convs := make(map[string]sequence.Conversation, len(convOrder))
+	for _, convId := range convOrder {
+		msgs := byConv[convId]
+		last := msgs[len(msgs)-1]
+		conv := sequence.Conversation{
+			Id:       convId,
+			Type:     1,
+			TargetId: last.ReceiverId,
+			LastMsg:  toMessageTemplate(last),
+		}
+		if last.GroupId > 0 {
+			conv.Type = 2
+			conv.TargetId = last.GroupId
+		}
+		convs[convId] = conv
+	}
+
+	lastSeq := make(map[string]int64)
+	unreadIncr := make(map[bookmarkKey]int64)
+	err := l.svcCtx.SqlConn.TransactCtx(l.ctx, func(ctx context.Context, s sqlx.Session) error {
+		rowsByTable := make(map[string][]*model.MessageTemplate)
+		var bookmarkOrder []bookmarkKey
+		bookmarks := make(map[bookmarkKey]*pendingBookmark)
+
+		for _, convId := range convOrder {
+			msgs := byConv[convId]
+
+			seq, err := l.svcCtx.SeqAllocator.Allocate(ctx, s, convs[convId], int64(len(msgs)))
+			if err != nil {
+				return status.Error(codes.Internal, "fail to allocate sequence: "+err.Error())
+			}
+			lastSeq[convId] = seq
+
+			first := seq - int64(len(msgs)) + 1
+			for i, e := range msgs {
+				e.Sequence = first + int64(i)
+				row := toMessageTemplate(e)
+				rowsByTable[tableOf(e)] = append(rowsByTable[tableOf(e)], row)
+
+				for _, b := range bookmarksOf[e] {
+					key := bookmarkKey{UserId: b.UserId, ConversationId: convId}
+					pending, ok := bookmarks[key]
+					if !ok {
+						pending = &pendingBookmark{}
+						bookmarks[key] = pending
+						bookmarkOrder = append(bookmarkOrder, key)
+					}
+					pending.conversationBookmark = b
+					pending.lastMsg = row
+					if b.IncUnread {
+						pending.unread++
+					}
+				}
+
+				if e.GroupId == 0 && e.ReceiverId > 0 {
+					unreadIncr[bookmarkKey{UserId: e.ReceiverId, ConversationId: convId}]++
+				}
+			}
+		}
+
+		for table, rows := range rowsByTable {
+			if err := l.svcCtx.MessageTemplateModel.InsertBatchToTable(ctx, s, table, rows); err != nil {
+				return status.Error(codes.Internal, "fail to insert msgs: "+err.Error())
+			}
+		}
+
+		for _, key := range bookmarkOrder {
+			b := bookmarks[key]
+			err := l.svcCtx.UserConversationModel.UpdateLastMsg(ctx, s, b.UserId, b.PeerId, b.PeerName, b.PeerAvatar, key.ConversationId, b.lastMsg, b.unread)
+			if err != nil {
+				return status.Error(codes.Internal, "fail to init bookmark: "+err.Error())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		for convId, seq := range lastSeq {
+			l.svcCtx.SeqAllocator.Abandon(l.ctx, convs[convId], seq-int64(len(byConv[convId]))+1, seq)
+		}
+		return status.Error(codes.Internal, "Failed to persist messages: "+err.Error())
+	}
+	for convId, seq := range lastSeq {
+		conv := convs[convId]
+		conv.LastMsg.SequenceId = seq
+		if err := l.svcCtx.SeqAllocator.Settle(l.ctx, conv, seq); err != nil {
+			l.Errorf("Failed to settle conversation %s at seq %d: %v", convId, seq, err)
+		}
+	}
+
+	// Post-Commit logic: same cache pre-warming as SaveMessage, aggregated per conversation
+	for convId, seq := range lastSeq {
+		seqKey := fmt.Sprintf("conv:latest_seq:%s", convId)
+		_ = l.svcCtx.Redis.Setex(seqKey, strconv.FormatInt(seq, 10), 3600*24*7)
+	}
+	for key, n := range unreadIncr {
+		unreadKey := fmt.Sprintf("unread:cnt:%d:%s", key.UserId, key.ConversationId)
+		_, _ = l.svcCtx.Redis.Incrby(unreadKey, n)
+		_ = l.svcCtx.Redis.Expire(unreadKey, 3600*24*7)
+	}
+	return nil
+}
+
+func toMessageTemplate(e *pb.ChatMessageEvent) *model.MessageTemplate {
+	return &model.MessageTemplate{
+		MsgId:          e.MsgId,
+		ConversationId: e.ConversationId,
+		SenderId:       e.SenderId,
+		ReceiverId:     e.ReceiverId,
+		GroupId:        e.GroupId,
+		SequenceId:     e.Sequence,
+		MsgType:        int64(e.MsgType),
+		Content:        e.Content,
+		Status:         0,
+		CreatedAt:      time.UnixMilli(e.Timestamp),
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/archyhsh/gochat/rpc/message/internal/sequence"
 	"github.com/archyhsh/gochat/rpc/message/internal/svc"
 	"github.com/archyhsh/gochat/rpc/message/model"
 	"github.com/archyhsh/gochat/rpc/pb"
@@ -39,26 +40,39 @@ func (l *SaveMessageLogic) SaveMessage(in *pb.SaveMessageRequest) (*pb.SaveMessa
 		return nil, status.Error(codes.Internal, "Internal database error")
 	}
 
-	var newSeq int64
-	err = l.svcCtx.SqlConn.TransactCtx(l.ctx, func(ctx context.Context, s sqlx.Session) error {
-		msgModel := &model.MessageTemplate{
-			MsgId:     in.Message.MsgId,
-			Content:   in.Message.Content,
-			MsgType:   int64(in.Message.MsgType),
-			SenderId:  in.Message.SenderId,
-			CreatedAt: time.UnixMilli(in.Message.Timestamp),
-		}
+	// 获取冗余资料快照 (peer_name, peer_avatar)，在事务外完成 RPC 调用
+	profiles := loadProfiles(l.ctx, l.svcCtx, []*pb.ChatMessageEvent{in.Message})
+	bookmarks := buildBookmarks(in.Message, profiles, func(uid int64) bool {
+		checkResp, err := l.svcCtx.RelationRpc.CheckFriend(l.ctx, &pb.CheckFriendRequest{
+			UserId:   uid,                 // The target receiver
+			FriendId: in.Message.SenderId, // The sender
+		})
+		return err == nil && checkResp.IsBlocked
+	})
 
-		convType := int32(1)
-		targetId := in.Message.ReceiverId
-		if in.Message.GroupId > 0 {
-			convType = 2
-			targetId = in.Message.GroupId
-		}
+	msgModel := &model.MessageTemplate{
+		MsgId:     in.Message.MsgId,
+		Content:   in.Message.Content,
+		MsgType:   int64(in.Message.MsgType),
+		SenderId:  in.Message.SenderId,
+		CreatedAt: time.UnixMilli(in.Message.Timestamp),
+	}
+	conv := sequence.Conversation{
+		Id:       in.Message.ConversationId,
+		Type:     1,
+		TargetId: in.Message.ReceiverId,
+		LastMsg:  msgModel,
+	}
+	if in.Message.GroupId > 0 {
+		conv.Type = 2
+		conv.TargetId = in.Message.GroupId
+	}
 
-		seq, err := l.svcCtx.ConversationModel.UpdateSeq(ctx, s, in.Message.ConversationId, convType, targetId, msgModel)
+	var newSeq int64
+	err = l.svcCtx.SqlConn.TransactCtx(l.ctx, func(ctx context.Context, s sqlx.Session) error {
+		seq, err := l.svcCtx.SeqAllocator.Allocate(ctx, s, conv, 1)
 		if err != nil {
-			return status.Error(codes.Internal, "fail to update conversation table: "+err.Error())
+			return status.Error(codes.Internal, "fail to allocate sequence: "+err.Error())
 		}
 		newSeq = seq
 		msgModel.ConversationId = in.Message.ConversationId
@@ -72,114 +86,25 @@ func (l *SaveMessageLogic) SaveMessage(in *pb.SaveMessageRequest) (*pb.SaveMessa
 			return status.Error(codes.Internal, "fail to insert msg: "+err.Error())
 		}
 
-		// 获取冗余资料快照 (peer_name, peer_avatar)
-		peerName := ""
-		peerAvatar := ""
-		senderName := ""
-		senderAvatar := ""
-
-		if in.Message.GroupId > 0 {
-			gResp, err := l.svcCtx.GroupRpc.GetGroupInfo(l.ctx, &pb.GetGroupInfoRequest{GroupId: in.Message.GroupId})
-			if err == nil && gResp.Group != nil {
-				peerName = gResp.Group.Name
-				peerAvatar = gResp.Group.Avatar
-			}
-		} else {
-			// 私聊：预取发送者资料
-			sResp, err := l.svcCtx.UserRpc.GetUsersByIds(l.ctx, &pb.GetUsersByIdsRequest{UserIds: []int64{in.Message.SenderId, in.Message.ReceiverId}})
-			if err == nil && sResp != nil {
-				for _, u := range sResp.Users {
-					if u.Id == in.Message.SenderId {
-						senderName = u.Nickname
-						senderAvatar = u.Avatar
-					}
-					if u.Id == in.Message.ReceiverId {
-						peerName = u.Nickname
-						peerAvatar = u.Avatar
-					}
-				}
-			}
-		}
-
-		updatedUsers := make(map[int64]bool)
-		if in.Message.SenderId > 0 {
-			peerId := in.Message.ReceiverId
-			pName := peerName
-			pAvatar := peerAvatar
-			if in.Message.GroupId > 0 {
-				peerId = in.Message.GroupId
-				pName = peerName
-				pAvatar = peerAvatar
-			}
-			err = l.svcCtx.UserConversationModel.UpdateNewPrivateMsg(ctx, s, in.Message.SenderId, peerId, pName, pAvatar, in.Message.ConversationId, msgModel, false)
-			if err != nil {
-				return status.Error(codes.Internal, "fail to init sender bookmark: "+err.Error())
-			}
-			updatedUsers[in.Message.SenderId] = true
-		}
-		targets := in.Message.TargetIds
-		if in.Message.GroupId == 0 && len(targets) == 0 && in.Message.ReceiverId > 0 {
-			targets = []int64{in.Message.ReceiverId}
-		}
-
-		for _, tid := range targets {
-			if tid <= 0 || updatedUsers[tid] {
-				continue
-			}
-
-			// Block check for targets (receivers)
-			if in.Message.GroupId == 0 && in.Message.SenderId > 0 {
-				checkResp, err := l.svcCtx.RelationRpc.CheckFriend(l.ctx, &pb.CheckFriendRequest{
-					UserId:   tid,                 // The target receiver
-					FriendId: in.Message.SenderId, // The sender
-				})
-				// If blocked, don't update this user's conversation list/unread count
-				if err == nil && checkResp.IsBlocked {
-					continue
-				}
-			}
-
-			incUnread := tid != in.Message.SenderId
-			peerId := in.Message.SenderId
-			pName := senderName
-			pAvatar := senderAvatar
-
-			if in.Message.GroupId > 0 {
-				peerId = in.Message.GroupId
-				pName = peerName
-				pAvatar = peerAvatar
-			} else if peerId == 0 {
-				// For system messages in private chat (conv_A_B)
-				parts := strings.Split(in.Message.ConversationId, "_")
-				if len(parts) == 3 && parts[0] == "conv" {
-					id1, _ := strconv.ParseInt(parts[1], 10, 64)
-					id2, _ := strconv.ParseInt(parts[2], 10, 64)
-					if tid == id1 {
-						peerId = id2
-						pName = peerName
-					} else {
-						peerId = id1
-						pName = senderName // This assumes tid is the 'other' person
-					}
-				} else {
-					peerId = in.Message.ReceiverId
-					pName = peerName
-				}
-			}
-
-			err = l.svcCtx.UserConversationModel.UpdateNewPrivateMsg(ctx, s, tid, peerId, pName, pAvatar, in.Message.ConversationId, msgModel, incUnread)
+		for _, b := range bookmarks {
+			err = l.svcCtx.UserConversationModel.UpdateNewPrivateMsg(ctx, s, b.UserId, b.PeerId, b.PeerName, b.PeerAvatar, in.Message.ConversationId, msgModel, b.IncUnread)
 			if err != nil {
-				return status.Error(codes.Internal, "fail to init target bookmark: "+err.Error())
+				return status.Error(codes.Internal, "fail to init bookmark: "+err.Error())
 			}
-			updatedUsers[tid] = true
 		}
 
 		return nil
 	})
 
 	if err != nil {
+		if newSeq > 0 {
+			l.svcCtx.SeqAllocator.Abandon(l.ctx, conv, newSeq, newSeq)
+		}
 		return nil, status.Error(codes.Internal, "Failed to persist message: "+err.Error())
 	}
+	if err := l.svcCtx.SeqAllocator.Settle(l.ctx, conv, newSeq); err != nil {
+		l.Errorf("Failed to settle conversation %s at seq %d: %v", conv.Id, newSeq, err)
+	}
 
 	// Post-Commit logic: Update Redis for fast access (Cache Pre-warming)
 	// 1. Update Latest Sequence for the conversation
@@ -198,3 +123,147 @@ func (l *SaveMessageLogic) SaveMessage(in *pb.SaveMessageRequest) (*pb.SaveMessa
 		Sequence: newSeq,
 	}, nil
 }
+
+// profileSnapshot is the redundant peer data stored on a user's conversation bookmark.
+type profileSnapshot struct {
+	Name   string
+	Avatar string
+}
+
+type profileCache struct {
+	groups map[int64]profileSnapshot
+	users  map[int64]profileSnapshot
+}
+
+// loadProfiles fetches group and user snapshots needed by the given messages with one RPC per kind.
+func loadProfiles(ctx context.Context, svcCtx *svc.ServiceContext, msgs []*pb.ChatMessageEvent) *profileCache {
+	p := &profileCache{
+		groups: make(map[int64]profileSnapshot),
+		users:  make(map[int64]profileSnapshot),
+	}
+
+	var userIds []int64
+	seenUsers := make(map[int64]bool)
+	for _, m := range msgs {
+		if m.GroupId > 0 {
+			if _, ok := p.groups[m.GroupId]; ok {
+				continue
+			}
+			p.groups[m.GroupId] = profileSnapshot{}
+			gResp, err := svcCtx.GroupRpc.GetGroupInfo(ctx, &pb.GetGroupInfoRequest{GroupId: m.GroupId})
+			if err == nil && gResp.Group != nil {
+				p.groups[m.GroupId] = profileSnapshot{Name: gResp.Group.Name, Avatar: gResp.Group.Avatar}
+			}
+			continue
+		}
+		// 私聊：预取发送者与接收者资料
+		for _, uid := range []int64{m.SenderId, m.ReceiverId} {
+			if !seenUsers[uid] {
+				seenUsers[uid] = true
+				userIds = append(userIds, uid)
+			}
+		}
+	}
+
+	if len(userIds) > 0 {
+		sResp, err := svcCtx.UserRpc.GetUsersByIds(ctx, &pb.GetUsersByIdsRequest{UserIds: userIds})
+		if err == nil && sResp != nil {
+			for _, u := range sResp.Users {
+				p.users[u.Id] = profileSnapshot{Name: u.Nickname, Avatar: u.Avatar}
+			}
+		}
+	}
+	return p
+}
+
+// snapshots returns the (peer, sender) snapshots for a message.
+func (p *profileCache) snapshots(msg *pb.ChatMessageEvent) (profileSnapshot, profileSnapshot) {
+	if msg.GroupId > 0 {
+		return p.groups[msg.GroupId], profileSnapshot{}
+	}
+	return p.users[msg.ReceiverId], p.users[msg.SenderId]
+}
+
+// conversationBookmark is a single user_conversation upsert caused by a message.
+type conversationBookmark struct {
+	UserId     int64
+	PeerId     int64
+	PeerName   string
+	PeerAvatar string
+	IncUnread  bool
+}
+
+// buildBookmarks decides which users' conversation lists a message touches and with which peer
+// snapshot. isBlocked reports whether a private-chat receiver has blocked the sender.
+func buildBookmarks(msg *pb.ChatMessageEvent, profiles *profileCache, isBlocked func(uid int64) bool) []conversationBookmark {
+	peer, sender := profiles.snapshots(msg)
+
+	var bookmarks []conversationBookmark
+	updatedUsers := make(map[int64]bool)
+	if msg.SenderId > 0 {
+		peerId := msg.ReceiverId
+		if msg.GroupId > 0 {
+			peerId = msg.GroupId
+		}
+		bookmarks = append(bookmarks, conversationBookmark{
+			UserId:     msg.SenderId,
+			PeerId:     peerId,
+			PeerName:   peer.Name,
+			PeerAvatar: peer.Avatar,
+		})
+		updatedUsers[msg.SenderId] = true
+	}
+
+	targets := msg.TargetIds
+	if msg.GroupId == 0 && len(targets) == 0 && msg.ReceiverId > 0 {
+		targets = []int64{msg.ReceiverId}
+	}
+
+	for _, tid := range targets {
+		if tid <= 0 || updatedUsers[tid] {
+			continue
+		}
+
+		// Block check for targets (receivers): blocked users' conversation list/unread count stay untouched
+		if msg.GroupId == 0 && msg.SenderId > 0 && isBlocked(tid) {
+			continue
+		}
+
+		peerId := msg.SenderId
+		pName := sender.Name
+		pAvatar := sender.Avatar
+
+		if msg.GroupId > 0 {
+			peerId = msg.GroupId
+			pName = peer.Name
+			pAvatar = peer.Avatar
+		} else if peerId == 0 {
+			// For system messages in private chat (conv_A_B)
+			parts := strings.Split(msg.ConversationId, "_")
+			if len(parts) == 3 && parts[0] == "conv" {
+				id1, _ := strconv.ParseInt(parts[1], 10, 64)
+				id2, _ := strconv.ParseInt(parts[2], 10, 64)
+				if tid == id1 {
+					peerId = id2
+					pName = peer.Name
+				} else {
+					peerId = id1
+					pName = sender.Name // This assumes tid is the 'other' person
+				}
+			} else {
+				peerId = msg.ReceiverId
+				pName = peer.Name
+			}
+		}
+
+		bookmarks = append(bookmarks, conversationBookmark{
+			UserId:     tid,
+			PeerId:     peerId,
+			PeerName:   pName,
+			PeerAvatar: pAvatar,
+			IncUnread:  tid != msg.SenderId,
+		})
+		updatedUsers[tid] = true
+	}
+	return bookmarks
+}
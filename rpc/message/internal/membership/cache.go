@@ -0,0 +1,95 @@
+package membership
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/archyhsh/gochat/rpc/group/groupservice"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/zeromicro/go-zero/core/syncx"
+)
+
+// DefaultExpire bounds how long a member list may be served without any group event,
+// in case an invalidation was missed.
+const DefaultExpire = 10 * time.Minute
+
+type entry struct {
+	memberIds []int64
+	version   int64
+	expireAt  time.Time
+}
+
+// Cache keeps the member ids of groups in memory, keyed by group id and tagged with the
+// group's member_version. It is kept fresh by the group events the message service already
+// consumes and falls back to GroupService.GetGroupMemberIds on a miss.
+type Cache struct {
+	groupRpc groupservice.GroupService
+	expire   time.Duration
+	entries  sync.Map // group_id -> *entry
+	floors   sync.Map // group_id -> lowest member_version still acceptable
+	barrier  syncx.SingleFlight
+}
+
+func NewCache(groupRpc groupservice.GroupService, expire time.Duration) *Cache {
+	if expire <= 0 {
+		expire = DefaultExpire
+	}
+	return &Cache{
+		groupRpc: groupRpc,
+		expire:   expire,
+		barrier:  syncx.NewSingleFlight(),
+	}
+}
+
+// Members returns the member ids of a group. The returned slice must not be modified.
+func (c *Cache) Members(ctx context.Context, groupId int64) ([]int64, error) {
+	if v, ok := c.entries.Load(groupId); ok {
+		e := v.(*entry)
+		if time.Now().Before(e.expireAt) {
+			return e.memberIds, nil
+		}
+	}
+
+	v, err := c.barrier.Do(strconv.FormatInt(groupId, 10), func() (any, error) {
+		resp, err := c.groupRpc.GetGroupMemberIds(ctx, &pb.GetGroupMemberIdsRequest{GroupId: groupId})
+		if err != nil {
+			return nil, err
+		}
+		e := &entry{
+			memberIds: resp.MemberIds,
+			version:   resp.MemberVersion,
+			expireAt:  time.Now().Add(c.expire),
+		}
+		// A change we were already told about is newer than what we just read: serve it once, don't keep it
+		if floor, ok := c.floors.Load(groupId); !ok || e.version >= floor.(int64) {
+			c.entries.Store(groupId, e)
+		}
+		return e, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*entry).memberIds, nil
+}
+
+// Invalidate drops the cached members of a group unless they already reflect version.
+// A zero version (unknown) always drops them.
+func (c *Cache) Invalidate(groupId int64, version int64) {
+	if version > 0 {
+		if floor, ok := c.floors.Load(groupId); !ok || version > floor.(int64) {
+			c.floors.Store(groupId, version)
+		}
+		if v, ok := c.entries.Load(groupId); ok && v.(*entry).version >= version {
+			return
+		}
+	}
+	c.entries.Delete(groupId)
+}
+
+// Remove forgets a group entirely, e.g. once it is dismissed.
+func (c *Cache) Remove(groupId int64) {
+	c.entries.Delete(groupId)
+	c.floors.Delete(groupId)
+}
@@ -0,0 +1,361 @@
+package offline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/archyhsh/gochat/pkg/notify"
+	"github.com/archyhsh/gochat/rpc/group/groupservice"
+	"github.com/archyhsh/gochat/rpc/message/model"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/userservice"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+const (
+	// BurstKeyPrefix collects the messages of one conversation for one user until the burst is flushed
+	BurstKeyPrefix = "notify:burst:"
+	// BurstDueKey orders the open bursts ("<user>:<conversation>") by the unix millis they are due
+	// at; any instance may flush them, so a restart does not lose the bursts it opened
+	BurstDueKey     = "notify:burst:due"
+	DefaultWindow   = 3 * time.Second
+	previewMaxRunes = 100
+	// burstTTL outlasts restarts, after it an unflushed burst is dropped as stale
+	burstTTL = 10 * time.Minute
+	// flushLease is how long a claimed burst waits before another instance may flush it again,
+	// in case the one that claimed it died
+	flushLease = 30 * time.Second
+	flushBatch = 100
+	// maxFlushAttempts bounds how often a burst whose flush failed is scheduled again
+	maxFlushAttempts = 5
+)
+
+// burstScript counts the message into the burst and keeps it as the latest one; the message
+// that opens the burst (count 1) schedules its flush
+const burstScript = `
+	local n = redis.call("hincrby", KEYS[1], "count", 1)
+	redis.call("hset", KEYS[1], "sender", ARGV[1], "type", ARGV[2], "content", ARGV[3], "group", ARGV[4])
+	redis.call("pexpire", KEYS[1], ARGV[5])
+	if n == 1 then
+		redis.call("zadd", KEYS[2], "NX", ARGV[6], ARGV[7])
+	end
+	return n
+`
+
+// claimScript returns the bursts that are due and pushes them back by the lease, so other
+// instances leave them alone while they are flushed
+const claimScript = `
+	local due = redis.call("zrangebyscore", KEYS[1], "-inf", ARGV[1], "limit", 0, ARGV[3])
+	for _, member in ipairs(due) do
+		redis.call("zadd", KEYS[1], ARGV[2], member)
+	end
+	return due
+`
+
+// takeScript reads and closes a burst and unschedules it atomically, so later messages open and
+// schedule a new one
+const takeScript = `
+	local v = redis.call("hgetall", KEYS[1])
+	redis.call("del", KEYS[1])
+	redis.call("zrem", KEYS[2], ARGV[1])
+	return v
+`
+
+// restoreScript puts a burst whose flush failed back, merged into any burst opened meanwhile,
+// whose newer message is kept as the latest one, and schedules it again
+const restoreScript = `
+	local n = redis.call("hincrby", KEYS[1], "count", ARGV[1])
+	redis.call("hsetnx", KEYS[1], "sender", ARGV[2])
+	redis.call("hsetnx", KEYS[1], "type", ARGV[3])
+	redis.call("hsetnx", KEYS[1], "content", ARGV[4])
+	redis.call("hsetnx", KEYS[1], "group", ARGV[5])
+	redis.call("hset", KEYS[1], "attempts", ARGV[6])
+	redis.call("pexpire", KEYS[1], ARGV[7])
+	redis.call("zadd", KEYS[2], "NX", ARGV[8], ARGV[9])
+	return n
+`
+
+// Notifier alerts users who are not connected to any gateway. Messages a user receives in one
+// conversation within the collapse window become a single notification carrying the count and
+// the latest message. Muted conversations are skipped, and inside the user's do-not-disturb
+// hours only the badge is updated.
+type Notifier struct {
+	rdb                   *redis.Redis
+	sender                notify.Notifier
+	userConversationModel model.UserConversationModel
+	userRpc               userservice.UserService
+	groupRpc              groupservice.GroupService
+	window                time.Duration
+}
+
+func NewNotifier(rdb *redis.Redis, sender notify.Notifier, userConversationModel model.UserConversationModel,
+	userRpc userservice.UserService, groupRpc groupservice.GroupService, window time.Duration) *Notifier {
+	if window <= 0 {
+		window = DefaultWindow
+	}
+	return &Notifier{
+		rdb:                   rdb,
+		sender:                sender,
+		userConversationModel: userConversationModel,
+		userRpc:               userRpc,
+		groupRpc:              groupRpc,
+		window:                window,
+	}
+}
+
+// notifiable reports whether a message type is user content; system messages and signals are not
+func notifiable(msgType int32) bool {
+	return msgType >= 1 && msgType <= 5
+}
+
+// Notify records the event for every offline recipient and schedules the flush of bursts it
+// opens; Run flushes them once they are due.
+func (n *Notifier) Notify(ctx context.Context, event *pb.ChatMessageEvent, userIds []int64) {
+	if !notifiable(event.MsgType) || event.ConversationId == "" {
+		return
+	}
+	logger := logx.WithContext(ctx)
+	for _, uid := range userIds {
+		if uid <= 0 || uid == event.SenderId {
+			continue
+		}
+		key := burstKey(uid, event.ConversationId)
+		due := time.Now().Add(n.window).UnixMilli()
+		if _, err := n.rdb.EvalCtx(ctx, burstScript, []string{key, BurstDueKey},
+			event.SenderId, event.MsgType, event.Content, event.GroupId, burstTTL.Milliseconds(),
+			due, burstMember(uid, event.ConversationId)); err != nil {
+			logger.Errorf("[OfflineNotify] failed to record burst for user %d: %v", uid, err)
+		}
+	}
+}
+
+// Run flushes due bursts until ctx is done. Every instance runs it; a burst is flushed by the
+// instance that claims it first.
+func (n *Notifier) Run(ctx context.Context) {
+	ticker := time.NewTicker(max(n.window/3, 100*time.Millisecond))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n.flushDue(ctx)
+		}
+	}
+}
+
+func (n *Notifier) flushDue(ctx context.Context) {
+	now := time.Now()
+	res, err := n.rdb.EvalCtx(ctx, claimScript, []string{BurstDueKey},
+		now.UnixMilli(), now.Add(flushLease).UnixMilli(), flushBatch)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("[OfflineNotify] failed to claim due bursts: %v", err)
+		return
+	}
+	members, _ := res.([]any)
+	for _, m := range members {
+		member, _ := m.(string)
+		userIdStr, conversationId, _ := strings.Cut(member, ":")
+		userId, err := strconv.ParseInt(userIdStr, 10, 64)
+		if err != nil || conversationId == "" {
+			_, _ = n.rdb.ZremCtx(ctx, BurstDueKey, member)
+			continue
+		}
+		// A burst that could not be taken stays scheduled and is claimed again after the lease
+		n.flush(ctx, userId, conversationId)
+	}
+}
+
+func burstMember(userId int64, conversationId string) string {
+	return fmt.Sprintf("%d:%s", userId, conversationId)
+}
+
+func burstKey(userId int64, conversationId string) string {
+	return fmt.Sprintf("%s%d:%s", BurstKeyPrefix, userId, conversationId)
+}
+
+func (n *Notifier) flush(ctx context.Context, userId int64, conversationId string) {
+	logger := logx.WithContext(ctx)
+	res, err := n.rdb.EvalCtx(ctx, takeScript, []string{burstKey(userId, conversationId), BurstDueKey},
+		burstMember(userId, conversationId))
+	if err != nil {
+		logger.Errorf("[OfflineNotify] failed to take burst of user %d: %v", userId, err)
+		return
+	}
+	burst := toFields(res)
+	count, _ := strconv.ParseInt(burst["count"], 10, 64)
+	if count == 0 {
+		return
+	}
+
+	uc, err := n.userConversationModel.FindOneByUserIdConversationId(ctx, userId, conversationId)
+	if err != nil && !errors.Is(err, sqlx.ErrNotFound) {
+		logger.Errorf("[OfflineNotify] failed to load conversation %s of user %d: %v", conversationId, userId, err)
+		n.restore(ctx, userId, conversationId, burst, count)
+		return
+	}
+	if uc != nil && uc.IsMuted == 1 {
+		return
+	}
+
+	targets, err := n.userRpc.GetPushTargets(ctx, &pb.GetPushTargetsRequest{UserIds: []int64{userId}})
+	if err != nil {
+		logger.Errorf("[OfflineNotify] failed to load push targets of user %d: %v", userId, err)
+		n.restore(ctx, userId, conversationId, burst, count)
+		return
+	}
+	if len(targets.Targets) == 0 || len(targets.Targets[0].Devices) == 0 {
+		return
+	}
+	target := targets.Targets[0]
+
+	senderId, _ := strconv.ParseInt(burst["sender"], 10, 64)
+	groupId, _ := strconv.ParseInt(burst["group"], 10, 64)
+	msgType, _ := strconv.ParseInt(burst["type"], 10, 64)
+	title, body := n.compose(ctx, senderId, groupId, int32(msgType), burst["content"], count)
+	silent := inDnd(target.Setting, time.Now())
+	badge := n.badge(ctx, userId)
+
+	// Sent again only when no device got it, so nobody is notified twice
+	failed := 0
+	for _, device := range target.Devices {
+		err := n.sender.Notify(ctx, &notify.Notification{
+			UserId:      userId,
+			DeviceId:    device.DeviceId,
+			Platform:    device.Platform,
+			Token:       device.PushToken,
+			Title:       title,
+			Body:        body,
+			Badge:       badge,
+			CollapseKey: conversationId,
+			Silent:      silent,
+			Data:        map[string]string{"conversation_id": conversationId},
+		})
+		switch {
+		case err == nil:
+		case errors.Is(err, notify.ErrUnregistered):
+			logger.Infof("[OfflineNotify] push token of device %s (user %d) is unregistered", device.DeviceId, userId)
+		default:
+			logger.Errorf("[OfflineNotify] failed to notify device %s of user %d: %v", device.DeviceId, userId, err)
+			failed++
+		}
+	}
+	if failed == len(target.Devices) {
+		n.restore(ctx, userId, conversationId, burst, count)
+	}
+}
+
+// restore schedules a burst taken for a failed flush again, backing off with every attempt, and
+// drops it after maxFlushAttempts.
+func (n *Notifier) restore(ctx context.Context, userId int64, conversationId string, burst map[string]string, count int64) {
+	attempts, _ := strconv.Atoi(burst["attempts"])
+	attempts++
+	if attempts > maxFlushAttempts {
+		logx.WithContext(ctx).Errorf("[OfflineNotify] dropped burst of %d message(s) for user %d after %d attempts", count, userId, attempts-1)
+		return
+	}
+	due := time.Now().Add(n.window << attempts).UnixMilli()
+	if _, err := n.rdb.EvalCtx(ctx, restoreScript, []string{burstKey(userId, conversationId), BurstDueKey},
+		count, burst["sender"], burst["type"], burst["content"], burst["group"], attempts, burstTTL.Milliseconds(),
+		due, burstMember(userId, conversationId)); err != nil {
+		logx.WithContext(ctx).Errorf("[OfflineNotify] failed to reschedule burst of user %d: %v", userId, err)
+	}
+}
+
+// compose builds the alert: the sender (and group) as title, the latest message or the number
+// of collapsed messages as body
+func (n *Notifier) compose(ctx context.Context, senderId, groupId int64, msgType int32, content string, count int64) (string, string) {
+	senderName := "New message"
+	if resp, err := n.userRpc.GetUsersByIds(ctx, &pb.GetUsersByIdsRequest{UserIds: []int64{senderId}}); err == nil && len(resp.Users) > 0 {
+		senderName = resp.Users[0].Nickname
+	}
+
+	preview := "[attachment]"
+	if msgType == 1 {
+		preview = content
+		if runes := []rune(preview); len(runes) > previewMaxRunes {
+			preview = string(runes[:previewMaxRunes]) + "…"
+		}
+	}
+	if count > 1 {
+		preview = fmt.Sprintf("%d new messages, latest: %s", count, preview)
+	}
+
+	if groupId > 0 {
+		if resp, err := n.groupRpc.GetGroupsByIds(ctx, &pb.GetGroupsByIdsRequest{GroupIds: []int64{groupId}}); err == nil && len(resp.Groups) > 0 {
+			return resp.Groups[0].Name, senderName + ": " + preview
+		}
+	}
+	return senderName, preview
+}
+
+// badge counts the unread messages of the user's visible, unmuted conversations, the same way
+// the conversation list does: sequences for groups, the unread counter for private chats
+func (n *Notifier) badge(ctx context.Context, userId int64) int64 {
+	convs, err := n.userConversationModel.GetUserConversationsByUserId(ctx, userId)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("[OfflineNotify] failed to count unread of user %d: %v", userId, err)
+		return 0
+	}
+
+	var privateKeys []string
+	var privateCounts []int64
+	var total int64
+	for _, uc := range convs {
+		if uc.IsMuted == 1 {
+			continue
+		}
+		if strings.HasPrefix(uc.ConversationId, "group_") {
+			if uc.LatestSeq > uc.ReadSequence {
+				total += uc.LatestSeq - uc.ReadSequence
+			}
+			continue
+		}
+		privateKeys = append(privateKeys, fmt.Sprintf("unread:cnt:%d:%s", userId, uc.ConversationId))
+		privateCounts = append(privateCounts, uc.UnreadCount)
+	}
+	if len(privateKeys) > 0 {
+		// Redis first, the row's counter when the key is gone
+		vals, _ := n.rdb.MgetCtx(ctx, privateKeys...)
+		for i, cnt := range privateCounts {
+			if i < len(vals) && vals[i] != "" {
+				cnt, _ = strconv.ParseInt(vals[i], 10, 64)
+			}
+			total += cnt
+		}
+	}
+	return total
+}
+
+// inDnd reports whether now falls into the user's do-not-disturb range; ranges may wrap midnight
+func inDnd(s *pb.NotifySetting, now time.Time) bool {
+	if s == nil || !s.DndEnabled || s.DndStart == s.DndEnd {
+		return false
+	}
+	if loc, err := time.LoadLocation(s.Timezone); err == nil {
+		now = now.In(loc)
+	}
+	minute := int32(now.Hour()*60 + now.Minute())
+	if s.DndStart < s.DndEnd {
+		return minute >= s.DndStart && minute < s.DndEnd
+	}
+	return minute >= s.DndStart || minute < s.DndEnd
+}
+
+func toFields(res any) map[string]string {
+	fields := make(map[string]string)
+	vals, _ := res.([]any)
+	for i := 0; i+1 < len(vals); i += 2 {
+		k, _ := vals[i].(string)
+		v, _ := vals[i+1].(string)
+		fields[k] = v
+	}
+	return fields
+}
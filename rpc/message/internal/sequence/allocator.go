@@ -0,0 +1,33 @@
+package sequence
+
+import (
+	"context"
+
+	"github.com/archyhsh/gochat/rpc/message/model"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+const (
+	AllocatorMySQL = "mysql"
+	AllocatorRedis = "redis"
+)
+
+// Conversation identifies the conversation sequences are allocated for, together with the
+// newest message of the allocation so the conversation row can be kept up to date.
+type Conversation struct {
+	Id       string
+	Type     int32
+	TargetId int64
+	LastMsg  *model.MessageTemplate
+}
+
+// Allocator hands out strictly increasing per-conversation message sequences.
+type Allocator interface {
+	// Allocate reserves n consecutive sequences and returns the last one, so the reserved range is
+	// [last-n+1, last]. session is the transaction persisting the messages.
+	Allocate(ctx context.Context, session sqlx.Session, conv Conversation, n int64) (int64, error)
+	// Settle is called once the transaction committed.
+	Settle(ctx context.Context, conv Conversation, last int64) error
+	// Abandon is called when the transaction rolled back after Allocate succeeded.
+	Abandon(ctx context.Context, conv Conversation, first, last int64)
+}
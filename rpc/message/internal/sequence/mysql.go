@@ -0,0 +1,32 @@
+package sequence
+
+import (
+	"context"
+
+	"github.com/archyhsh/gochat/rpc/message/model"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// MySQLAllocator increments conversation.latest_seq inside the persisting transaction. The row
+// lock is held until commit, which serializes writers of a busy conversation but never leaves gaps.
+type MySQLAllocator struct {
+	conversationModel model.ConversationModel
+}
+
+func NewMySQLAllocator(conversationModel model.ConversationModel) *MySQLAllocator {
+	return &MySQLAllocator{
+		conversationModel: conversationModel,
+	}
+}
+
+func (a *MySQLAllocator) Allocate(ctx context.Context, session sqlx.Session, conv Conversation, n int64) (int64, error) {
+	return a.conversationModel.UpdateSeqBy(ctx, session, conv.Id, conv.Type, conv.TargetId, conv.LastMsg, n)
+}
+
+// Settle is a no-op: the conversation row was updated together with the sequence.
+func (a *MySQLAllocator) Settle(ctx context.Context, conv Conversation, last int64) error {
+	return nil
+}
+
+// Abandon is a no-op: rolling back the transaction also rolls back the increment.
+func (a *MySQLAllocator) Abandon(ctx context.Context, conv Conversation, first, last int64) {}
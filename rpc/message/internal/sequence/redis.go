@@ -0,0 +1,164 @@
+package sequence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/archyhsh/gochat/rpc/message/model"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+const (
+	CounterKeyPrefix = "seq:counter:"
+	LeaseKeyPrefix   = "seq:lease:"
+	// GapKeyPrefix holds the ranges that were allocated but never persisted, as a sorted set of
+	// "first-last" members scored by first, so readers can tell a known hole from a lost message.
+	GapKeyPrefix   = "seq:gaps:"
+	DefaultSegment = 1000
+
+	keyExpire = 7 * 24 * 3600
+)
+
+// allocScript increments the counter unless it has to be seeded first, and returns the persisted lease
+const allocScript = `
+	if redis.call("exists", KEYS[1]) == 0 then
+		return {-1, 0}
+	end
+	local last = redis.call("incrby", KEYS[1], ARGV[1])
+	local lease = tonumber(redis.call("get", KEYS[2]) or "0")
+	redis.call("expire", KEYS[1], ARGV[2])
+	redis.call("expire", KEYS[2], ARGV[2])
+	return {last, lease}
+`
+
+// leaseScript seeds the counter (when ARGV[1] >= 0 and nobody else did) and raises the lease
+const leaseScript = `
+	if tonumber(ARGV[1]) >= 0 then
+		redis.call("set", KEYS[1], ARGV[1], "NX", "EX", ARGV[3])
+	end
+	local cur = tonumber(redis.call("get", KEYS[2]) or "0")
+	if tonumber(ARGV[2]) > cur then
+		redis.call("set", KEYS[2], ARGV[2], "EX", ARGV[3])
+	end
+	return 1
+`
+
+// RedisAllocator allocates sequences with INCRBY so busy conversations no longer contend on the
+// conversation row. Every sequence it returns is covered by conversation.seq_lease, which is
+// extended one segment at a time before the counter may pass it. When the counter is lost it is
+// re-seeded from max(seq_lease, latest_seq, max(sequence_id)), so sequences never go backwards;
+// the unused rest of the old lease is recorded as a gap.
+type RedisAllocator struct {
+	rdb               *redis.Redis
+	conversationModel model.ConversationModel
+	messageModel      model.MessageTemplateModel
+	segment           int64
+}
+
+func NewRedisAllocator(rdb *redis.Redis, conversationModel model.ConversationModel, messageModel model.MessageTemplateModel, segment int64) *RedisAllocator {
+	if segment <= 0 {
+		segment = DefaultSegment
+	}
+	return &RedisAllocator{
+		rdb:               rdb,
+		conversationModel: conversationModel,
+		messageModel:      messageModel,
+		segment:           segment,
+	}
+}
+
+func (a *RedisAllocator) Allocate(ctx context.Context, _ sqlx.Session, conv Conversation, n int64) (int64, error) {
+	keys := []string{CounterKeyPrefix + conv.Id, LeaseKeyPrefix + conv.Id}
+	for i := 0; i < 3; i++ {
+		res, err := a.rdb.EvalCtx(ctx, allocScript, keys, n, keyExpire)
+		if err != nil {
+			return 0, err
+		}
+		vals, ok := res.([]interface{})
+		if !ok || len(vals) != 2 {
+			return 0, fmt.Errorf("unexpected allocation result: %v", res)
+		}
+		last, _ := vals[0].(int64)
+		lease, _ := vals[1].(int64)
+
+		if last < 0 {
+			if err := a.seed(ctx, conv, keys); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		if last > lease {
+			// The counter ran past the persisted lease: extend it before handing the range out
+			if err := a.extend(ctx, conv, keys, last+a.segment); err != nil {
+				// The counter already moved on, so the range is burnt
+				a.recordGap(ctx, conv.Id, last-n+1, last)
+				return 0, err
+			}
+		}
+		return last, nil
+	}
+	return 0, errors.New("failed to seed sequence counter")
+}
+
+// Settle records the latest message on the conversation row outside the persisting transaction.
+func (a *RedisAllocator) Settle(ctx context.Context, conv Conversation, last int64) error {
+	return a.conversationModel.UpdateLastMsg(ctx, conv.Id, conv.Type, conv.TargetId, conv.LastMsg, last)
+}
+
+// Abandon records the burnt range as a gap; the counter is never rolled back.
+func (a *RedisAllocator) Abandon(ctx context.Context, conv Conversation, first, last int64) {
+	a.recordGap(ctx, conv.Id, first, last)
+}
+
+func (a *RedisAllocator) seed(ctx context.Context, conv Conversation, keys []string) error {
+	latestSeq, lease, err := a.conversationModel.FindSeqState(ctx, conv.Id)
+	if err != nil {
+		return err
+	}
+
+	// Messages may have been persisted without the conversation row being settled
+	used := latestSeq
+	now := time.Now()
+	for _, t := range []time.Time{now, now.AddDate(0, -1, 0)} {
+		maxSeq, err := a.messageModel.MaxSequenceId(ctx, "message_"+t.Format("200601"), conv.Id)
+		if err != nil {
+			return err
+		}
+		used = max(used, maxSeq)
+	}
+
+	start := max(used, lease)
+	if lease > used {
+		a.recordGap(ctx, conv.Id, used+1, lease)
+	}
+	logx.WithContext(ctx).Infof("[Sequence] seeding %s from %d (latest_seq=%d, lease=%d)", conv.Id, start, latestSeq, lease)
+
+	newLease, err := a.conversationModel.ExtendSeqLease(ctx, conv.Id, conv.Type, conv.TargetId, start+a.segment)
+	if err != nil {
+		return err
+	}
+	_, err = a.rdb.EvalCtx(ctx, leaseScript, keys, start, newLease, keyExpire)
+	return err
+}
+
+func (a *RedisAllocator) extend(ctx context.Context, conv Conversation, keys []string, lease int64) error {
+	newLease, err := a.conversationModel.ExtendSeqLease(ctx, conv.Id, conv.Type, conv.TargetId, lease)
+	if err != nil {
+		return err
+	}
+	_, err = a.rdb.EvalCtx(ctx, leaseScript, keys, -1, newLease, keyExpire)
+	return err
+}
+
+func (a *RedisAllocator) recordGap(ctx context.Context, conversationId string, first, last int64) {
+	key := GapKeyPrefix + conversationId
+	if _, err := a.rdb.ZaddCtx(ctx, key, first, fmt.Sprintf("%d-%d", first, last)); err != nil {
+		logx.WithContext(ctx).Errorf("[Sequence] failed to record gap %d-%d of %s: %v", first, last, conversationId, err)
+		return
+	}
+	_ = a.rdb.ExpireCtx(ctx, key, keyExpire)
+}
@@ -57,3 +57,36 @@ func (s *MessageServiceServer) DeleteConversation(ctx context.Context, in *pb.De
 	l := logic.NewDeleteConversationLogic(ctx, s.svcCtx)
 	return l.DeleteConversation(in)
 }
+
+// Messages whose realtime push gave up for good, kept until the user acknowledges them
+func (s *MessageServiceServer) ListNotifications(ctx context.Context, in *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+	l := logic.NewListNotificationsLogic(ctx, s.svcCtx)
+	return l.ListNotifications(in)
+}
+
+func (s *MessageServiceServer) AckNotifications(ctx context.Context, in *pb.AckNotificationsRequest) (*pb.AckNotificationsResponse, error) {
+	l := logic.NewAckNotificationsLogic(ctx, s.svcCtx)
+	return l.AckNotifications(in)
+}
+
+// Admin: inspect and replay records quarantined on the dead-letter topic
+func (s *MessageServiceServer) ListQuarantinedMessages(ctx context.Context, in *pb.ListQuarantinedMessagesRequest) (*pb.ListQuarantinedMessagesResponse, error) {
+	l := logic.NewListQuarantinedMessagesLogic(ctx, s.svcCtx)
+	return l.ListQuarantinedMessages(in)
+}
+
+func (s *MessageServiceServer) ReplayQuarantinedMessages(ctx context.Context, in *pb.ReplayQuarantinedMessagesRequest) (*pb.ReplayQuarantinedMessagesResponse, error) {
+	l := logic.NewReplayQuarantinedMessagesLogic(ctx, s.svcCtx)
+	return l.ReplayQuarantinedMessages(in)
+}
+
+// Admin: review messages flagged by content moderation
+func (s *MessageServiceServer) ListFlaggedMessages(ctx context.Context, in *pb.ListFlaggedMessagesRequest) (*pb.ListFlaggedMessagesResponse, error) {
+	l := logic.NewListFlaggedMessagesLogic(ctx, s.svcCtx)
+	return l.ListFlaggedMessages(in)
+}
+
+func (s *MessageServiceServer) ResolveFlaggedMessages(ctx context.Context, in *pb.ResolveFlaggedMessagesRequest) (*pb.ResolveFlaggedMessagesResponse, error) {
+	l := logic.NewResolveFlaggedMessagesLogic(ctx, s.svcCtx)
+	return l.ResolveFlaggedMessages(in)
+}
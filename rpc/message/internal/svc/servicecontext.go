@@ -4,12 +4,22 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/archyhsh/gochat/pkg/auth"
+	"github.com/archyhsh/gochat/pkg/gateway"
+	"github.com/archyhsh/gochat/pkg/kafka"
+	"github.com/archyhsh/gochat/pkg/messaging"
+	"github.com/archyhsh/gochat/pkg/moderation"
+	"github.com/archyhsh/gochat/pkg/notify"
 	"github.com/archyhsh/gochat/pkg/router"
 	"github.com/archyhsh/gochat/rpc/group/groupservice"
 	"github.com/archyhsh/gochat/rpc/message/internal/config"
+	"github.com/archyhsh/gochat/rpc/message/internal/membership"
+	"github.com/archyhsh/gochat/rpc/message/internal/offline"
+	"github.com/archyhsh/gochat/rpc/message/internal/sequence"
 	"github.com/archyhsh/gochat/rpc/message/model"
 	"github.com/archyhsh/gochat/rpc/relation/relationservice"
 	"github.com/archyhsh/gochat/rpc/user/userservice"
+	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 	"github.com/zeromicro/go-zero/zrpc"
@@ -23,11 +33,20 @@ type ServiceContext struct {
 	MessageReadModel      model.MessageReadModel
 	MessageTemplateModel  model.MessageTemplateModel
 	UserConversationModel model.UserConversationModel
+	NotificationModel     model.UserNotificationModel
 	UserRpc               userservice.UserService
 	GroupRpc              groupservice.GroupService
 	RelationRpc           relationservice.RelationService
 	Router                *router.Router
 	HttpClient            *http.Client
+	GatewayPool           *gateway.Pool
+	PushSigner            *auth.Signer
+	SeqAllocator          sequence.Allocator
+	KafkaProducer         *kafka.Producer
+	QuarantineStore       *messaging.RedisQuarantineStore
+	ReviewStore           *moderation.ReviewStore
+	MemberCache           *membership.Cache
+	OfflineNotifier       *offline.Notifier // nil when offline notifications are disabled
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -36,21 +55,55 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	// Robust Redis initialization: uses the main cache node's configuration
 	// go-zero's redis.RedisConf internally handles cluster/sentinel if Type is set correctly.
 	rdb := redis.MustNewRedis(c.Cache[0].RedisConf)
+	conversationModel := model.NewConversationModel(sqlConn, c.Cache)
+	messageTemplateModel := model.NewMessageTemplateModel(sqlConn, c.Cache)
+	var seqAllocator sequence.Allocator = sequence.NewMySQLAllocator(conversationModel)
+	if c.Sequence.Allocator == sequence.AllocatorRedis {
+		seqAllocator = sequence.NewRedisAllocator(rdb, conversationModel, messageTemplateModel, c.Sequence.Segment)
+	}
+	// Used to park failed messages on retry/dead-letter topics and to replay quarantined ones
+	producer, _ := kafka.NewProducer(c.Kafka.Brokers, c.Kafka.Topics.Message)
+	pushSigner := auth.NewSigner(c.Push.Secret)
+	groupRpc := groupservice.NewGroupService(zrpc.MustNewClient(c.GroupRpc))
+	userRpc := userservice.NewUserService(zrpc.MustNewClient(c.UserRpc))
+	userConversationModel := model.NewUserConversationModel(sqlConn, c.Cache)
+
+	var offlineNotifier *offline.Notifier
+	if c.Notify.Enabled {
+		dispatcher, err := notify.NewDispatcher(c.Notify.Providers)
+		switch {
+		case err != nil:
+			logx.Errorf("offline notifications disabled: %v", err)
+		case dispatcher.Empty():
+			logx.Infof("offline notifications disabled: no APNs, FCM or webhook configured")
+		default:
+			offlineNotifier = offline.NewNotifier(rdb, dispatcher, userConversationModel, userRpc, groupRpc, c.Notify.CollapseWindow)
+		}
+	}
 
 	return &ServiceContext{
 		Config:                c,
 		SqlConn:               sqlConn,
 		Redis:                 rdb,
-		ConversationModel:     model.NewConversationModel(sqlConn, c.Cache),
+		ConversationModel:     conversationModel,
 		MessageReadModel:      model.NewMessageReadModel(sqlConn, c.Cache),
-		MessageTemplateModel:  model.NewMessageTemplateModel(sqlConn, c.Cache),
-		UserConversationModel: model.NewUserConversationModel(sqlConn, c.Cache),
-		UserRpc:               userservice.NewUserService(zrpc.MustNewClient(c.UserRpc)),
-		GroupRpc:              groupservice.NewGroupService(zrpc.MustNewClient(c.GroupRpc)),
+		MessageTemplateModel:  messageTemplateModel,
+		UserConversationModel: userConversationModel,
+		NotificationModel:     model.NewUserNotificationModel(sqlConn, c.Cache),
+		UserRpc:               userRpc,
+		GroupRpc:              groupRpc,
 		RelationRpc:           relationservice.NewRelationService(zrpc.MustNewClient(c.RelationRpc)),
 		Router:                router.NewRouter(rdb, ""),
 		HttpClient: &http.Client{
 			Timeout: 5 * time.Second,
 		},
+		GatewayPool:     gateway.NewPool(c.Push.Timeout, zrpc.WithUnaryClientInterceptor(pushSigner.UnaryClientInterceptor())),
+		PushSigner:      pushSigner,
+		SeqAllocator:    seqAllocator,
+		KafkaProducer:   producer,
+		QuarantineStore: messaging.NewRedisQuarantineStore(rdb, ""),
+		ReviewStore:     moderation.NewReviewStore(rdb, ""),
+		MemberCache:     membership.NewCache(groupRpc, c.MemberCacheExpire),
+		OfflineNotifier: offlineNotifier,
 	}
 }
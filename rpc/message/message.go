@@ -45,14 +45,18 @@ func main() {
 	handler := logic.NewMessageConsumerHandler(ctx)
 	hostname, _ := os.Hostname()
 
-	// 2.1 Persistence Consumer (Shared GroupID for Message Topic)
-	persistenceConsumer, err := kafka.NewConsumer(
+	// 2.1 Persistence Consumer (Shared GroupID for Message Topic, batched when Kafka.Batch.Size > 1)
+	persistenceConsumer, err := kafka.NewBatchConsumer(
 		c.Kafka.Brokers,
 		c.Kafka.GroupID, // e.g. "message-rpc-consumer-group"
 		[]string{c.Kafka.Topics.Message},
 		handler,
+		kafka.BatchConfig{Size: c.Kafka.Batch.Size, Linger: c.Kafka.Batch.Linger},
 	)
 	if err == nil {
+		if ctx.KafkaProducer != nil {
+			persistenceConsumer.EnableRetry(ctx.KafkaProducer, c.Kafka.Retry)
+		}
 		go func() {
 			logx.Infof("Starting persistence consumer for topic: %s", c.Kafka.Topics.Message)
 			if err := persistenceConsumer.Start(context.Background()); err != nil {
@@ -61,7 +65,44 @@ func main() {
 		}()
 	}
 
-	// 2.2 Broadcast Consumer (Unique GroupID for Cache Invalidation Topics)
+	// 2.2 Retry & Dead-letter Consumers (failed persistence is parked, retried with backoff, then quarantined)
+	if ctx.KafkaProducer != nil {
+		if len(c.Kafka.Retry.Stages) > 0 {
+			retryConsumer, err := kafka.NewRetryConsumer(
+				c.Kafka.Brokers,
+				c.Kafka.GroupID+"-retry",
+				[]string{c.Kafka.Topics.Message},
+				handler,
+				ctx.KafkaProducer,
+				c.Kafka.Retry,
+			)
+			if err == nil {
+				go func() {
+					logx.Infof("Starting retry consumer for %d stage(s) of topic: %s", len(c.Kafka.Retry.Stages), c.Kafka.Topics.Message)
+					if err := retryConsumer.Start(context.Background()); err != nil {
+						logx.Errorf("Retry consumer error: %v", err)
+					}
+				}()
+			}
+		}
+
+		dltConsumer, err := kafka.NewConsumer(
+			c.Kafka.Brokers,
+			c.Kafka.GroupID+"-dlt",
+			[]string{kafka.DeadLetterTopic(c.Kafka.Topics.Message)},
+			logic.NewQuarantineConsumerHandler(ctx),
+		)
+		if err == nil {
+			go func() {
+				logx.Infof("Starting dead-letter consumer for topic: %s", kafka.DeadLetterTopic(c.Kafka.Topics.Message))
+				if err := dltConsumer.Start(context.Background()); err != nil {
+					logx.Errorf("Dead-letter consumer error: %v", err)
+				}
+			}()
+		}
+	}
+
+	// 2.3 Broadcast Consumer (Unique GroupID for Cache Invalidation Topics)
 	broadcastGroupID := fmt.Sprintf("%s-broadcast-%s", c.Kafka.GroupID, hostname)
 	broadcastConsumer, err := kafka.NewConsumer(
 		c.Kafka.Brokers,
@@ -79,6 +120,46 @@ func main() {
 		}()
 	}
 
+	// 2.4 Live Event Consumer (Shared GroupID for User Topic, each presence or typing change is pushed once)
+	liveEventConsumer, err := kafka.NewConsumer(
+		c.Kafka.Brokers,
+		c.Kafka.GroupID+"-events",
+		[]string{c.Kafka.Topics.User},
+		logic.NewLiveEventConsumerHandler(handler),
+	)
+	if err == nil {
+		go func() {
+			logx.Infof("Starting live event consumer for topic: %s", c.Kafka.Topics.User)
+			if err := liveEventConsumer.Start(context.Background()); err != nil {
+				logx.Errorf("Live event consumer error: %v", err)
+			}
+		}()
+	}
+
+	// 2.5 Account Deletion Consumer (Shared GroupID for User Topic, each deletion is cleared once)
+	deletionConsumer, err := kafka.NewConsumer(
+		c.Kafka.Brokers,
+		c.Kafka.GroupID+"-account",
+		[]string{c.Kafka.Topics.User},
+		logic.NewAccountDeletionConsumerHandler(ctx),
+	)
+	if err == nil {
+		go func() {
+			logx.Infof("Starting account deletion consumer for topic: %s", c.Kafka.Topics.User)
+			if err := deletionConsumer.Start(context.Background()); err != nil {
+				logx.Errorf("Account deletion consumer error: %v", err)
+			}
+		}()
+	}
+
+	// 2.6 Push Recovery (retries pushes no gateway accepted, see queue:push:failed)
+	go logic.NewPushRecoveryWorker(ctx, handler).Start(context.Background())
+
+	// 2.7 Offline Notifications (flushes collapsed bursts kept in Redis, see notify:burst:due)
+	if ctx.OfflineNotifier != nil {
+		go ctx.OfflineNotifier.Run(context.Background())
+	}
+
 	// 3. Start gRPC Server
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
 		pb.RegisterMessageServiceServer(grpcServer, server.NewMessageServiceServer(ctx))
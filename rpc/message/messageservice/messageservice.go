@@ -14,23 +14,39 @@ import (
 )
 
 type (
-	ChatMessage                 = pb.ChatMessage
-	ChatMessageEvent            = pb.ChatMessageEvent
-	ClearUnreadRequest          = pb.ClearUnreadRequest
-	ClearUnreadResponse         = pb.ClearUnreadResponse
-	ConversationInfo            = pb.ConversationInfo
-	DeleteConversationRequest   = pb.DeleteConversationRequest
-	DeleteConversationResponse  = pb.DeleteConversationResponse
-	GetConversationsRequest     = pb.GetConversationsRequest
-	GetConversationsResponse    = pb.GetConversationsResponse
-	GetMessageByIDRequest       = pb.GetMessageByIDRequest
-	GetMessageByIDResponse      = pb.GetMessageByIDResponse
-	GetMessagesRequest          = pb.GetMessagesRequest
-	GetMessagesResponse         = pb.GetMessagesResponse
-	RestoreConversationRequest  = pb.RestoreConversationRequest
-	RestoreConversationResponse = pb.RestoreConversationResponse
-	SaveMessageRequest          = pb.SaveMessageRequest
-	SaveMessageResponse         = pb.SaveMessageResponse
+	AckNotificationsRequest           = pb.AckNotificationsRequest
+	AckNotificationsResponse          = pb.AckNotificationsResponse
+	ChatMessage                       = pb.ChatMessage
+	ChatMessageEvent                  = pb.ChatMessageEvent
+	ClearUnreadRequest                = pb.ClearUnreadRequest
+	ClearUnreadResponse               = pb.ClearUnreadResponse
+	ConversationInfo                  = pb.ConversationInfo
+	DeleteConversationRequest         = pb.DeleteConversationRequest
+	DeleteConversationResponse        = pb.DeleteConversationResponse
+	FlaggedMessage                    = pb.FlaggedMessage
+	GetConversationsRequest           = pb.GetConversationsRequest
+	GetConversationsResponse          = pb.GetConversationsResponse
+	GetMessageByIDRequest             = pb.GetMessageByIDRequest
+	GetMessageByIDResponse            = pb.GetMessageByIDResponse
+	GetMessagesRequest                = pb.GetMessagesRequest
+	GetMessagesResponse               = pb.GetMessagesResponse
+	ListFlaggedMessagesRequest        = pb.ListFlaggedMessagesRequest
+	ListFlaggedMessagesResponse       = pb.ListFlaggedMessagesResponse
+	ListNotificationsRequest          = pb.ListNotificationsRequest
+	ListNotificationsResponse         = pb.ListNotificationsResponse
+	ListQuarantinedMessagesRequest    = pb.ListQuarantinedMessagesRequest
+	ListQuarantinedMessagesResponse   = pb.ListQuarantinedMessagesResponse
+	ModerationHit                     = pb.ModerationHit
+	Notification                      = pb.Notification
+	QuarantinedMessage                = pb.QuarantinedMessage
+	ReplayQuarantinedMessagesRequest  = pb.ReplayQuarantinedMessagesRequest
+	ReplayQuarantinedMessagesResponse = pb.ReplayQuarantinedMessagesResponse
+	ResolveFlaggedMessagesRequest     = pb.ResolveFlaggedMessagesRequest
+	ResolveFlaggedMessagesResponse    = pb.ResolveFlaggedMessagesResponse
+	RestoreConversationRequest        = pb.RestoreConversationRequest
+	RestoreConversationResponse       = pb.RestoreConversationResponse
+	SaveMessageRequest                = pb.SaveMessageRequest
+	SaveMessageResponse               = pb.SaveMessageResponse
 
 	MessageService interface {
 		GetMessages(ctx context.Context, in *GetMessagesRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error)
@@ -40,6 +56,15 @@ type (
 		SaveMessage(ctx context.Context, in *SaveMessageRequest, opts ...grpc.CallOption) (*SaveMessageResponse, error)
 		RestoreConversation(ctx context.Context, in *RestoreConversationRequest, opts ...grpc.CallOption) (*RestoreConversationResponse, error)
 		DeleteConversation(ctx context.Context, in *DeleteConversationRequest, opts ...grpc.CallOption) (*DeleteConversationResponse, error)
+		// Messages whose realtime push gave up for good, kept until the user acknowledges them
+		ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error)
+		AckNotifications(ctx context.Context, in *AckNotificationsRequest, opts ...grpc.CallOption) (*AckNotificationsResponse, error)
+		// Admin: inspect and replay records quarantined on the dead-letter topic
+		ListQuarantinedMessages(ctx context.Context, in *ListQuarantinedMessagesRequest, opts ...grpc.CallOption) (*ListQuarantinedMessagesResponse, error)
+		ReplayQuarantinedMessages(ctx context.Context, in *ReplayQuarantinedMessagesRequest, opts ...grpc.CallOption) (*ReplayQuarantinedMessagesResponse, error)
+		// Admin: review messages flagged by content moderation
+		ListFlaggedMessages(ctx context.Context, in *ListFlaggedMessagesRequest, opts ...grpc.CallOption) (*ListFlaggedMessagesResponse, error)
+		ResolveFlaggedMessages(ctx context.Context, in *ResolveFlaggedMessagesRequest, opts ...grpc.CallOption) (*ResolveFlaggedMessagesResponse, error)
 	}
 
 	defaultMessageService struct {
@@ -87,3 +112,36 @@ func (m *defaultMessageService) DeleteConversation(ctx context.Context, in *Dele
 	client := pb.NewMessageServiceClient(m.cli.Conn())
 	return client.DeleteConversation(ctx, in, opts...)
 }
+
+// Messages whose realtime push gave up for good, kept until the user acknowledges them
+func (m *defaultMessageService) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error) {
+	client := pb.NewMessageServiceClient(m.cli.Conn())
+	return client.ListNotifications(ctx, in, opts...)
+}
+
+func (m *defaultMessageService) AckNotifications(ctx context.Context, in *AckNotificationsRequest, opts ...grpc.CallOption) (*AckNotificationsResponse, error) {
+	client := pb.NewMessageServiceClient(m.cli.Conn())
+	return client.AckNotifications(ctx, in, opts...)
+}
+
+// Admin: inspect and replay records quarantined on the dead-letter topic
+func (m *defaultMessageService) ListQuarantinedMessages(ctx context.Context, in *ListQuarantinedMessagesRequest, opts ...grpc.CallOption) (*ListQuarantinedMessagesResponse, error) {
+	client := pb.NewMessageServiceClient(m.cli.Conn())
+	return client.ListQuarantinedMessages(ctx, in, opts...)
+}
+
+func (m *defaultMessageService) ReplayQuarantinedMessages(ctx context.Context, in *ReplayQuarantinedMessagesRequest, opts ...grpc.CallOption) (*ReplayQuarantinedMessagesResponse, error) {
+	client := pb.NewMessageServiceClient(m.cli.Conn())
+	return client.ReplayQuarantinedMessages(ctx, in, opts...)
+}
+
+// Admin: review messages flagged by content moderation
+func (m *defaultMessageService) ListFlaggedMessages(ctx context.Context, in *ListFlaggedMessagesRequest, opts ...grpc.CallOption) (*ListFlaggedMessagesResponse, error) {
+	client := pb.NewMessageServiceClient(m.cli.Conn())
+	return client.ListFlaggedMessages(ctx, in, opts...)
+}
+
+func (m *defaultMessageService) ResolveFlaggedMessages(ctx context.Context, in *ResolveFlaggedMessagesRequest, opts ...grpc.CallOption) (*ResolveFlaggedMessagesResponse, error) {
+	client := pb.NewMessageServiceClient(m.cli.Conn())
+	return client.ResolveFlaggedMessages(ctx, in, opts...)
+}
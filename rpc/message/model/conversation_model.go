@@ -16,6 +16,10 @@ type (
 	ConversationModel interface {
 		conversationModel
 		UpdateSeq(ctx context.Context, session sqlx.Session, conversationId string, convType int32, targetId int64, lastMsg *MessageTemplate) (int64, error)
+		UpdateSeqBy(ctx context.Context, session sqlx.Session, conversationId string, convType int32, targetId int64, lastMsg *MessageTemplate, n int64) (int64, error)
+		UpdateLastMsg(ctx context.Context, conversationId string, convType int32, targetId int64, lastMsg *MessageTemplate, seq int64) error
+		FindSeqState(ctx context.Context, conversationId string) (latestSeq int64, lease int64, err error)
+		ExtendSeqLease(ctx context.Context, conversationId string, convType int32, targetId int64, lease int64) (int64, error)
 	}
 
 	customConversationModel struct {
@@ -31,14 +35,20 @@ func NewConversationModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Op
 }
 
 func (m *customConversationModel) UpdateSeq(ctx context.Context, session sqlx.Session, conversationId string, convType int32, targetId int64, lastMsg *MessageTemplate) (int64, error) {
+	return m.UpdateSeqBy(ctx, session, conversationId, convType, targetId, lastMsg, 1)
+}
+
+// UpdateSeqBy reserves n consecutive sequences in one statement and returns the last one,
+// so the reserved range is [last-n+1, last].
+func (m *customConversationModel) UpdateSeqBy(ctx context.Context, session sqlx.Session, conversationId string, convType int32, targetId int64, lastMsg *MessageTemplate, n int64) (int64, error) {
 	query := fmt.Sprintf(`
 		INSERT INTO %s (
 			conversation_id, type, target_id, 
 			last_msg_id, last_msg_time, last_msg_content, 
 			last_msg_type, last_sender_id, latest_seq
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, LAST_INSERT_ID(1))
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, LAST_INSERT_ID(?))
 		ON DUPLICATE KEY UPDATE 
-			latest_seq = LAST_INSERT_ID(latest_seq + 1),
+			latest_seq = LAST_INSERT_ID(latest_seq + ?),
 			last_msg_id = VALUES(last_msg_id),
 			last_msg_time = VALUES(last_msg_time),
 			last_msg_content = VALUES(last_msg_content),
@@ -49,7 +59,7 @@ func (m *customConversationModel) UpdateSeq(ctx context.Context, session sqlx.Se
 	_, err := session.ExecCtx(ctx, query,
 		conversationId, convType, targetId,
 		lastMsg.MsgId, lastMsg.CreatedAt, lastMsg.Content,
-		lastMsg.MsgType, lastMsg.SenderId,
+		lastMsg.MsgType, lastMsg.SenderId, n, n,
 	)
 	if err != nil {
 		return 0, err
@@ -61,3 +71,67 @@ func (m *customConversationModel) UpdateSeq(ctx context.Context, session sqlx.Se
 	}
 	return newSeq, nil
 }
+
+// UpdateLastMsg records the latest message of a conversation whose sequence was allocated elsewhere.
+// A message older than the stored latest_seq never overwrites it, so the row only moves forward.
+func (m *customConversationModel) UpdateLastMsg(ctx context.Context, conversationId string, convType int32, targetId int64, lastMsg *MessageTemplate, seq int64) error {
+	// last_msg_* are assigned before latest_seq: MySQL evaluates the assignments left to right
+	query := fmt.Sprintf(`
+		INSERT INTO %s (
+			conversation_id, type, target_id, 
+			last_msg_id, last_msg_time, last_msg_content, 
+			last_msg_type, last_sender_id, latest_seq
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE 
+			last_msg_id = IF(VALUES(latest_seq) >= latest_seq, VALUES(last_msg_id), last_msg_id),
+			last_msg_time = IF(VALUES(latest_seq) >= latest_seq, VALUES(last_msg_time), last_msg_time),
+			last_msg_content = IF(VALUES(latest_seq) >= latest_seq, VALUES(last_msg_content), last_msg_content),
+			last_msg_type = IF(VALUES(latest_seq) >= latest_seq, VALUES(last_msg_type), last_msg_type),
+			last_sender_id = IF(VALUES(latest_seq) >= latest_seq, VALUES(last_sender_id), last_sender_id),
+			latest_seq = GREATEST(latest_seq, VALUES(latest_seq))
+	`, m.table)
+
+	_, err := m.ExecNoCacheCtx(ctx, query,
+		conversationId, convType, targetId,
+		lastMsg.MsgId, lastMsg.CreatedAt, lastMsg.Content,
+		lastMsg.MsgType, lastMsg.SenderId, seq,
+	)
+	return err
+}
+
+// FindSeqState reads latest_seq and seq_lease bypassing the cache; a missing row yields zeros.
+func (m *customConversationModel) FindSeqState(ctx context.Context, conversationId string) (int64, int64, error) {
+	query := fmt.Sprintf("SELECT latest_seq, seq_lease FROM %s WHERE conversation_id = ? LIMIT 1", m.table)
+	var resp struct {
+		LatestSeq int64 `db:"latest_seq"`
+		SeqLease  int64 `db:"seq_lease"`
+	}
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, conversationId)
+	switch err {
+	case nil:
+		return resp.LatestSeq, resp.SeqLease, nil
+	case sqlx.ErrNotFound:
+		return 0, 0, nil
+	default:
+		return 0, 0, err
+	}
+}
+
+// ExtendSeqLease raises seq_lease to at least lease and returns the stored value, which is larger
+// when another allocator already extended it further.
+func (m *customConversationModel) ExtendSeqLease(ctx context.Context, conversationId string, convType int32, targetId int64, lease int64) (int64, error) {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (conversation_id, type, target_id, last_msg_content, seq_lease)
+		VALUES (?, ?, ?, '', LAST_INSERT_ID(?))
+		ON DUPLICATE KEY UPDATE seq_lease = LAST_INSERT_ID(GREATEST(seq_lease, VALUES(seq_lease)))
+	`, m.table)
+
+	var stored int64
+	err := m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		if _, err := session.ExecCtx(ctx, query, conversationId, convType, targetId, lease); err != nil {
+			return err
+		}
+		return session.QueryRowCtx(ctx, &stored, "SELECT LAST_INSERT_ID()")
+	})
+	return stored, err
+}
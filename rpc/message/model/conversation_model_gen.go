@@ -53,6 +53,7 @@ type (
 		LastMsgType    int64     `db:"last_msg_type"`
 		LastSenderId   int64     `db:"last_sender_id"`
 		LatestSeq      int64     `db:"latest_seq"` // latest msg sequence
+		SeqLease       int64     `db:"seq_lease"`  // upper bound of sequences handed out by the redis allocator
 		CreatedAt      time.Time `db:"created_at"`
 	}
 )
@@ -120,8 +121,8 @@ func (m *defaultConversationModel) Insert(ctx context.Context, data *Conversatio
 	conversationConversationIdKey := fmt.Sprintf("%s%v", cacheConversationConversationIdPrefix, data.ConversationId)
 	conversationIdKey := fmt.Sprintf("%s%v", cacheConversationIdPrefix, data.Id)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, conversationRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.ConversationId, data.Type, data.TargetId, data.LastMsgId, data.LastMsgTime, data.LastMsgContent, data.LastMsgType, data.LastSenderId, data.LatestSeq)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, conversationRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.ConversationId, data.Type, data.TargetId, data.LastMsgId, data.LastMsgTime, data.LastMsgContent, data.LastMsgType, data.LastSenderId, data.LatestSeq, data.SeqLease)
 	}, conversationConversationIdKey, conversationIdKey)
 	return ret, err
 }
@@ -136,7 +137,7 @@ func (m *defaultConversationModel) Update(ctx context.Context, newData *Conversa
 	conversationIdKey := fmt.Sprintf("%s%v", cacheConversationIdPrefix, data.Id)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, conversationRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.ConversationId, newData.Type, newData.TargetId, newData.LastMsgId, newData.LastMsgTime, newData.LastMsgContent, newData.LastMsgType, newData.LastSenderId, newData.LatestSeq, newData.Id)
+		return conn.ExecCtx(ctx, query, newData.ConversationId, newData.Type, newData.TargetId, newData.LastMsgId, newData.LastMsgTime, newData.LastMsgContent, newData.LastMsgType, newData.LastSenderId, newData.LatestSeq, newData.SeqLease, newData.Id)
 	}, conversationConversationIdKey, conversationIdKey)
 	return err
 }
@@ -3,6 +3,7 @@ package model
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/go-sql-driver/mysql"
@@ -23,6 +24,9 @@ type (
 		CountByTable(ctx context.Context, table string, conversationId string) (int64, error)
 		CheckTableExist(ctx context.Context, table string) error
 		InsertToTable(ctx context.Context, session sqlx.Session, table string, data *MessageTemplate) error
+		InsertBatchToTable(ctx context.Context, session sqlx.Session, table string, data []*MessageTemplate) error
+		FindSeqByMsgIds(ctx context.Context, table string, msgIds []string) (map[string]int64, error)
+		MaxSequenceId(ctx context.Context, table string, conversationId string) (int64, error)
 	}
 
 	customMessageTemplateModel struct {
@@ -106,3 +110,65 @@ func (m *customMessageTemplateModel) InsertToTable(ctx context.Context, session
 	}
 	return nil
 }
+
+func (m *customMessageTemplateModel) InsertBatchToTable(ctx context.Context, session sqlx.Session, table string, data []*MessageTemplate) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(data))
+	args := make([]interface{}, 0, len(data)*9)
+	for i, d := range data {
+		placeholders[i] = "(?, ?, ?, ?, ?, ?, ?, ?, ?)"
+		args = append(args, d.MsgId, d.ConversationId, d.SenderId, d.ReceiverId, d.GroupId, d.SequenceId, d.MsgType, d.Content, d.Status)
+	}
+
+	// Rows already persisted by an earlier delivery are filtered by the caller; IGNORE only
+	// covers the race where another consumer inserted the same msg_id in the meantime.
+	query := fmt.Sprintf("INSERT IGNORE INTO %s (%s) VALUES %s", table, messageTemplateRowsExpectAutoSet, strings.Join(placeholders, ","))
+	_, err := session.ExecCtx(ctx, query, args...)
+	return err
+}
+
+func (m *customMessageTemplateModel) FindSeqByMsgIds(ctx context.Context, table string, msgIds []string) (map[string]int64, error) {
+	res := make(map[string]int64)
+	if len(msgIds) == 0 {
+		return res, nil
+	}
+
+	placeholders := make([]string, len(msgIds))
+	args := make([]interface{}, len(msgIds))
+	for i, id := range msgIds {
+		placeholders[i] = "?"
+		args[i] = id
+	}
+
+	query := fmt.Sprintf("SELECT msg_id, sequence_id FROM %s WHERE msg_id IN (%s)", table, strings.Join(placeholders, ","))
+	var rows []struct {
+		MsgId      string `db:"msg_id"`
+		SequenceId int64  `db:"sequence_id"`
+	}
+	if err := m.QueryRowsNoCacheCtx(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	for _, r := range rows {
+		res[r.MsgId] = r.SequenceId
+	}
+	return res, nil
+}
+
+// MaxSequenceId returns the highest persisted sequence of a conversation in table, 0 if the table
+// does not exist yet.
+func (m *customMessageTemplateModel) MaxSequenceId(ctx context.Context, table string, conversationId string) (int64, error) {
+	query := fmt.Sprintf("SELECT COALESCE(MAX(sequence_id), 0) FROM %s WHERE conversation_id = ?", table)
+	var maxSeq int64
+	err := m.QueryRowNoCacheCtx(ctx, &maxSeq, query, conversationId)
+	if err != nil {
+		// 月表尚未创建 (MySQL Error 1146)
+		if me, ok := err.(*mysql.MySQLError); ok && me.Number == 1146 {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return maxSeq, nil
+}
@@ -3,6 +3,7 @@ package model
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/zeromicro/go-zero/core/stores/cache"
@@ -20,11 +21,15 @@ type (
 		GetUserConversationsByUserId(ctx context.Context, userId int64) ([]*UserConversationWithSeq, error)
 		SearchUserConversationsByUserId(ctx context.Context, userId int64, keyword string) ([]*UserConversationWithSeq, error)
 		UpdateNewPrivateMsg(ctx context.Context, session sqlx.Session, userId int64, peerId int64, peerName string, peerAvatar string, conversationId string, lastMsg *MessageTemplate, incUnread bool) error
+		UpdateLastMsg(ctx context.Context, session sqlx.Session, userId int64, peerId int64, peerName string, peerAvatar string, conversationId string, lastMsg *MessageTemplate, unread int64) error
 		UpdateReadSequence(ctx context.Context, userId int64, conversationId string, seq int64) error
 		UpdateVersion(ctx context.Context, userId int64, conversationId string, version int64) error
 		Restore(ctx context.Context, userId int64, conversationId string) error
 		Hide(ctx context.Context, userId int64, conversationId string) error
 		GetUsersByPeerId(ctx context.Context, peerId int64) ([]int64, error)
+		FindReadSequences(ctx context.Context, conversationId string, userIds []int64) (map[int64]int64, error)
+		FindAllByUserId(ctx context.Context, userId int64) ([]*UserConversation, error)
+		AnonymizePeer(ctx context.Context, peerId int64, peerName string, peerAvatar string) error
 	}
 
 	customUserConversationModel struct {
@@ -92,6 +97,15 @@ func (m *customUserConversationModel) SearchUserConversationsByUserId(ctx contex
 }
 
 func (m *customUserConversationModel) UpdateNewPrivateMsg(ctx context.Context, session sqlx.Session, userId int64, peerId int64, peerName string, peerAvatar string, conversationId string, lastMsg *MessageTemplate, incUnread bool) error {
+	var unread int64
+	if incUnread {
+		unread = 1
+	}
+	return m.UpdateLastMsg(ctx, session, userId, peerId, peerName, peerAvatar, conversationId, lastMsg, unread)
+}
+
+// UpdateLastMsg upserts the bookmark with the latest message and adds unread to its unread counter.
+func (m *customUserConversationModel) UpdateLastMsg(ctx context.Context, session sqlx.Session, userId int64, peerId int64, peerName string, peerAvatar string, conversationId string, lastMsg *MessageTemplate, unread int64) error {
 	query := fmt.Sprintf(`
 		INSERT INTO %s (
 			user_id, conversation_id, peer_id, peer_name, peer_avatar, 
@@ -110,15 +124,10 @@ func (m *customUserConversationModel) UpdateNewPrivateMsg(ctx context.Context, s
 			is_deleted = 0
 	`, m.table)
 
-	unreadVal := 0
-	if incUnread {
-		unreadVal = 1
-	}
-
 	_, err := session.ExecCtx(ctx, query,
 		userId, conversationId, peerId, peerName, peerAvatar,
 		lastMsg.MsgId, lastMsg.CreatedAt, lastMsg.Content,
-		lastMsg.MsgType, lastMsg.SenderId, unreadVal,
+		lastMsg.MsgType, lastMsg.SenderId, unread,
 	)
 	if err != nil {
 		return err
@@ -177,3 +186,65 @@ func (m *customUserConversationModel) GetUsersByPeerId(ctx context.Context, peer
 	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, peerId)
 	return resp, err
 }
+
+// FindReadSequences returns the read_sequence of each listed user in a conversation;
+// users without a bookmark are left out.
+func (m *customUserConversationModel) FindReadSequences(ctx context.Context, conversationId string, userIds []int64) (map[int64]int64, error) {
+	res := make(map[int64]int64, len(userIds))
+	if len(userIds) == 0 {
+		return res, nil
+	}
+
+	args := make([]interface{}, 0, len(userIds)+1)
+	args = append(args, conversationId)
+	for _, uid := range userIds {
+		args = append(args, uid)
+	}
+	query := fmt.Sprintf("SELECT user_id, read_sequence FROM %s WHERE conversation_id = ? AND user_id IN (%s)",
+		m.table, strings.TrimSuffix(strings.Repeat("?,", len(userIds)), ","))
+	var rows []struct {
+		UserId       int64 `db:"user_id"`
+		ReadSequence int64 `db:"read_sequence"`
+	}
+	if err := m.QueryRowsNoCacheCtx(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	for _, r := range rows {
+		res[r.UserId] = r.ReadSequence
+	}
+	return res, nil
+}
+
+// FindAllByUserId returns every bookmark of the user, hidden ones included.
+func (m *customUserConversationModel) FindAllByUserId(ctx context.Context, userId int64) ([]*UserConversation, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE user_id = ?", userConversationRows, m.table)
+	var resp []*UserConversation
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userId)
+	return resp, err
+}
+
+// AnonymizePeer replaces the redundant name and avatar of peerId on the private conversation
+// bookmarks of the users who talked to them.
+func (m *customUserConversationModel) AnonymizePeer(ctx context.Context, peerId int64, peerName string, peerAvatar string) error {
+	query := fmt.Sprintf("SELECT user_id, conversation_id FROM %s WHERE peer_id = ? AND conversation_id LIKE 'conv\\_%%'", m.table)
+	var rows []struct {
+		UserId         int64  `db:"user_id"`
+		ConversationId string `db:"conversation_id"`
+	}
+	if err := m.QueryRowsNoCacheCtx(ctx, &rows, query, peerId); err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	update := fmt.Sprintf("UPDATE %s SET peer_name = ?, peer_avatar = ? WHERE peer_id = ? AND conversation_id LIKE 'conv\\_%%'", m.table)
+	if _, err := m.ExecNoCacheCtx(ctx, update, peerName, peerAvatar, peerId); err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(rows))
+	for _, r := range rows {
+		keys = append(keys, fmt.Sprintf("cache:userConversation:userId:conversationId:%d:%s", r.UserId, r.ConversationId))
+	}
+	return m.DelCacheCtx(ctx, keys...)
+}
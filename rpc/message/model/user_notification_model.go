@@ -0,0 +1,97 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// Values of user_notification.status
+const (
+	NotificationPending   = 0
+	NotificationDelivered = 1
+)
+
+var _ UserNotificationModel = (*customUserNotificationModel)(nil)
+
+type (
+	// UserNotificationModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customUserNotificationModel.
+	UserNotificationModel interface {
+		userNotificationModel
+		InsertBatch(ctx context.Context, data []*UserNotification) error
+		FindAllByUserId(ctx context.Context, userId int64) ([]*UserNotification, error)
+		FindPendingByUserId(ctx context.Context, userId, afterId int64, limit int) ([]*UserNotification, error)
+		MarkDelivered(ctx context.Context, userId int64, ids []int64) (int64, error)
+	}
+
+	customUserNotificationModel struct {
+		*defaultUserNotificationModel
+	}
+)
+
+// NewUserNotificationModel returns a model for the database table.
+func NewUserNotificationModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) UserNotificationModel {
+	return &customUserNotificationModel{
+		defaultUserNotificationModel: newUserNotificationModel(conn, c, opts...),
+	}
+}
+
+// InsertBatch stores notifications for several users at once; a (user_id, msg_id) pair
+// that is already stored is kept as is.
+func (m *customUserNotificationModel) InsertBatch(ctx context.Context, data []*UserNotification) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(data))
+	args := make([]interface{}, 0, len(data)*7)
+	for i, d := range data {
+		placeholders[i] = "(?, ?, ?, ?, ?, ?, ?)"
+		args = append(args, d.UserId, d.MsgId, d.ConversationId, d.Sequence, d.Payload, d.Reason, d.Status)
+	}
+
+	query := fmt.Sprintf("INSERT IGNORE INTO %s (%s) VALUES %s", m.table, userNotificationRowsExpectAutoSet, strings.Join(placeholders, ","))
+	_, err := m.ExecNoCacheCtx(ctx, query, args...)
+	return err
+}
+
+// FindAllByUserId returns every notification kept for the user, delivered or not.
+func (m *customUserNotificationModel) FindAllByUserId(ctx context.Context, userId int64) ([]*UserNotification, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `user_id` = ?", userNotificationRows, m.table)
+	var resp []*UserNotification
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userId)
+	return resp, err
+}
+
+// FindPendingByUserId returns up to limit notifications the user did not acknowledge yet, with
+// ids above afterId, oldest first.
+func (m *customUserNotificationModel) FindPendingByUserId(ctx context.Context, userId, afterId int64, limit int) ([]*UserNotification, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `user_id` = ? AND `status` = ? AND `id` > ? ORDER BY `id` LIMIT ?", userNotificationRows, m.table)
+	var resp []*UserNotification
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userId, NotificationPending, afterId, limit)
+	return resp, err
+}
+
+// MarkDelivered acknowledges the user's notifications among ids and returns how many were
+// still pending. Only the uncached queries read the status, so no cache key is cleared.
+func (m *customUserNotificationModel) MarkDelivered(ctx context.Context, userId int64, ids []int64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	args := make([]interface{}, 0, len(ids)+3)
+	args = append(args, NotificationDelivered, userId, NotificationPending)
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	query := fmt.Sprintf("UPDATE %s SET `status` = ? WHERE `user_id` = ? AND `status` = ? AND `id` IN (%s)", m.table,
+		strings.TrimSuffix(strings.Repeat("?,", len(ids)), ","))
+	res, err := m.ExecNoCacheCtx(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
@@ -76,6 +76,223 @@ func (DevicePlatform) EnumDescriptor() ([]byte, []int) {
 	return file_common_proto_rawDescGZIP(), []int{0}
 }
 
+// Why a connection was closed by the server; gateways send it as WebSocket close code 4000 + value
+type KickReason int32
+
+const (
+	KickReason_KICK_REASON_UNSPECIFIED         KickReason = 0
+	KickReason_KICK_REASON_PASSWORD_CHANGED    KickReason = 1
+	KickReason_KICK_REASON_BANNED              KickReason = 2
+	KickReason_KICK_REASON_LOGGED_IN_ELSEWHERE KickReason = 3
+	KickReason_KICK_REASON_DEVICE_REMOVED      KickReason = 4
+	KickReason_KICK_REASON_LOGGED_OUT          KickReason = 5
+	KickReason_KICK_REASON_SESSION_REVOKED     KickReason = 6 // e.g. a refresh token was reused
+	KickReason_KICK_REASON_ACCOUNT_CLOSED      KickReason = 7 // deactivated or deleted by the user
+)
+
+// Enum value maps for KickReason.
+var (
+	KickReason_name = map[int32]string{
+		0: "KICK_REASON_UNSPECIFIED",
+		1: "KICK_REASON_PASSWORD_CHANGED",
+		2: "KICK_REASON_BANNED",
+		3: "KICK_REASON_LOGGED_IN_ELSEWHERE",
+		4: "KICK_REASON_DEVICE_REMOVED",
+		5: "KICK_REASON_LOGGED_OUT",
+		6: "KICK_REASON_SESSION_REVOKED",
+		7: "KICK_REASON_ACCOUNT_CLOSED",
+	}
+	KickReason_value = map[string]int32{
+		"KICK_REASON_UNSPECIFIED":         0,
+		"KICK_REASON_PASSWORD_CHANGED":    1,
+		"KICK_REASON_BANNED":              2,
+		"KICK_REASON_LOGGED_IN_ELSEWHERE": 3,
+		"KICK_REASON_DEVICE_REMOVED":      4,
+		"KICK_REASON_LOGGED_OUT":          5,
+		"KICK_REASON_SESSION_REVOKED":     6,
+		"KICK_REASON_ACCOUNT_CLOSED":      7,
+	}
+)
+
+func (x KickReason) Enum() *KickReason {
+	p := new(KickReason)
+	*p = x
+	return p
+}
+
+func (x KickReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KickReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[1].Descriptor()
+}
+
+func (KickReason) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[1]
+}
+
+func (x KickReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KickReason.Descriptor instead.
+func (KickReason) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{1}
+}
+
+// Who may see a profile field or the last seen time, or add the user to groups
+type Audience int32
+
+const (
+	Audience_AUDIENCE_EVERYONE Audience = 0
+	Audience_AUDIENCE_FRIENDS  Audience = 1
+	Audience_AUDIENCE_NOBODY   Audience = 2
+)
+
+// Enum value maps for Audience.
+var (
+	Audience_name = map[int32]string{
+		0: "AUDIENCE_EVERYONE",
+		1: "AUDIENCE_FRIENDS",
+		2: "AUDIENCE_NOBODY",
+	}
+	Audience_value = map[string]int32{
+		"AUDIENCE_EVERYONE": 0,
+		"AUDIENCE_FRIENDS":  1,
+		"AUDIENCE_NOBODY":   2,
+	}
+)
+
+func (x Audience) Enum() *Audience {
+	p := new(Audience)
+	*p = x
+	return p
+}
+
+func (x Audience) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Audience) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[2].Descriptor()
+}
+
+func (Audience) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[2]
+}
+
+func (x Audience) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Audience.Descriptor instead.
+func (Audience) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{2}
+}
+
+// Who may send the user friend requests
+type FriendRequestPolicy int32
+
+const (
+	FriendRequestPolicy_FRIEND_REQUEST_ANYONE             FriendRequestPolicy = 0
+	FriendRequestPolicy_FRIEND_REQUEST_FRIENDS_OF_FRIENDS FriendRequestPolicy = 1 // at least one friend in common
+	FriendRequestPolicy_FRIEND_REQUEST_NOBODY             FriendRequestPolicy = 2
+	FriendRequestPolicy_FRIEND_REQUEST_QUESTION           FriendRequestPolicy = 3 // whoever answers the user's question
+)
+
+// Enum value maps for FriendRequestPolicy.
+var (
+	FriendRequestPolicy_name = map[int32]string{
+		0: "FRIEND_REQUEST_ANYONE",
+		1: "FRIEND_REQUEST_FRIENDS_OF_FRIENDS",
+		2: "FRIEND_REQUEST_NOBODY",
+		3: "FRIEND_REQUEST_QUESTION",
+	}
+	FriendRequestPolicy_value = map[string]int32{
+		"FRIEND_REQUEST_ANYONE":             0,
+		"FRIEND_REQUEST_FRIENDS_OF_FRIENDS": 1,
+		"FRIEND_REQUEST_NOBODY":             2,
+		"FRIEND_REQUEST_QUESTION":           3,
+	}
+)
+
+func (x FriendRequestPolicy) Enum() *FriendRequestPolicy {
+	p := new(FriendRequestPolicy)
+	*p = x
+	return p
+}
+
+func (x FriendRequestPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FriendRequestPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[3].Descriptor()
+}
+
+func (FriendRequestPolicy) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[3]
+}
+
+func (x FriendRequestPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FriendRequestPolicy.Descriptor instead.
+func (FriendRequestPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{3}
+}
+
+type PresenceState int32
+
+const (
+	PresenceState_PRESENCE_STATE_OFFLINE PresenceState = 0
+	PresenceState_PRESENCE_STATE_ONLINE  PresenceState = 1
+	PresenceState_PRESENCE_STATE_AWAY    PresenceState = 2
+)
+
+// Enum value maps for PresenceState.
+var (
+	PresenceState_name = map[int32]string{
+		0: "PRESENCE_STATE_OFFLINE",
+		1: "PRESENCE_STATE_ONLINE",
+		2: "PRESENCE_STATE_AWAY",
+	}
+	PresenceState_value = map[string]int32{
+		"PRESENCE_STATE_OFFLINE": 0,
+		"PRESENCE_STATE_ONLINE":  1,
+		"PRESENCE_STATE_AWAY":    2,
+	}
+)
+
+func (x PresenceState) Enum() *PresenceState {
+	p := new(PresenceState)
+	*p = x
+	return p
+}
+
+func (x PresenceState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PresenceState) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[4].Descriptor()
+}
+
+func (PresenceState) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[4]
+}
+
+func (x PresenceState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PresenceState.Descriptor instead.
+func (PresenceState) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{4}
+}
+
 type MessageStatus int32
 
 const (
@@ -118,11 +335,11 @@ func (x MessageStatus) String() string {
 }
 
 func (MessageStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_common_proto_enumTypes[1].Descriptor()
+	return file_common_proto_enumTypes[5].Descriptor()
 }
 
 func (MessageStatus) Type() protoreflect.EnumType {
-	return &file_common_proto_enumTypes[1]
+	return &file_common_proto_enumTypes[5]
 }
 
 func (x MessageStatus) Number() protoreflect.EnumNumber {
@@ -131,7 +348,7 @@ func (x MessageStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MessageStatus.Descriptor instead.
 func (MessageStatus) EnumDescriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{1}
+	return file_common_proto_rawDescGZIP(), []int{5}
 }
 
 type BaseResponse struct {
@@ -447,7 +664,30 @@ const file_common_proto_rawDesc = "" +
 	"\fPLATFORM_WEB\x10\x01\x12\x10\n" +
 	"\fPLATFORM_IOS\x10\x02\x12\x14\n" +
 	"\x10PLATFORM_ANDROID\x10\x03\x12\x14\n" +
-	"\x10PLATFORM_DESKTOP\x10\x04*\xb6\x01\n" +
+	"\x10PLATFORM_DESKTOP\x10\x04*\x85\x02\n" +
+	"\n" +
+	"KickReason\x12\x1b\n" +
+	"\x17KICK_REASON_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cKICK_REASON_PASSWORD_CHANGED\x10\x01\x12\x16\n" +
+	"\x12KICK_REASON_BANNED\x10\x02\x12#\n" +
+	"\x1fKICK_REASON_LOGGED_IN_ELSEWHERE\x10\x03\x12\x1e\n" +
+	"\x1aKICK_REASON_DEVICE_REMOVED\x10\x04\x12\x1a\n" +
+	"\x16KICK_REASON_LOGGED_OUT\x10\x05\x12\x1f\n" +
+	"\x1bKICK_REASON_SESSION_REVOKED\x10\x06\x12\x1e\n" +
+	"\x1aKICK_REASON_ACCOUNT_CLOSED\x10\a*L\n" +
+	"\bAudience\x12\x15\n" +
+	"\x11AUDIENCE_EVERYONE\x10\x00\x12\x14\n" +
+	"\x10AUDIENCE_FRIENDS\x10\x01\x12\x13\n" +
+	"\x0fAUDIENCE_NOBODY\x10\x02*\x8f\x01\n" +
+	"\x13FriendRequestPolicy\x12\x19\n" +
+	"\x15FRIEND_REQUEST_ANYONE\x10\x00\x12%\n" +
+	"!FRIEND_REQUEST_FRIENDS_OF_FRIENDS\x10\x01\x12\x19\n" +
+	"\x15FRIEND_REQUEST_NOBODY\x10\x02\x12\x1b\n" +
+	"\x17FRIEND_REQUEST_QUESTION\x10\x03*_\n" +
+	"\rPresenceState\x12\x1a\n" +
+	"\x16PRESENCE_STATE_OFFLINE\x10\x00\x12\x19\n" +
+	"\x15PRESENCE_STATE_ONLINE\x10\x01\x12\x17\n" +
+	"\x13PRESENCE_STATE_AWAY\x10\x02*\xb6\x01\n" +
 	"\rMessageStatus\x12\x1e\n" +
 	"\x1aMESSAGE_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16MESSAGE_STATUS_SENDING\x10\x01\x12\x17\n" +
@@ -468,15 +708,19 @@ func file_common_proto_rawDescGZIP() []byte {
 	return file_common_proto_rawDescData
 }
 
-var file_common_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_common_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
 var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_common_proto_goTypes = []any{
-	(DevicePlatform)(0),   // 0: gochat.rpc.DevicePlatform
-	(MessageStatus)(0),    // 1: gochat.rpc.MessageStatus
-	(*BaseResponse)(nil),  // 2: gochat.rpc.BaseResponse
-	(*PagingRequest)(nil), // 3: gochat.rpc.PagingRequest
-	(*UserAvatar)(nil),    // 4: gochat.rpc.UserAvatar
-	(*GroupSummary)(nil),  // 5: gochat.rpc.GroupSummary
+	(DevicePlatform)(0),      // 0: gochat.rpc.DevicePlatform
+	(KickReason)(0),          // 1: gochat.rpc.KickReason
+	(Audience)(0),            // 2: gochat.rpc.Audience
+	(FriendRequestPolicy)(0), // 3: gochat.rpc.FriendRequestPolicy
+	(PresenceState)(0),       // 4: gochat.rpc.PresenceState
+	(MessageStatus)(0),       // 5: gochat.rpc.MessageStatus
+	(*BaseResponse)(nil),     // 6: gochat.rpc.BaseResponse
+	(*PagingRequest)(nil),    // 7: gochat.rpc.PagingRequest
+	(*UserAvatar)(nil),       // 8: gochat.rpc.UserAvatar
+	(*GroupSummary)(nil),     // 9: gochat.rpc.GroupSummary
 }
 var file_common_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -496,7 +740,7 @@ func file_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_proto_rawDesc), len(file_common_proto_rawDesc)),
-			NumEnums:      2,
+			NumEnums:      6,
 			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -21,6 +21,110 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type GetGroupMemberIdsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupMemberIdsRequest) Reset() {
+	*x = GetGroupMemberIdsRequest{}
+	mi := &file_group_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupMemberIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupMemberIdsRequest) ProtoMessage() {}
+
+func (x *GetGroupMemberIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupMemberIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupMemberIdsRequest) Descriptor() ([]byte, []int) {
+	return file_group_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetGroupMemberIdsRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type GetGroupMemberIdsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	MemberIds     []int64                `protobuf:"varint,2,rep,packed,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+	MemberVersion int64                  `protobuf:"varint,3,opt,name=member_version,json=memberVersion,proto3" json:"member_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupMemberIdsResponse) Reset() {
+	*x = GetGroupMemberIdsResponse{}
+	mi := &file_group_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupMemberIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupMemberIdsResponse) ProtoMessage() {}
+
+func (x *GetGroupMemberIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupMemberIdsResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupMemberIdsResponse) Descriptor() ([]byte, []int) {
+	return file_group_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetGroupMemberIdsResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetGroupMemberIdsResponse) GetMemberIds() []int64 {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+func (x *GetGroupMemberIdsResponse) GetMemberVersion() int64 {
+	if x != nil {
+		return x.MemberVersion
+	}
+	return 0
+}
+
 type GetGroupsByIdsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GroupIds      []int64                `protobuf:"varint,1,rep,packed,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"`
@@ -30,7 +134,7 @@ type GetGroupsByIdsRequest struct {
 
 func (x *GetGroupsByIdsRequest) Reset() {
 	*x = GetGroupsByIdsRequest{}
-	mi := &file_group_proto_msgTypes[0]
+	mi := &file_group_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -42,7 +146,7 @@ func (x *GetGroupsByIdsRequest) String() string {
 func (*GetGroupsByIdsRequest) ProtoMessage() {}
 
 func (x *GetGroupsByIdsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[0]
+	mi := &file_group_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -55,7 +159,7 @@ func (x *GetGroupsByIdsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupsByIdsRequest.ProtoReflect.Descriptor instead.
 func (*GetGroupsByIdsRequest) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{0}
+	return file_group_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GetGroupsByIdsRequest) GetGroupIds() []int64 {
@@ -75,7 +179,7 @@ type GetGroupsByIdsResponse struct {
 
 func (x *GetGroupsByIdsResponse) Reset() {
 	*x = GetGroupsByIdsResponse{}
-	mi := &file_group_proto_msgTypes[1]
+	mi := &file_group_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -87,7 +191,7 @@ func (x *GetGroupsByIdsResponse) String() string {
 func (*GetGroupsByIdsResponse) ProtoMessage() {}
 
 func (x *GetGroupsByIdsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[1]
+	mi := &file_group_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -100,7 +204,7 @@ func (x *GetGroupsByIdsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupsByIdsResponse.ProtoReflect.Descriptor instead.
 func (*GetGroupsByIdsResponse) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{1}
+	return file_group_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetGroupsByIdsResponse) GetBase() *BaseResponse {
@@ -126,7 +230,7 @@ type GetGroupRequestsRequest struct {
 
 func (x *GetGroupRequestsRequest) Reset() {
 	*x = GetGroupRequestsRequest{}
-	mi := &file_group_proto_msgTypes[2]
+	mi := &file_group_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -138,7 +242,7 @@ func (x *GetGroupRequestsRequest) String() string {
 func (*GetGroupRequestsRequest) ProtoMessage() {}
 
 func (x *GetGroupRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[2]
+	mi := &file_group_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -151,7 +255,7 @@ func (x *GetGroupRequestsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupRequestsRequest.ProtoReflect.Descriptor instead.
 func (*GetGroupRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{2}
+	return file_group_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetGroupRequestsRequest) GetGroupId() int64 {
@@ -171,7 +275,7 @@ type GetGroupRequestsResponse struct {
 
 func (x *GetGroupRequestsResponse) Reset() {
 	*x = GetGroupRequestsResponse{}
-	mi := &file_group_proto_msgTypes[3]
+	mi := &file_group_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -183,7 +287,7 @@ func (x *GetGroupRequestsResponse) String() string {
 func (*GetGroupRequestsResponse) ProtoMessage() {}
 
 func (x *GetGroupRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[3]
+	mi := &file_group_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -196,7 +300,7 @@ func (x *GetGroupRequestsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupRequestsResponse.ProtoReflect.Descriptor instead.
 func (*GetGroupRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{3}
+	return file_group_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetGroupRequestsResponse) GetBase() *BaseResponse {
@@ -223,7 +327,7 @@ type HandleGroupRequestRequest struct {
 
 func (x *HandleGroupRequestRequest) Reset() {
 	*x = HandleGroupRequestRequest{}
-	mi := &file_group_proto_msgTypes[4]
+	mi := &file_group_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -235,7 +339,7 @@ func (x *HandleGroupRequestRequest) String() string {
 func (*HandleGroupRequestRequest) ProtoMessage() {}
 
 func (x *HandleGroupRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[4]
+	mi := &file_group_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -248,7 +352,7 @@ func (x *HandleGroupRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HandleGroupRequestRequest.ProtoReflect.Descriptor instead.
 func (*HandleGroupRequestRequest) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{4}
+	return file_group_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *HandleGroupRequestRequest) GetRequestId() int64 {
@@ -274,7 +378,7 @@ type HandleGroupRequestResponse struct {
 
 func (x *HandleGroupRequestResponse) Reset() {
 	*x = HandleGroupRequestResponse{}
-	mi := &file_group_proto_msgTypes[5]
+	mi := &file_group_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -286,7 +390,7 @@ func (x *HandleGroupRequestResponse) String() string {
 func (*HandleGroupRequestResponse) ProtoMessage() {}
 
 func (x *HandleGroupRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[5]
+	mi := &file_group_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -299,7 +403,7 @@ func (x *HandleGroupRequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HandleGroupRequestResponse.ProtoReflect.Descriptor instead.
 func (*HandleGroupRequestResponse) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{5}
+	return file_group_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *HandleGroupRequestResponse) GetBase() *BaseResponse {
@@ -325,7 +429,7 @@ type GroupRequest struct {
 
 func (x *GroupRequest) Reset() {
 	*x = GroupRequest{}
-	mi := &file_group_proto_msgTypes[6]
+	mi := &file_group_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -337,7 +441,7 @@ func (x *GroupRequest) String() string {
 func (*GroupRequest) ProtoMessage() {}
 
 func (x *GroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[6]
+	mi := &file_group_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -350,7 +454,7 @@ func (x *GroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupRequest.ProtoReflect.Descriptor instead.
 func (*GroupRequest) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{6}
+	return file_group_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GroupRequest) GetId() int64 {
@@ -420,7 +524,7 @@ type UpdateGroupNicknameRequest struct {
 
 func (x *UpdateGroupNicknameRequest) Reset() {
 	*x = UpdateGroupNicknameRequest{}
-	mi := &file_group_proto_msgTypes[7]
+	mi := &file_group_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -432,7 +536,7 @@ func (x *UpdateGroupNicknameRequest) String() string {
 func (*UpdateGroupNicknameRequest) ProtoMessage() {}
 
 func (x *UpdateGroupNicknameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[7]
+	mi := &file_group_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,7 +549,7 @@ func (x *UpdateGroupNicknameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateGroupNicknameRequest.ProtoReflect.Descriptor instead.
 func (*UpdateGroupNicknameRequest) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{7}
+	return file_group_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateGroupNicknameRequest) GetGroupId() int64 {
@@ -478,7 +582,7 @@ type UpdateGroupNicknameResponse struct {
 
 func (x *UpdateGroupNicknameResponse) Reset() {
 	*x = UpdateGroupNicknameResponse{}
-	mi := &file_group_proto_msgTypes[8]
+	mi := &file_group_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -490,7 +594,7 @@ func (x *UpdateGroupNicknameResponse) String() string {
 func (*UpdateGroupNicknameResponse) ProtoMessage() {}
 
 func (x *UpdateGroupNicknameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[8]
+	mi := &file_group_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -503,7 +607,7 @@ func (x *UpdateGroupNicknameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateGroupNicknameResponse.ProtoReflect.Descriptor instead.
 func (*UpdateGroupNicknameResponse) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{8}
+	return file_group_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateGroupNicknameResponse) GetBase() *BaseResponse {
@@ -523,7 +627,7 @@ type CheckGroupMemberRequest struct {
 
 func (x *CheckGroupMemberRequest) Reset() {
 	*x = CheckGroupMemberRequest{}
-	mi := &file_group_proto_msgTypes[9]
+	mi := &file_group_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -535,7 +639,7 @@ func (x *CheckGroupMemberRequest) String() string {
 func (*CheckGroupMemberRequest) ProtoMessage() {}
 
 func (x *CheckGroupMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[9]
+	mi := &file_group_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -548,7 +652,7 @@ func (x *CheckGroupMemberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckGroupMemberRequest.ProtoReflect.Descriptor instead.
 func (*CheckGroupMemberRequest) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{9}
+	return file_group_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CheckGroupMemberRequest) GetUserId() int64 {
@@ -575,7 +679,7 @@ type CheckGroupMemberResponse struct {
 
 func (x *CheckGroupMemberResponse) Reset() {
 	*x = CheckGroupMemberResponse{}
-	mi := &file_group_proto_msgTypes[10]
+	mi := &file_group_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -587,7 +691,7 @@ func (x *CheckGroupMemberResponse) String() string {
 func (*CheckGroupMemberResponse) ProtoMessage() {}
 
 func (x *CheckGroupMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[10]
+	mi := &file_group_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -600,7 +704,7 @@ func (x *CheckGroupMemberResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckGroupMemberResponse.ProtoReflect.Descriptor instead.
 func (*CheckGroupMemberResponse) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{10}
+	return file_group_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CheckGroupMemberResponse) GetIsMember() bool {
@@ -635,7 +739,7 @@ type Group struct {
 
 func (x *Group) Reset() {
 	*x = Group{}
-	mi := &file_group_proto_msgTypes[11]
+	mi := &file_group_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -647,7 +751,7 @@ func (x *Group) String() string {
 func (*Group) ProtoMessage() {}
 
 func (x *Group) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[11]
+	mi := &file_group_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -660,7 +764,7 @@ func (x *Group) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Group.ProtoReflect.Descriptor instead.
 func (*Group) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{11}
+	return file_group_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Group) GetId() int64 {
@@ -747,7 +851,7 @@ type GroupMember struct {
 
 func (x *GroupMember) Reset() {
 	*x = GroupMember{}
-	mi := &file_group_proto_msgTypes[12]
+	mi := &file_group_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -759,7 +863,7 @@ func (x *GroupMember) String() string {
 func (*GroupMember) ProtoMessage() {}
 
 func (x *GroupMember) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[12]
+	mi := &file_group_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -772,7 +876,7 @@ func (x *GroupMember) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupMember.ProtoReflect.Descriptor instead.
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{12}
+	return file_group_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GroupMember) GetUserId() int64 {
@@ -828,7 +932,7 @@ type CreateGroupRequest struct {
 
 func (x *CreateGroupRequest) Reset() {
 	*x = CreateGroupRequest{}
-	mi := &file_group_proto_msgTypes[13]
+	mi := &file_group_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -840,7 +944,7 @@ func (x *CreateGroupRequest) String() string {
 func (*CreateGroupRequest) ProtoMessage() {}
 
 func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[13]
+	mi := &file_group_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -853,7 +957,7 @@ func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
 func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{13}
+	return file_group_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CreateGroupRequest) GetName() string {
@@ -887,7 +991,7 @@ type CreateGroupResponse struct {
 
 func (x *CreateGroupResponse) Reset() {
 	*x = CreateGroupResponse{}
-	mi := &file_group_proto_msgTypes[14]
+	mi := &file_group_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -899,7 +1003,7 @@ func (x *CreateGroupResponse) String() string {
 func (*CreateGroupResponse) ProtoMessage() {}
 
 func (x *CreateGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[14]
+	mi := &file_group_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -912,7 +1016,7 @@ func (x *CreateGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateGroupResponse.ProtoReflect.Descriptor instead.
 func (*CreateGroupResponse) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{14}
+	return file_group_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CreateGroupResponse) GetBase() *BaseResponse {
@@ -937,7 +1041,7 @@ type GetGroupListRequest struct {
 
 func (x *GetGroupListRequest) Reset() {
 	*x = GetGroupListRequest{}
-	mi := &file_group_proto_msgTypes[15]
+	mi := &file_group_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -949,7 +1053,7 @@ func (x *GetGroupListRequest) String() string {
 func (*GetGroupListRequest) ProtoMessage() {}
 
 func (x *GetGroupListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[15]
+	mi := &file_group_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -962,7 +1066,7 @@ func (x *GetGroupListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupListRequest.ProtoReflect.Descriptor instead.
 func (*GetGroupListRequest) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{15}
+	return file_group_proto_rawDescGZIP(), []int{17}
 }
 
 type GetGroupListResponse struct {
@@ -975,7 +1079,7 @@ type GetGroupListResponse struct {
 
 func (x *GetGroupListResponse) Reset() {
 	*x = GetGroupListResponse{}
-	mi := &file_group_proto_msgTypes[16]
+	mi := &file_group_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -987,7 +1091,7 @@ func (x *GetGroupListResponse) String() string {
 func (*GetGroupListResponse) ProtoMessage() {}
 
 func (x *GetGroupListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[16]
+	mi := &file_group_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1000,7 +1104,7 @@ func (x *GetGroupListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupListResponse.ProtoReflect.Descriptor instead.
 func (*GetGroupListResponse) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{16}
+	return file_group_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetGroupListResponse) GetBase() *BaseResponse {
@@ -1026,7 +1130,7 @@ type GetGroupInfoRequest struct {
 
 func (x *GetGroupInfoRequest) Reset() {
 	*x = GetGroupInfoRequest{}
-	mi := &file_group_proto_msgTypes[17]
+	mi := &file_group_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1038,7 +1142,7 @@ func (x *GetGroupInfoRequest) String() string {
 func (*GetGroupInfoRequest) ProtoMessage() {}
 
 func (x *GetGroupInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[17]
+	mi := &file_group_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1051,7 +1155,7 @@ func (x *GetGroupInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetGroupInfoRequest) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{17}
+	return file_group_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetGroupInfoRequest) GetGroupId() int64 {
@@ -1071,7 +1175,7 @@ type GetGroupInfoResponse struct {
 
 func (x *GetGroupInfoResponse) Reset() {
 	*x = GetGroupInfoResponse{}
-	mi := &file_group_proto_msgTypes[18]
+	mi := &file_group_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1083,7 +1187,7 @@ func (x *GetGroupInfoResponse) String() string {
 func (*GetGroupInfoResponse) ProtoMessage() {}
 
 func (x *GetGroupInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[18]
+	mi := &file_group_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1096,7 +1200,7 @@ func (x *GetGroupInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetGroupInfoResponse) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{18}
+	return file_group_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetGroupInfoResponse) GetBase() *BaseResponse {
@@ -1122,7 +1226,7 @@ type GetGroupMembersRequest struct {
 
 func (x *GetGroupMembersRequest) Reset() {
 	*x = GetGroupMembersRequest{}
-	mi := &file_group_proto_msgTypes[19]
+	mi := &file_group_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1134,7 +1238,7 @@ func (x *GetGroupMembersRequest) String() string {
 func (*GetGroupMembersRequest) ProtoMessage() {}
 
 func (x *GetGroupMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[19]
+	mi := &file_group_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1147,7 +1251,7 @@ func (x *GetGroupMembersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupMembersRequest.ProtoReflect.Descriptor instead.
 func (*GetGroupMembersRequest) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{19}
+	return file_group_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetGroupMembersRequest) GetGroupId() int64 {
@@ -1167,7 +1271,7 @@ type GetGroupMembersResponse struct {
 
 func (x *GetGroupMembersResponse) Reset() {
 	*x = GetGroupMembersResponse{}
-	mi := &file_group_proto_msgTypes[20]
+	mi := &file_group_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1179,7 +1283,7 @@ func (x *GetGroupMembersResponse) String() string {
 func (*GetGroupMembersResponse) ProtoMessage() {}
 
 func (x *GetGroupMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[20]
+	mi := &file_group_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1192,7 +1296,7 @@ func (x *GetGroupMembersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupMembersResponse.ProtoReflect.Descriptor instead.
 func (*GetGroupMembersResponse) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{20}
+	return file_group_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetGroupMembersResponse) GetBase() *BaseResponse {
@@ -1219,7 +1323,7 @@ type JoinGroupRequest struct {
 
 func (x *JoinGroupRequest) Reset() {
 	*x = JoinGroupRequest{}
-	mi := &file_group_proto_msgTypes[21]
+	mi := &file_group_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1231,7 +1335,7 @@ func (x *JoinGroupRequest) String() string {
 func (*JoinGroupRequest) ProtoMessage() {}
 
 func (x *JoinGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[21]
+	mi := &file_group_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1244,7 +1348,7 @@ func (x *JoinGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinGroupRequest.ProtoReflect.Descriptor instead.
 func (*JoinGroupRequest) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{21}
+	return file_group_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *JoinGroupRequest) GetGroupId() int64 {
@@ -1270,7 +1374,7 @@ type JoinGroupResponse struct {
 
 func (x *JoinGroupResponse) Reset() {
 	*x = JoinGroupResponse{}
-	mi := &file_group_proto_msgTypes[22]
+	mi := &file_group_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1282,7 +1386,7 @@ func (x *JoinGroupResponse) String() string {
 func (*JoinGroupResponse) ProtoMessage() {}
 
 func (x *JoinGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[22]
+	mi := &file_group_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1295,7 +1399,7 @@ func (x *JoinGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinGroupResponse.ProtoReflect.Descriptor instead.
 func (*JoinGroupResponse) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{22}
+	return file_group_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *JoinGroupResponse) GetBase() *BaseResponse {
@@ -1314,7 +1418,7 @@ type QuitGroupRequest struct {
 
 func (x *QuitGroupRequest) Reset() {
 	*x = QuitGroupRequest{}
-	mi := &file_group_proto_msgTypes[23]
+	mi := &file_group_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1326,7 +1430,7 @@ func (x *QuitGroupRequest) String() string {
 func (*QuitGroupRequest) ProtoMessage() {}
 
 func (x *QuitGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[23]
+	mi := &file_group_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1339,7 +1443,7 @@ func (x *QuitGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuitGroupRequest.ProtoReflect.Descriptor instead.
 func (*QuitGroupRequest) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{23}
+	return file_group_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *QuitGroupRequest) GetGroupId() int64 {
@@ -1358,7 +1462,7 @@ type QuitGroupResponse struct {
 
 func (x *QuitGroupResponse) Reset() {
 	*x = QuitGroupResponse{}
-	mi := &file_group_proto_msgTypes[24]
+	mi := &file_group_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1370,7 +1474,7 @@ func (x *QuitGroupResponse) String() string {
 func (*QuitGroupResponse) ProtoMessage() {}
 
 func (x *QuitGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[24]
+	mi := &file_group_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1383,7 +1487,7 @@ func (x *QuitGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuitGroupResponse.ProtoReflect.Descriptor instead.
 func (*QuitGroupResponse) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{24}
+	return file_group_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *QuitGroupResponse) GetBase() *BaseResponse {
@@ -1403,7 +1507,7 @@ type KickGroupMemberRequest struct {
 
 func (x *KickGroupMemberRequest) Reset() {
 	*x = KickGroupMemberRequest{}
-	mi := &file_group_proto_msgTypes[25]
+	mi := &file_group_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1415,7 +1519,7 @@ func (x *KickGroupMemberRequest) String() string {
 func (*KickGroupMemberRequest) ProtoMessage() {}
 
 func (x *KickGroupMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[25]
+	mi := &file_group_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1428,7 +1532,7 @@ func (x *KickGroupMemberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KickGroupMemberRequest.ProtoReflect.Descriptor instead.
 func (*KickGroupMemberRequest) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{25}
+	return file_group_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *KickGroupMemberRequest) GetGroupId() int64 {
@@ -1454,7 +1558,7 @@ type KickGroupMemberResponse struct {
 
 func (x *KickGroupMemberResponse) Reset() {
 	*x = KickGroupMemberResponse{}
-	mi := &file_group_proto_msgTypes[26]
+	mi := &file_group_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1466,7 +1570,7 @@ func (x *KickGroupMemberResponse) String() string {
 func (*KickGroupMemberResponse) ProtoMessage() {}
 
 func (x *KickGroupMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[26]
+	mi := &file_group_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1479,7 +1583,7 @@ func (x *KickGroupMemberResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KickGroupMemberResponse.ProtoReflect.Descriptor instead.
 func (*KickGroupMemberResponse) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{26}
+	return file_group_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *KickGroupMemberResponse) GetBase() *BaseResponse {
@@ -1498,7 +1602,7 @@ type DismissGroupRequest struct {
 
 func (x *DismissGroupRequest) Reset() {
 	*x = DismissGroupRequest{}
-	mi := &file_group_proto_msgTypes[27]
+	mi := &file_group_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1510,7 +1614,7 @@ func (x *DismissGroupRequest) String() string {
 func (*DismissGroupRequest) ProtoMessage() {}
 
 func (x *DismissGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[27]
+	mi := &file_group_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1523,7 +1627,7 @@ func (x *DismissGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DismissGroupRequest.ProtoReflect.Descriptor instead.
 func (*DismissGroupRequest) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{27}
+	return file_group_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *DismissGroupRequest) GetGroupId() int64 {
@@ -1542,7 +1646,7 @@ type DismissGroupResponse struct {
 
 func (x *DismissGroupResponse) Reset() {
 	*x = DismissGroupResponse{}
-	mi := &file_group_proto_msgTypes[28]
+	mi := &file_group_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1554,7 +1658,7 @@ func (x *DismissGroupResponse) String() string {
 func (*DismissGroupResponse) ProtoMessage() {}
 
 func (x *DismissGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[28]
+	mi := &file_group_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1567,7 +1671,7 @@ func (x *DismissGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DismissGroupResponse.ProtoReflect.Descriptor instead.
 func (*DismissGroupResponse) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{28}
+	return file_group_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *DismissGroupResponse) GetBase() *BaseResponse {
@@ -1587,7 +1691,7 @@ type UpdateAnnouncementRequest struct {
 
 func (x *UpdateAnnouncementRequest) Reset() {
 	*x = UpdateAnnouncementRequest{}
-	mi := &file_group_proto_msgTypes[29]
+	mi := &file_group_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1599,7 +1703,7 @@ func (x *UpdateAnnouncementRequest) String() string {
 func (*UpdateAnnouncementRequest) ProtoMessage() {}
 
 func (x *UpdateAnnouncementRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[29]
+	mi := &file_group_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1612,7 +1716,7 @@ func (x *UpdateAnnouncementRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAnnouncementRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAnnouncementRequest) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{29}
+	return file_group_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *UpdateAnnouncementRequest) GetGroupId() int64 {
@@ -1638,7 +1742,7 @@ type UpdateAnnouncementResponse struct {
 
 func (x *UpdateAnnouncementResponse) Reset() {
 	*x = UpdateAnnouncementResponse{}
-	mi := &file_group_proto_msgTypes[30]
+	mi := &file_group_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1650,7 +1754,7 @@ func (x *UpdateAnnouncementResponse) String() string {
 func (*UpdateAnnouncementResponse) ProtoMessage() {}
 
 func (x *UpdateAnnouncementResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[30]
+	mi := &file_group_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1663,7 +1767,7 @@ func (x *UpdateAnnouncementResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAnnouncementResponse.ProtoReflect.Descriptor instead.
 func (*UpdateAnnouncementResponse) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{30}
+	return file_group_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *UpdateAnnouncementResponse) GetBase() *BaseResponse {
@@ -1682,7 +1786,7 @@ type GetAnnouncementRequest struct {
 
 func (x *GetAnnouncementRequest) Reset() {
 	*x = GetAnnouncementRequest{}
-	mi := &file_group_proto_msgTypes[31]
+	mi := &file_group_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1694,7 +1798,7 @@ func (x *GetAnnouncementRequest) String() string {
 func (*GetAnnouncementRequest) ProtoMessage() {}
 
 func (x *GetAnnouncementRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[31]
+	mi := &file_group_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1707,7 +1811,7 @@ func (x *GetAnnouncementRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAnnouncementRequest.ProtoReflect.Descriptor instead.
 func (*GetAnnouncementRequest) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{31}
+	return file_group_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetAnnouncementRequest) GetGroupId() int64 {
@@ -1727,7 +1831,7 @@ type GetAnnouncementResponse struct {
 
 func (x *GetAnnouncementResponse) Reset() {
 	*x = GetAnnouncementResponse{}
-	mi := &file_group_proto_msgTypes[32]
+	mi := &file_group_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1739,7 +1843,7 @@ func (x *GetAnnouncementResponse) String() string {
 func (*GetAnnouncementResponse) ProtoMessage() {}
 
 func (x *GetAnnouncementResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[32]
+	mi := &file_group_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1752,7 +1856,7 @@ func (x *GetAnnouncementResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAnnouncementResponse.ProtoReflect.Descriptor instead.
 func (*GetAnnouncementResponse) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{32}
+	return file_group_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GetAnnouncementResponse) GetBase() *BaseResponse {
@@ -1778,7 +1882,7 @@ type SearchGroupsRequest struct {
 
 func (x *SearchGroupsRequest) Reset() {
 	*x = SearchGroupsRequest{}
-	mi := &file_group_proto_msgTypes[33]
+	mi := &file_group_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1790,7 +1894,7 @@ func (x *SearchGroupsRequest) String() string {
 func (*SearchGroupsRequest) ProtoMessage() {}
 
 func (x *SearchGroupsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[33]
+	mi := &file_group_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1803,7 +1907,7 @@ func (x *SearchGroupsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchGroupsRequest.ProtoReflect.Descriptor instead.
 func (*SearchGroupsRequest) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{33}
+	return file_group_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *SearchGroupsRequest) GetKeyword() string {
@@ -1823,7 +1927,7 @@ type SearchGroupsResponse struct {
 
 func (x *SearchGroupsResponse) Reset() {
 	*x = SearchGroupsResponse{}
-	mi := &file_group_proto_msgTypes[34]
+	mi := &file_group_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1835,7 +1939,7 @@ func (x *SearchGroupsResponse) String() string {
 func (*SearchGroupsResponse) ProtoMessage() {}
 
 func (x *SearchGroupsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[34]
+	mi := &file_group_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1848,7 +1952,7 @@ func (x *SearchGroupsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchGroupsResponse.ProtoReflect.Descriptor instead.
 func (*SearchGroupsResponse) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{34}
+	return file_group_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *SearchGroupsResponse) GetBase() *BaseResponse {
@@ -1875,7 +1979,7 @@ type InviteMembersRequest struct {
 
 func (x *InviteMembersRequest) Reset() {
 	*x = InviteMembersRequest{}
-	mi := &file_group_proto_msgTypes[35]
+	mi := &file_group_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1887,7 +1991,7 @@ func (x *InviteMembersRequest) String() string {
 func (*InviteMembersRequest) ProtoMessage() {}
 
 func (x *InviteMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[35]
+	mi := &file_group_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1900,7 +2004,7 @@ func (x *InviteMembersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InviteMembersRequest.ProtoReflect.Descriptor instead.
 func (*InviteMembersRequest) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{35}
+	return file_group_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *InviteMembersRequest) GetGroupId() int64 {
@@ -1926,7 +2030,7 @@ type InviteMembersResponse struct {
 
 func (x *InviteMembersResponse) Reset() {
 	*x = InviteMembersResponse{}
-	mi := &file_group_proto_msgTypes[36]
+	mi := &file_group_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1938,7 +2042,7 @@ func (x *InviteMembersResponse) String() string {
 func (*InviteMembersResponse) ProtoMessage() {}
 
 func (x *InviteMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_group_proto_msgTypes[36]
+	mi := &file_group_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1951,7 +2055,7 @@ func (x *InviteMembersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InviteMembersResponse.ProtoReflect.Descriptor instead.
 func (*InviteMembersResponse) Descriptor() ([]byte, []int) {
-	return file_group_proto_rawDescGZIP(), []int{36}
+	return file_group_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *InviteMembersResponse) GetBase() *BaseResponse {
@@ -1966,7 +2070,14 @@ var File_group_proto protoreflect.FileDescriptor
 const file_group_proto_rawDesc = "" +
 	"\n" +
 	"\vgroup.proto\x12\n" +
-	"gochat.rpc\x1a\fcommon.proto\"4\n" +
+	"gochat.rpc\x1a\fcommon.proto\"5\n" +
+	"\x18GetGroupMemberIdsRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\"\x8f\x01\n" +
+	"\x19GetGroupMemberIdsResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x02 \x03(\x03R\tmemberIds\x12%\n" +
+	"\x0emember_version\x18\x03 \x01(\x03R\rmemberVersion\"4\n" +
 	"\x15GetGroupsByIdsRequest\x12\x1b\n" +
 	"\tgroup_ids\x18\x01 \x03(\x03R\bgroupIds\"q\n" +
 	"\x16GetGroupsByIdsResponse\x12,\n" +
@@ -2085,7 +2196,7 @@ const file_group_proto_rawDesc = "" +
 	"\n" +
 	"member_ids\x18\x02 \x03(\x03R\tmemberIds\"E\n" +
 	"\x15InviteMembersResponse\x12,\n" +
-	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base2\xf1\v\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base2\xd3\f\n" +
 	"\fGroupService\x12N\n" +
 	"\vCreateGroup\x12\x1e.gochat.rpc.CreateGroupRequest\x1a\x1f.gochat.rpc.CreateGroupResponse\x12Q\n" +
 	"\fGetGroupList\x12\x1f.gochat.rpc.GetGroupListRequest\x1a .gochat.rpc.GetGroupListResponse\x12Q\n" +
@@ -2103,7 +2214,8 @@ const file_group_proto_rawDesc = "" +
 	"\x13UpdateGroupNickname\x12&.gochat.rpc.UpdateGroupNicknameRequest\x1a'.gochat.rpc.UpdateGroupNicknameResponse\x12]\n" +
 	"\x10GetGroupRequests\x12#.gochat.rpc.GetGroupRequestsRequest\x1a$.gochat.rpc.GetGroupRequestsResponse\x12c\n" +
 	"\x12HandleGroupRequest\x12%.gochat.rpc.HandleGroupRequestRequest\x1a&.gochat.rpc.HandleGroupRequestResponse\x12W\n" +
-	"\x0eGetGroupsByIds\x12!.gochat.rpc.GetGroupsByIdsRequest\x1a\".gochat.rpc.GetGroupsByIdsResponseB\x06Z\x04./pbb\x06proto3"
+	"\x0eGetGroupsByIds\x12!.gochat.rpc.GetGroupsByIdsRequest\x1a\".gochat.rpc.GetGroupsByIdsResponse\x12`\n" +
+	"\x11GetGroupMemberIds\x12$.gochat.rpc.GetGroupMemberIdsRequest\x1a%.gochat.rpc.GetGroupMemberIdsResponseB\x06Z\x04./pbb\x06proto3"
 
 var (
 	file_group_proto_rawDescOnce sync.Once
@@ -2117,111 +2229,116 @@ func file_group_proto_rawDescGZIP() []byte {
 	return file_group_proto_rawDescData
 }
 
-var file_group_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
+var file_group_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_group_proto_goTypes = []any{
-	(*GetGroupsByIdsRequest)(nil),       // 0: gochat.rpc.GetGroupsByIdsRequest
-	(*GetGroupsByIdsResponse)(nil),      // 1: gochat.rpc.GetGroupsByIdsResponse
-	(*GetGroupRequestsRequest)(nil),     // 2: gochat.rpc.GetGroupRequestsRequest
-	(*GetGroupRequestsResponse)(nil),    // 3: gochat.rpc.GetGroupRequestsResponse
-	(*HandleGroupRequestRequest)(nil),   // 4: gochat.rpc.HandleGroupRequestRequest
-	(*HandleGroupRequestResponse)(nil),  // 5: gochat.rpc.HandleGroupRequestResponse
-	(*GroupRequest)(nil),                // 6: gochat.rpc.GroupRequest
-	(*UpdateGroupNicknameRequest)(nil),  // 7: gochat.rpc.UpdateGroupNicknameRequest
-	(*UpdateGroupNicknameResponse)(nil), // 8: gochat.rpc.UpdateGroupNicknameResponse
-	(*CheckGroupMemberRequest)(nil),     // 9: gochat.rpc.CheckGroupMemberRequest
-	(*CheckGroupMemberResponse)(nil),    // 10: gochat.rpc.CheckGroupMemberResponse
-	(*Group)(nil),                       // 11: gochat.rpc.Group
-	(*GroupMember)(nil),                 // 12: gochat.rpc.GroupMember
-	(*CreateGroupRequest)(nil),          // 13: gochat.rpc.CreateGroupRequest
-	(*CreateGroupResponse)(nil),         // 14: gochat.rpc.CreateGroupResponse
-	(*GetGroupListRequest)(nil),         // 15: gochat.rpc.GetGroupListRequest
-	(*GetGroupListResponse)(nil),        // 16: gochat.rpc.GetGroupListResponse
-	(*GetGroupInfoRequest)(nil),         // 17: gochat.rpc.GetGroupInfoRequest
-	(*GetGroupInfoResponse)(nil),        // 18: gochat.rpc.GetGroupInfoResponse
-	(*GetGroupMembersRequest)(nil),      // 19: gochat.rpc.GetGroupMembersRequest
-	(*GetGroupMembersResponse)(nil),     // 20: gochat.rpc.GetGroupMembersResponse
-	(*JoinGroupRequest)(nil),            // 21: gochat.rpc.JoinGroupRequest
-	(*JoinGroupResponse)(nil),           // 22: gochat.rpc.JoinGroupResponse
-	(*QuitGroupRequest)(nil),            // 23: gochat.rpc.QuitGroupRequest
-	(*QuitGroupResponse)(nil),           // 24: gochat.rpc.QuitGroupResponse
-	(*KickGroupMemberRequest)(nil),      // 25: gochat.rpc.KickGroupMemberRequest
-	(*KickGroupMemberResponse)(nil),     // 26: gochat.rpc.KickGroupMemberResponse
-	(*DismissGroupRequest)(nil),         // 27: gochat.rpc.DismissGroupRequest
-	(*DismissGroupResponse)(nil),        // 28: gochat.rpc.DismissGroupResponse
-	(*UpdateAnnouncementRequest)(nil),   // 29: gochat.rpc.UpdateAnnouncementRequest
-	(*UpdateAnnouncementResponse)(nil),  // 30: gochat.rpc.UpdateAnnouncementResponse
-	(*GetAnnouncementRequest)(nil),      // 31: gochat.rpc.GetAnnouncementRequest
-	(*GetAnnouncementResponse)(nil),     // 32: gochat.rpc.GetAnnouncementResponse
-	(*SearchGroupsRequest)(nil),         // 33: gochat.rpc.SearchGroupsRequest
-	(*SearchGroupsResponse)(nil),        // 34: gochat.rpc.SearchGroupsResponse
-	(*InviteMembersRequest)(nil),        // 35: gochat.rpc.InviteMembersRequest
-	(*InviteMembersResponse)(nil),       // 36: gochat.rpc.InviteMembersResponse
-	(*BaseResponse)(nil),                // 37: gochat.rpc.BaseResponse
-	(*GroupSummary)(nil),                // 38: gochat.rpc.GroupSummary
+	(*GetGroupMemberIdsRequest)(nil),    // 0: gochat.rpc.GetGroupMemberIdsRequest
+	(*GetGroupMemberIdsResponse)(nil),   // 1: gochat.rpc.GetGroupMemberIdsResponse
+	(*GetGroupsByIdsRequest)(nil),       // 2: gochat.rpc.GetGroupsByIdsRequest
+	(*GetGroupsByIdsResponse)(nil),      // 3: gochat.rpc.GetGroupsByIdsResponse
+	(*GetGroupRequestsRequest)(nil),     // 4: gochat.rpc.GetGroupRequestsRequest
+	(*GetGroupRequestsResponse)(nil),    // 5: gochat.rpc.GetGroupRequestsResponse
+	(*HandleGroupRequestRequest)(nil),   // 6: gochat.rpc.HandleGroupRequestRequest
+	(*HandleGroupRequestResponse)(nil),  // 7: gochat.rpc.HandleGroupRequestResponse
+	(*GroupRequest)(nil),                // 8: gochat.rpc.GroupRequest
+	(*UpdateGroupNicknameRequest)(nil),  // 9: gochat.rpc.UpdateGroupNicknameRequest
+	(*UpdateGroupNicknameResponse)(nil), // 10: gochat.rpc.UpdateGroupNicknameResponse
+	(*CheckGroupMemberRequest)(nil),     // 11: gochat.rpc.CheckGroupMemberRequest
+	(*CheckGroupMemberResponse)(nil),    // 12: gochat.rpc.CheckGroupMemberResponse
+	(*Group)(nil),                       // 13: gochat.rpc.Group
+	(*GroupMember)(nil),                 // 14: gochat.rpc.GroupMember
+	(*CreateGroupRequest)(nil),          // 15: gochat.rpc.CreateGroupRequest
+	(*CreateGroupResponse)(nil),         // 16: gochat.rpc.CreateGroupResponse
+	(*GetGroupListRequest)(nil),         // 17: gochat.rpc.GetGroupListRequest
+	(*GetGroupListResponse)(nil),        // 18: gochat.rpc.GetGroupListResponse
+	(*GetGroupInfoRequest)(nil),         // 19: gochat.rpc.GetGroupInfoRequest
+	(*GetGroupInfoResponse)(nil),        // 20: gochat.rpc.GetGroupInfoResponse
+	(*GetGroupMembersRequest)(nil),      // 21: gochat.rpc.GetGroupMembersRequest
+	(*GetGroupMembersResponse)(nil),     // 22: gochat.rpc.GetGroupMembersResponse
+	(*JoinGroupRequest)(nil),            // 23: gochat.rpc.JoinGroupRequest
+	(*JoinGroupResponse)(nil),           // 24: gochat.rpc.JoinGroupResponse
+	(*QuitGroupRequest)(nil),            // 25: gochat.rpc.QuitGroupRequest
+	(*QuitGroupResponse)(nil),           // 26: gochat.rpc.QuitGroupResponse
+	(*KickGroupMemberRequest)(nil),      // 27: gochat.rpc.KickGroupMemberRequest
+	(*KickGroupMemberResponse)(nil),     // 28: gochat.rpc.KickGroupMemberResponse
+	(*DismissGroupRequest)(nil),         // 29: gochat.rpc.DismissGroupRequest
+	(*DismissGroupResponse)(nil),        // 30: gochat.rpc.DismissGroupResponse
+	(*UpdateAnnouncementRequest)(nil),   // 31: gochat.rpc.UpdateAnnouncementRequest
+	(*UpdateAnnouncementResponse)(nil),  // 32: gochat.rpc.UpdateAnnouncementResponse
+	(*GetAnnouncementRequest)(nil),      // 33: gochat.rpc.GetAnnouncementRequest
+	(*GetAnnouncementResponse)(nil),     // 34: gochat.rpc.GetAnnouncementResponse
+	(*SearchGroupsRequest)(nil),         // 35: gochat.rpc.SearchGroupsRequest
+	(*SearchGroupsResponse)(nil),        // 36: gochat.rpc.SearchGroupsResponse
+	(*InviteMembersRequest)(nil),        // 37: gochat.rpc.InviteMembersRequest
+	(*InviteMembersResponse)(nil),       // 38: gochat.rpc.InviteMembersResponse
+	(*BaseResponse)(nil),                // 39: gochat.rpc.BaseResponse
+	(*GroupSummary)(nil),                // 40: gochat.rpc.GroupSummary
 }
 var file_group_proto_depIdxs = []int32{
-	37, // 0: gochat.rpc.GetGroupsByIdsResponse.base:type_name -> gochat.rpc.BaseResponse
-	11, // 1: gochat.rpc.GetGroupsByIdsResponse.groups:type_name -> gochat.rpc.Group
-	37, // 2: gochat.rpc.GetGroupRequestsResponse.base:type_name -> gochat.rpc.BaseResponse
-	6,  // 3: gochat.rpc.GetGroupRequestsResponse.requests:type_name -> gochat.rpc.GroupRequest
-	37, // 4: gochat.rpc.HandleGroupRequestResponse.base:type_name -> gochat.rpc.BaseResponse
-	37, // 5: gochat.rpc.UpdateGroupNicknameResponse.base:type_name -> gochat.rpc.BaseResponse
-	37, // 6: gochat.rpc.CreateGroupResponse.base:type_name -> gochat.rpc.BaseResponse
-	11, // 7: gochat.rpc.CreateGroupResponse.group:type_name -> gochat.rpc.Group
-	37, // 8: gochat.rpc.GetGroupListResponse.base:type_name -> gochat.rpc.BaseResponse
-	38, // 9: gochat.rpc.GetGroupListResponse.groups:type_name -> gochat.rpc.GroupSummary
-	37, // 10: gochat.rpc.GetGroupInfoResponse.base:type_name -> gochat.rpc.BaseResponse
-	11, // 11: gochat.rpc.GetGroupInfoResponse.group:type_name -> gochat.rpc.Group
-	37, // 12: gochat.rpc.GetGroupMembersResponse.base:type_name -> gochat.rpc.BaseResponse
-	12, // 13: gochat.rpc.GetGroupMembersResponse.members:type_name -> gochat.rpc.GroupMember
-	37, // 14: gochat.rpc.JoinGroupResponse.base:type_name -> gochat.rpc.BaseResponse
-	37, // 15: gochat.rpc.QuitGroupResponse.base:type_name -> gochat.rpc.BaseResponse
-	37, // 16: gochat.rpc.KickGroupMemberResponse.base:type_name -> gochat.rpc.BaseResponse
-	37, // 17: gochat.rpc.DismissGroupResponse.base:type_name -> gochat.rpc.BaseResponse
-	37, // 18: gochat.rpc.UpdateAnnouncementResponse.base:type_name -> gochat.rpc.BaseResponse
-	37, // 19: gochat.rpc.GetAnnouncementResponse.base:type_name -> gochat.rpc.BaseResponse
-	37, // 20: gochat.rpc.SearchGroupsResponse.base:type_name -> gochat.rpc.BaseResponse
-	38, // 21: gochat.rpc.SearchGroupsResponse.groups:type_name -> gochat.rpc.GroupSummary
-	37, // 22: gochat.rpc.InviteMembersResponse.base:type_name -> gochat.rpc.BaseResponse
-	13, // 23: gochat.rpc.GroupService.CreateGroup:input_type -> gochat.rpc.CreateGroupRequest
-	15, // 24: gochat.rpc.GroupService.GetGroupList:input_type -> gochat.rpc.GetGroupListRequest
-	17, // 25: gochat.rpc.GroupService.GetGroupInfo:input_type -> gochat.rpc.GetGroupInfoRequest
-	19, // 26: gochat.rpc.GroupService.GetGroupMembers:input_type -> gochat.rpc.GetGroupMembersRequest
-	21, // 27: gochat.rpc.GroupService.JoinGroup:input_type -> gochat.rpc.JoinGroupRequest
-	23, // 28: gochat.rpc.GroupService.QuitGroup:input_type -> gochat.rpc.QuitGroupRequest
-	25, // 29: gochat.rpc.GroupService.KickGroupMember:input_type -> gochat.rpc.KickGroupMemberRequest
-	27, // 30: gochat.rpc.GroupService.DismissGroup:input_type -> gochat.rpc.DismissGroupRequest
-	29, // 31: gochat.rpc.GroupService.UpdateAnnouncement:input_type -> gochat.rpc.UpdateAnnouncementRequest
-	31, // 32: gochat.rpc.GroupService.GetAnnouncement:input_type -> gochat.rpc.GetAnnouncementRequest
-	33, // 33: gochat.rpc.GroupService.SearchGroups:input_type -> gochat.rpc.SearchGroupsRequest
-	35, // 34: gochat.rpc.GroupService.InviteMembers:input_type -> gochat.rpc.InviteMembersRequest
-	9,  // 35: gochat.rpc.GroupService.CheckGroupMember:input_type -> gochat.rpc.CheckGroupMemberRequest
-	7,  // 36: gochat.rpc.GroupService.UpdateGroupNickname:input_type -> gochat.rpc.UpdateGroupNicknameRequest
-	2,  // 37: gochat.rpc.GroupService.GetGroupRequests:input_type -> gochat.rpc.GetGroupRequestsRequest
-	4,  // 38: gochat.rpc.GroupService.HandleGroupRequest:input_type -> gochat.rpc.HandleGroupRequestRequest
-	0,  // 39: gochat.rpc.GroupService.GetGroupsByIds:input_type -> gochat.rpc.GetGroupsByIdsRequest
-	14, // 40: gochat.rpc.GroupService.CreateGroup:output_type -> gochat.rpc.CreateGroupResponse
-	16, // 41: gochat.rpc.GroupService.GetGroupList:output_type -> gochat.rpc.GetGroupListResponse
-	18, // 42: gochat.rpc.GroupService.GetGroupInfo:output_type -> gochat.rpc.GetGroupInfoResponse
-	20, // 43: gochat.rpc.GroupService.GetGroupMembers:output_type -> gochat.rpc.GetGroupMembersResponse
-	22, // 44: gochat.rpc.GroupService.JoinGroup:output_type -> gochat.rpc.JoinGroupResponse
-	24, // 45: gochat.rpc.GroupService.QuitGroup:output_type -> gochat.rpc.QuitGroupResponse
-	26, // 46: gochat.rpc.GroupService.KickGroupMember:output_type -> gochat.rpc.KickGroupMemberResponse
-	28, // 47: gochat.rpc.GroupService.DismissGroup:output_type -> gochat.rpc.DismissGroupResponse
-	30, // 48: gochat.rpc.GroupService.UpdateAnnouncement:output_type -> gochat.rpc.UpdateAnnouncementResponse
-	32, // 49: gochat.rpc.GroupService.GetAnnouncement:output_type -> gochat.rpc.GetAnnouncementResponse
-	34, // 50: gochat.rpc.GroupService.SearchGroups:output_type -> gochat.rpc.SearchGroupsResponse
-	36, // 51: gochat.rpc.GroupService.InviteMembers:output_type -> gochat.rpc.InviteMembersResponse
-	10, // 52: gochat.rpc.GroupService.CheckGroupMember:output_type -> gochat.rpc.CheckGroupMemberResponse
-	8,  // 53: gochat.rpc.GroupService.UpdateGroupNickname:output_type -> gochat.rpc.UpdateGroupNicknameResponse
-	3,  // 54: gochat.rpc.GroupService.GetGroupRequests:output_type -> gochat.rpc.GetGroupRequestsResponse
-	5,  // 55: gochat.rpc.GroupService.HandleGroupRequest:output_type -> gochat.rpc.HandleGroupRequestResponse
-	1,  // 56: gochat.rpc.GroupService.GetGroupsByIds:output_type -> gochat.rpc.GetGroupsByIdsResponse
-	40, // [40:57] is the sub-list for method output_type
-	23, // [23:40] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	39, // 0: gochat.rpc.GetGroupMemberIdsResponse.base:type_name -> gochat.rpc.BaseResponse
+	39, // 1: gochat.rpc.GetGroupsByIdsResponse.base:type_name -> gochat.rpc.BaseResponse
+	13, // 2: gochat.rpc.GetGroupsByIdsResponse.groups:type_name -> gochat.rpc.Group
+	39, // 3: gochat.rpc.GetGroupRequestsResponse.base:type_name -> gochat.rpc.BaseResponse
+	8,  // 4: gochat.rpc.GetGroupRequestsResponse.requests:type_name -> gochat.rpc.GroupRequest
+	39, // 5: gochat.rpc.HandleGroupRequestResponse.base:type_name -> gochat.rpc.BaseResponse
+	39, // 6: gochat.rpc.UpdateGroupNicknameResponse.base:type_name -> gochat.rpc.BaseResponse
+	39, // 7: gochat.rpc.CreateGroupResponse.base:type_name -> gochat.rpc.BaseResponse
+	13, // 8: gochat.rpc.CreateGroupResponse.group:type_name -> gochat.rpc.Group
+	39, // 9: gochat.rpc.GetGroupListResponse.base:type_name -> gochat.rpc.BaseResponse
+	40, // 10: gochat.rpc.GetGroupListResponse.groups:type_name -> gochat.rpc.GroupSummary
+	39, // 11: gochat.rpc.GetGroupInfoResponse.base:type_name -> gochat.rpc.BaseResponse
+	13, // 12: gochat.rpc.GetGroupInfoResponse.group:type_name -> gochat.rpc.Group
+	39, // 13: gochat.rpc.GetGroupMembersResponse.base:type_name -> gochat.rpc.BaseResponse
+	14, // 14: gochat.rpc.GetGroupMembersResponse.members:type_name -> gochat.rpc.GroupMember
+	39, // 15: gochat.rpc.JoinGroupResponse.base:type_name -> gochat.rpc.BaseResponse
+	39, // 16: gochat.rpc.QuitGroupResponse.base:type_name -> gochat.rpc.BaseResponse
+	39, // 17: gochat.rpc.KickGroupMemberResponse.base:type_name -> gochat.rpc.BaseResponse
+	39, // 18: gochat.rpc.DismissGroupResponse.base:type_name -> gochat.rpc.BaseResponse
+	39, // 19: gochat.rpc.UpdateAnnouncementResponse.base:type_name -> gochat.rpc.BaseResponse
+	39, // 20: gochat.rpc.GetAnnouncementResponse.base:type_name -> gochat.rpc.BaseResponse
+	39, // 21: gochat.rpc.SearchGroupsResponse.base:type_name -> gochat.rpc.BaseResponse
+	40, // 22: gochat.rpc.SearchGroupsResponse.groups:type_name -> gochat.rpc.GroupSummary
+	39, // 23: gochat.rpc.InviteMembersResponse.base:type_name -> gochat.rpc.BaseResponse
+	15, // 24: gochat.rpc.GroupService.CreateGroup:input_type -> gochat.rpc.CreateGroupRequest
+	17, // 25: gochat.rpc.GroupService.GetGroupList:input_type -> gochat.rpc.GetGroupListRequest
+	19, // 26: gochat.rpc.GroupService.GetGroupInfo:input_type -> gochat.rpc.GetGroupInfoRequest
+	21, // 27: gochat.rpc.GroupService.GetGroupMembers:input_type -> gochat.rpc.GetGroupMembersRequest
+	23, // 28: gochat.rpc.GroupService.JoinGroup:input_type -> gochat.rpc.JoinGroupRequest
+	25, // 29: gochat.rpc.GroupService.QuitGroup:input_type -> gochat.rpc.QuitGroupRequest
+	27, // 30: gochat.rpc.GroupService.KickGroupMember:input_type -> gochat.rpc.KickGroupMemberRequest
+	29, // 31: gochat.rpc.GroupService.DismissGroup:input_type -> gochat.rpc.DismissGroupRequest
+	31, // 32: gochat.rpc.GroupService.UpdateAnnouncement:input_type -> gochat.rpc.UpdateAnnouncementRequest
+	33, // 33: gochat.rpc.GroupService.GetAnnouncement:input_type -> gochat.rpc.GetAnnouncementRequest
+	35, // 34: gochat.rpc.GroupService.SearchGroups:input_type -> gochat.rpc.SearchGroupsRequest
+	37, // 35: gochat.rpc.GroupService.InviteMembers:input_type -> gochat.rpc.InviteMembersRequest
+	11, // 36: gochat.rpc.GroupService.CheckGroupMember:input_type -> gochat.rpc.CheckGroupMemberRequest
+	9,  // 37: gochat.rpc.GroupService.UpdateGroupNickname:input_type -> gochat.rpc.UpdateGroupNicknameRequest
+	4,  // 38: gochat.rpc.GroupService.GetGroupRequests:input_type -> gochat.rpc.GetGroupRequestsRequest
+	6,  // 39: gochat.rpc.GroupService.HandleGroupRequest:input_type -> gochat.rpc.HandleGroupRequestRequest
+	2,  // 40: gochat.rpc.GroupService.GetGroupsByIds:input_type -> gochat.rpc.GetGroupsByIdsRequest
+	0,  // 41: gochat.rpc.GroupService.GetGroupMemberIds:input_type -> gochat.rpc.GetGroupMemberIdsRequest
+	16, // 42: gochat.rpc.GroupService.CreateGroup:output_type -> gochat.rpc.CreateGroupResponse
+	18, // 43: gochat.rpc.GroupService.GetGroupList:output_type -> gochat.rpc.GetGroupListResponse
+	20, // 44: gochat.rpc.GroupService.GetGroupInfo:output_type -> gochat.rpc.GetGroupInfoResponse
+	22, // 45: gochat.rpc.GroupService.GetGroupMembers:output_type -> gochat.rpc.GetGroupMembersResponse
+	24, // 46: gochat.rpc.GroupService.JoinGroup:output_type -> gochat.rpc.JoinGroupResponse
+	26, // 47: gochat.rpc.GroupService.QuitGroup:output_type -> gochat.rpc.QuitGroupResponse
+	28, // 48: gochat.rpc.GroupService.KickGroupMember:output_type -> gochat.rpc.KickGroupMemberResponse
+	30, // 49: gochat.rpc.GroupService.DismissGroup:output_type -> gochat.rpc.DismissGroupResponse
+	32, // 50: gochat.rpc.GroupService.UpdateAnnouncement:output_type -> gochat.rpc.UpdateAnnouncementResponse
+	34, // 51: gochat.rpc.GroupService.GetAnnouncement:output_type -> gochat.rpc.GetAnnouncementResponse
+	36, // 52: gochat.rpc.GroupService.SearchGroups:output_type -> gochat.rpc.SearchGroupsResponse
+	38, // 53: gochat.rpc.GroupService.InviteMembers:output_type -> gochat.rpc.InviteMembersResponse
+	12, // 54: gochat.rpc.GroupService.CheckGroupMember:output_type -> gochat.rpc.CheckGroupMemberResponse
+	10, // 55: gochat.rpc.GroupService.UpdateGroupNickname:output_type -> gochat.rpc.UpdateGroupNicknameResponse
+	5,  // 56: gochat.rpc.GroupService.GetGroupRequests:output_type -> gochat.rpc.GetGroupRequestsResponse
+	7,  // 57: gochat.rpc.GroupService.HandleGroupRequest:output_type -> gochat.rpc.HandleGroupRequestResponse
+	3,  // 58: gochat.rpc.GroupService.GetGroupsByIds:output_type -> gochat.rpc.GetGroupsByIdsResponse
+	1,  // 59: gochat.rpc.GroupService.GetGroupMemberIds:output_type -> gochat.rpc.GetGroupMemberIdsResponse
+	42, // [42:60] is the sub-list for method output_type
+	24, // [24:42] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_group_proto_init() }
@@ -2236,7 +2353,7 @@ func file_group_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_group_proto_rawDesc), len(file_group_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   37,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -36,6 +36,7 @@ const (
 	GroupService_GetGroupRequests_FullMethodName    = "/gochat.rpc.GroupService/GetGroupRequests"
 	GroupService_HandleGroupRequest_FullMethodName  = "/gochat.rpc.GroupService/HandleGroupRequest"
 	GroupService_GetGroupsByIds_FullMethodName      = "/gochat.rpc.GroupService/GetGroupsByIds"
+	GroupService_GetGroupMemberIds_FullMethodName   = "/gochat.rpc.GroupService/GetGroupMemberIds"
 )
 
 // GroupServiceClient is the client API for GroupService service.
@@ -59,6 +60,8 @@ type GroupServiceClient interface {
 	GetGroupRequests(ctx context.Context, in *GetGroupRequestsRequest, opts ...grpc.CallOption) (*GetGroupRequestsResponse, error)
 	HandleGroupRequest(ctx context.Context, in *HandleGroupRequestRequest, opts ...grpc.CallOption) (*HandleGroupRequestResponse, error)
 	GetGroupsByIds(ctx context.Context, in *GetGroupsByIdsRequest, opts ...grpc.CallOption) (*GetGroupsByIdsResponse, error)
+	// Internal: compact member list for push fan-out, no membership check
+	GetGroupMemberIds(ctx context.Context, in *GetGroupMemberIdsRequest, opts ...grpc.CallOption) (*GetGroupMemberIdsResponse, error)
 }
 
 type groupServiceClient struct {
@@ -239,6 +242,16 @@ func (c *groupServiceClient) GetGroupsByIds(ctx context.Context, in *GetGroupsBy
 	return out, nil
 }
 
+func (c *groupServiceClient) GetGroupMemberIds(ctx context.Context, in *GetGroupMemberIdsRequest, opts ...grpc.CallOption) (*GetGroupMemberIdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupMemberIdsResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetGroupMemberIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GroupServiceServer is the server API for GroupService service.
 // All implementations must embed UnimplementedGroupServiceServer
 // for forward compatibility.
@@ -260,6 +273,8 @@ type GroupServiceServer interface {
 	GetGroupRequests(context.Context, *GetGroupRequestsRequest) (*GetGroupRequestsResponse, error)
 	HandleGroupRequest(context.Context, *HandleGroupRequestRequest) (*HandleGroupRequestResponse, error)
 	GetGroupsByIds(context.Context, *GetGroupsByIdsRequest) (*GetGroupsByIdsResponse, error)
+	// Internal: compact member list for push fan-out, no membership check
+	GetGroupMemberIds(context.Context, *GetGroupMemberIdsRequest) (*GetGroupMemberIdsResponse, error)
 	mustEmbedUnimplementedGroupServiceServer()
 }
 
@@ -321,6 +336,9 @@ func (UnimplementedGroupServiceServer) HandleGroupRequest(context.Context, *Hand
 func (UnimplementedGroupServiceServer) GetGroupsByIds(context.Context, *GetGroupsByIdsRequest) (*GetGroupsByIdsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGroupsByIds not implemented")
 }
+func (UnimplementedGroupServiceServer) GetGroupMemberIds(context.Context, *GetGroupMemberIdsRequest) (*GetGroupMemberIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupMemberIds not implemented")
+}
 func (UnimplementedGroupServiceServer) mustEmbedUnimplementedGroupServiceServer() {}
 func (UnimplementedGroupServiceServer) testEmbeddedByValue()                      {}
 
@@ -648,6 +666,24 @@ func _GroupService_GetGroupsByIds_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GroupService_GetGroupMemberIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupMemberIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetGroupMemberIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetGroupMemberIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetGroupMemberIds(ctx, req.(*GetGroupMemberIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GroupService_ServiceDesc is the grpc.ServiceDesc for GroupService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -723,6 +759,10 @@ var GroupService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetGroupsByIds",
 			Handler:    _GroupService_GetGroupsByIds_Handler,
 		},
+		{
+			MethodName: "GetGroupMemberIds",
+			Handler:    _GroupService_GetGroupMemberIds_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "group.proto",
@@ -124,6 +124,7 @@ type ChatMessage struct {
 	SenderInfoVersion int64                  `protobuf:"varint,11,opt,name=sender_info_version,json=senderInfoVersion,proto3" json:"sender_info_version,omitempty"`
 	GroupMetaVersion  int64                  `protobuf:"varint,12,opt,name=group_meta_version,json=groupMetaVersion,proto3" json:"group_meta_version,omitempty"`
 	RelationVersion   int64                  `protobuf:"varint,13,opt,name=relation_version,json=relationVersion,proto3" json:"relation_version,omitempty"`
+	UnreadCount       int64                  `protobuf:"varint,14,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"` // receiver's unread count of the conversation, only set on pushes
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -249,6 +250,13 @@ func (x *ChatMessage) GetRelationVersion() int64 {
 	return 0
 }
 
+func (x *ChatMessage) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
 type ConversationInfo struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId  string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
@@ -1121,97 +1129,1157 @@ func (x *DeleteConversationResponse) GetBase() *BaseResponse {
 	return nil
 }
 
-var File_message_proto protoreflect.FileDescriptor
+type Notification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Message       *ChatMessageEvent      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // the push that did not get through
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`   // why realtime delivery gave up
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_message_proto_rawDesc = "" +
-	"\n" +
-	"\rmessage.proto\x12\n" +
-	"gochat.rpc\x1a\fcommon.proto\"E\n" +
-	"\x1aRestoreConversationRequest\x12'\n" +
-	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\"K\n" +
-	"\x1bRestoreConversationResponse\x12,\n" +
-	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\"\xb6\x03\n" +
-	"\vChatMessage\x12\x15\n" +
-	"\x06msg_id\x18\x01 \x01(\tR\x05msgId\x12'\n" +
-	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x1b\n" +
-	"\tsender_id\x18\x03 \x01(\x03R\bsenderId\x12\x1f\n" +
-	"\vreceiver_id\x18\x04 \x01(\x03R\n" +
-	"receiverId\x12\x19\n" +
-	"\bgroup_id\x18\x05 \x01(\x03R\agroupId\x12\x19\n" +
-	"\bmsg_type\x18\x06 \x01(\x05R\amsgType\x12\x18\n" +
-	"\acontent\x18\a \x01(\tR\acontent\x12\x16\n" +
-	"\x06status\x18\b \x01(\x05R\x06status\x12\x1c\n" +
-	"\ttimestamp\x18\t \x01(\x03R\ttimestamp\x12\x1a\n" +
-	"\bsequence\x18\n" +
-	" \x01(\x03R\bsequence\x12.\n" +
-	"\x13sender_info_version\x18\v \x01(\x03R\x11senderInfoVersion\x12,\n" +
-	"\x12group_meta_version\x18\f \x01(\x03R\x10groupMetaVersion\x12)\n" +
-	"\x10relation_version\x18\r \x01(\x03R\x0frelationVersion\"\xc2\x03\n" +
-	"\x10ConversationInfo\x12'\n" +
-	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
-	"\apeer_id\x18\x02 \x01(\x03R\x06peerId\x12!\n" +
-	"\funread_count\x18\x03 \x01(\x05R\vunreadCount\x12\x1e\n" +
-	"\vlast_msg_id\x18\x04 \x01(\tR\tlastMsgId\x12!\n" +
-	"\flast_message\x18\x05 \x01(\tR\vlastMessage\x12\"\n" +
-	"\rlast_msg_type\x18\x06 \x01(\x05R\vlastMsgType\x12$\n" +
-	"\x0elast_sender_id\x18\a \x01(\x03R\flastSenderId\x12*\n" +
-	"\x11last_message_time\x18\b \x01(\x03R\x0flastMessageTime\x12\x15\n" +
-	"\x06is_top\x18\t \x01(\x05R\x05isTop\x12\x19\n" +
-	"\bis_muted\x18\n" +
-	" \x01(\x05R\aisMuted\x12\x18\n" +
-	"\aversion\x18\v \x01(\x03R\aversion\x12#\n" +
-	"\rpeer_nickname\x18\f \x01(\tR\fpeerNickname\x12\x1f\n" +
-	"\vpeer_avatar\x18\r \x01(\tR\n" +
-	"peerAvatar\"x\n" +
-	"\x12GetMessagesRequest\x12'\n" +
-	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12#\n" +
-	"\rlast_sequence\x18\x03 \x01(\x05R\flastSequence\"x\n" +
-	"\x13GetMessagesResponse\x12,\n" +
-	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x123\n" +
-	"\bmessages\x18\x02 \x03(\v2\x17.gochat.rpc.ChatMessageR\bmessages\"I\n" +
-	"\x17GetConversationsRequest\x12\x14\n" +
-	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x18\n" +
-	"\akeyword\x18\x02 \x01(\tR\akeyword\"\x8c\x01\n" +
-	"\x18GetConversationsResponse\x12,\n" +
-	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x12B\n" +
-	"\rconversations\x18\x02 \x03(\v2\x1c.gochat.rpc.ConversationInfoR\rconversations\"=\n" +
-	"\x12ClearUnreadRequest\x12'\n" +
-	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\"C\n" +
-	"\x13ClearUnreadResponse\x12,\n" +
-	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\".\n" +
-	"\x15GetMessageByIDRequest\x12\x15\n" +
-	"\x06msg_id\x18\x01 \x01(\tR\x05msgId\"y\n" +
-	"\x16GetMessageByIDResponse\x12,\n" +
-	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x121\n" +
-	"\amessage\x18\x02 \x01(\v2\x17.gochat.rpc.ChatMessageR\amessage\"\xdd\x03\n" +
-	"\x10ChatMessageEvent\x12\x15\n" +
-	"\x06msg_id\x18\x01 \x01(\tR\x05msgId\x12'\n" +
-	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x1b\n" +
-	"\tsender_id\x18\x03 \x01(\x03R\bsenderId\x12\x1f\n" +
-	"\vreceiver_id\x18\x04 \x01(\x03R\n" +
-	"receiverId\x12\x19\n" +
-	"\bgroup_id\x18\x05 \x01(\x03R\agroupId\x12\x19\n" +
-	"\bmsg_type\x18\x06 \x01(\x05R\amsgType\x12\x18\n" +
-	"\acontent\x18\a \x01(\tR\acontent\x12\x1c\n" +
-	"\ttimestamp\x18\b \x01(\x03R\ttimestamp\x12\x19\n" +
-	"\btrace_id\x18\t \x01(\tR\atraceId\x12\x1d\n" +
-	"\n" +
-	"target_ids\x18\n" +
-	" \x03(\x03R\ttargetIds\x12.\n" +
-	"\x13sender_info_version\x18\v \x01(\x03R\x11senderInfoVersion\x12,\n" +
-	"\x12group_meta_version\x18\f \x01(\x03R\x10groupMetaVersion\x12)\n" +
-	"\x10relation_version\x18\r \x01(\x03R\x0frelationVersion\x12\x1a\n" +
-	"\bsequence\x18\x0e \x01(\x03R\bsequence\"L\n" +
-	"\x12SaveMessageRequest\x126\n" +
-	"\amessage\x18\x01 \x01(\v2\x1c.gochat.rpc.ChatMessageEventR\amessage\"_\n" +
-	"\x13SaveMessageResponse\x12,\n" +
-	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x12\x1a\n" +
-	"\bsequence\x18\x02 \x01(\x03R\bsequence\"D\n" +
-	"\x19DeleteConversationRequest\x12'\n" +
-	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\"J\n" +
-	"\x1aDeleteConversationResponse\x12,\n" +
-	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base2\x85\x05\n" +
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_message_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Notification) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Notification) GetMessage() *ChatMessageEvent {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *Notification) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Notification) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterId       int64                  `protobuf:"varint,1,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"` // pages through the pending notifications, oldest first
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_message_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListNotificationsRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Notifications []*Notification        `protobuf:"bytes,2,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
+	mi := &file_message_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsResponse) ProtoMessage() {}
+
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListNotificationsResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListNotificationsResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListNotificationsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type AckNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AckNotificationsRequest) Reset() {
+	*x = AckNotificationsRequest{}
+	mi := &file_message_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AckNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckNotificationsRequest) ProtoMessage() {}
+
+func (x *AckNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*AckNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AckNotificationsRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type AckNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Acked         int32                  `protobuf:"varint,2,opt,name=acked,proto3" json:"acked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AckNotificationsResponse) Reset() {
+	*x = AckNotificationsResponse{}
+	mi := &file_message_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AckNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckNotificationsResponse) ProtoMessage() {}
+
+func (x *AckNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*AckNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AckNotificationsResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *AckNotificationsResponse) GetAcked() int32 {
+	if x != nil {
+		return x.Acked
+	}
+	return 0
+}
+
+type QuarantinedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Topic         string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"` // original topic
+	Partition     int32                  `protobuf:"varint,3,opt,name=partition,proto3" json:"partition,omitempty"`
+	Offset        int64                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Key           []byte                 `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
+	Value         []byte                 `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
+	Headers       map[string]string      `protobuf:"bytes,7,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	Attempts      int32                  `protobuf:"varint,9,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	FailedAt      int64                  `protobuf:"varint,10,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuarantinedMessage) Reset() {
+	*x = QuarantinedMessage{}
+	mi := &file_message_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuarantinedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuarantinedMessage) ProtoMessage() {}
+
+func (x *QuarantinedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuarantinedMessage.ProtoReflect.Descriptor instead.
+func (*QuarantinedMessage) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *QuarantinedMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QuarantinedMessage) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *QuarantinedMessage) GetPartition() int32 {
+	if x != nil {
+		return x.Partition
+	}
+	return 0
+}
+
+func (x *QuarantinedMessage) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *QuarantinedMessage) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *QuarantinedMessage) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *QuarantinedMessage) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *QuarantinedMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *QuarantinedMessage) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *QuarantinedMessage) GetFailedAt() int64 {
+	if x != nil {
+		return x.FailedAt
+	}
+	return 0
+}
+
+type ListQuarantinedMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offset        int64                  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuarantinedMessagesRequest) Reset() {
+	*x = ListQuarantinedMessagesRequest{}
+	mi := &file_message_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuarantinedMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuarantinedMessagesRequest) ProtoMessage() {}
+
+func (x *ListQuarantinedMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuarantinedMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListQuarantinedMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListQuarantinedMessagesRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListQuarantinedMessagesRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListQuarantinedMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Messages      []*QuarantinedMessage  `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuarantinedMessagesResponse) Reset() {
+	*x = ListQuarantinedMessagesResponse{}
+	mi := &file_message_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuarantinedMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuarantinedMessagesResponse) ProtoMessage() {}
+
+func (x *ListQuarantinedMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuarantinedMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListQuarantinedMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListQuarantinedMessagesResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListQuarantinedMessagesResponse) GetMessages() []*QuarantinedMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ListQuarantinedMessagesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ReplayQuarantinedMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Discard       bool                   `protobuf:"varint,2,opt,name=discard,proto3" json:"discard,omitempty"` // drop the records instead of re-publishing them
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayQuarantinedMessagesRequest) Reset() {
+	*x = ReplayQuarantinedMessagesRequest{}
+	mi := &file_message_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayQuarantinedMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayQuarantinedMessagesRequest) ProtoMessage() {}
+
+func (x *ReplayQuarantinedMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayQuarantinedMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ReplayQuarantinedMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReplayQuarantinedMessagesRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ReplayQuarantinedMessagesRequest) GetDiscard() bool {
+	if x != nil {
+		return x.Discard
+	}
+	return false
+}
+
+type ReplayQuarantinedMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ReplayedIds   []string               `protobuf:"bytes,2,rep,name=replayed_ids,json=replayedIds,proto3" json:"replayed_ids,omitempty"`
+	FailedIds     []string               `protobuf:"bytes,3,rep,name=failed_ids,json=failedIds,proto3" json:"failed_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayQuarantinedMessagesResponse) Reset() {
+	*x = ReplayQuarantinedMessagesResponse{}
+	mi := &file_message_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayQuarantinedMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayQuarantinedMessagesResponse) ProtoMessage() {}
+
+func (x *ReplayQuarantinedMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayQuarantinedMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ReplayQuarantinedMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReplayQuarantinedMessagesResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ReplayQuarantinedMessagesResponse) GetReplayedIds() []string {
+	if x != nil {
+		return x.ReplayedIds
+	}
+	return nil
+}
+
+func (x *ReplayQuarantinedMessagesResponse) GetFailedIds() []string {
+	if x != nil {
+		return x.FailedIds
+	}
+	return nil
+}
+
+type ModerationHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        string                 `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // block, mask or flag
+	Matches       []string               `protobuf:"bytes,3,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerationHit) Reset() {
+	*x = ModerationHit{}
+	mi := &file_message_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationHit) ProtoMessage() {}
+
+func (x *ModerationHit) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationHit.ProtoReflect.Descriptor instead.
+func (*ModerationHit) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ModerationHit) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ModerationHit) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ModerationHit) GetMatches() []string {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type FlaggedMessage struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MsgId            string                 `protobuf:"bytes,1,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	ConversationId   string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	SenderId         int64                  `protobuf:"varint,3,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	GroupId          int64                  `protobuf:"varint,4,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Content          string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`                                           // as sent
+	DeliveredContent string                 `protobuf:"bytes,6,opt,name=delivered_content,json=deliveredContent,proto3" json:"delivered_content,omitempty"` // after masking
+	Hits             []*ModerationHit       `protobuf:"bytes,7,rep,name=hits,proto3" json:"hits,omitempty"`
+	FlaggedAt        int64                  `protobuf:"varint,8,opt,name=flagged_at,json=flaggedAt,proto3" json:"flagged_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FlaggedMessage) Reset() {
+	*x = FlaggedMessage{}
+	mi := &file_message_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlaggedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlaggedMessage) ProtoMessage() {}
+
+func (x *FlaggedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlaggedMessage.ProtoReflect.Descriptor instead.
+func (*FlaggedMessage) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FlaggedMessage) GetMsgId() string {
+	if x != nil {
+		return x.MsgId
+	}
+	return ""
+}
+
+func (x *FlaggedMessage) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *FlaggedMessage) GetSenderId() int64 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *FlaggedMessage) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *FlaggedMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *FlaggedMessage) GetDeliveredContent() string {
+	if x != nil {
+		return x.DeliveredContent
+	}
+	return ""
+}
+
+func (x *FlaggedMessage) GetHits() []*ModerationHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *FlaggedMessage) GetFlaggedAt() int64 {
+	if x != nil {
+		return x.FlaggedAt
+	}
+	return 0
+}
+
+type ListFlaggedMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offset        int64                  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFlaggedMessagesRequest) Reset() {
+	*x = ListFlaggedMessagesRequest{}
+	mi := &file_message_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFlaggedMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlaggedMessagesRequest) ProtoMessage() {}
+
+func (x *ListFlaggedMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlaggedMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListFlaggedMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListFlaggedMessagesRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListFlaggedMessagesRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListFlaggedMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Messages      []*FlaggedMessage      `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFlaggedMessagesResponse) Reset() {
+	*x = ListFlaggedMessagesResponse{}
+	mi := &file_message_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFlaggedMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlaggedMessagesResponse) ProtoMessage() {}
+
+func (x *ListFlaggedMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlaggedMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListFlaggedMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListFlaggedMessagesResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListFlaggedMessagesResponse) GetMessages() []*FlaggedMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ListFlaggedMessagesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ResolveFlaggedMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MsgIds        []string               `protobuf:"bytes,1,rep,name=msg_ids,json=msgIds,proto3" json:"msg_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveFlaggedMessagesRequest) Reset() {
+	*x = ResolveFlaggedMessagesRequest{}
+	mi := &file_message_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveFlaggedMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveFlaggedMessagesRequest) ProtoMessage() {}
+
+func (x *ResolveFlaggedMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveFlaggedMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ResolveFlaggedMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ResolveFlaggedMessagesRequest) GetMsgIds() []string {
+	if x != nil {
+		return x.MsgIds
+	}
+	return nil
+}
+
+type ResolveFlaggedMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Resolved      int32                  `protobuf:"varint,2,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveFlaggedMessagesResponse) Reset() {
+	*x = ResolveFlaggedMessagesResponse{}
+	mi := &file_message_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveFlaggedMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveFlaggedMessagesResponse) ProtoMessage() {}
+
+func (x *ResolveFlaggedMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveFlaggedMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ResolveFlaggedMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ResolveFlaggedMessagesResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ResolveFlaggedMessagesResponse) GetResolved() int32 {
+	if x != nil {
+		return x.Resolved
+	}
+	return 0
+}
+
+var File_message_proto protoreflect.FileDescriptor
+
+const file_message_proto_rawDesc = "" +
+	"\n" +
+	"\rmessage.proto\x12\n" +
+	"gochat.rpc\x1a\fcommon.proto\"E\n" +
+	"\x1aRestoreConversationRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\"K\n" +
+	"\x1bRestoreConversationResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\"\xd9\x03\n" +
+	"\vChatMessage\x12\x15\n" +
+	"\x06msg_id\x18\x01 \x01(\tR\x05msgId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x1b\n" +
+	"\tsender_id\x18\x03 \x01(\x03R\bsenderId\x12\x1f\n" +
+	"\vreceiver_id\x18\x04 \x01(\x03R\n" +
+	"receiverId\x12\x19\n" +
+	"\bgroup_id\x18\x05 \x01(\x03R\agroupId\x12\x19\n" +
+	"\bmsg_type\x18\x06 \x01(\x05R\amsgType\x12\x18\n" +
+	"\acontent\x18\a \x01(\tR\acontent\x12\x16\n" +
+	"\x06status\x18\b \x01(\x05R\x06status\x12\x1c\n" +
+	"\ttimestamp\x18\t \x01(\x03R\ttimestamp\x12\x1a\n" +
+	"\bsequence\x18\n" +
+	" \x01(\x03R\bsequence\x12.\n" +
+	"\x13sender_info_version\x18\v \x01(\x03R\x11senderInfoVersion\x12,\n" +
+	"\x12group_meta_version\x18\f \x01(\x03R\x10groupMetaVersion\x12)\n" +
+	"\x10relation_version\x18\r \x01(\x03R\x0frelationVersion\x12!\n" +
+	"\funread_count\x18\x0e \x01(\x03R\vunreadCount\"\xc2\x03\n" +
+	"\x10ConversationInfo\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
+	"\apeer_id\x18\x02 \x01(\x03R\x06peerId\x12!\n" +
+	"\funread_count\x18\x03 \x01(\x05R\vunreadCount\x12\x1e\n" +
+	"\vlast_msg_id\x18\x04 \x01(\tR\tlastMsgId\x12!\n" +
+	"\flast_message\x18\x05 \x01(\tR\vlastMessage\x12\"\n" +
+	"\rlast_msg_type\x18\x06 \x01(\x05R\vlastMsgType\x12$\n" +
+	"\x0elast_sender_id\x18\a \x01(\x03R\flastSenderId\x12*\n" +
+	"\x11last_message_time\x18\b \x01(\x03R\x0flastMessageTime\x12\x15\n" +
+	"\x06is_top\x18\t \x01(\x05R\x05isTop\x12\x19\n" +
+	"\bis_muted\x18\n" +
+	" \x01(\x05R\aisMuted\x12\x18\n" +
+	"\aversion\x18\v \x01(\x03R\aversion\x12#\n" +
+	"\rpeer_nickname\x18\f \x01(\tR\fpeerNickname\x12\x1f\n" +
+	"\vpeer_avatar\x18\r \x01(\tR\n" +
+	"peerAvatar\"x\n" +
+	"\x12GetMessagesRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12#\n" +
+	"\rlast_sequence\x18\x03 \x01(\x05R\flastSequence\"x\n" +
+	"\x13GetMessagesResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x123\n" +
+	"\bmessages\x18\x02 \x03(\v2\x17.gochat.rpc.ChatMessageR\bmessages\"I\n" +
+	"\x17GetConversationsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x18\n" +
+	"\akeyword\x18\x02 \x01(\tR\akeyword\"\x8c\x01\n" +
+	"\x18GetConversationsResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x12B\n" +
+	"\rconversations\x18\x02 \x03(\v2\x1c.gochat.rpc.ConversationInfoR\rconversations\"=\n" +
+	"\x12ClearUnreadRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\"C\n" +
+	"\x13ClearUnreadResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\".\n" +
+	"\x15GetMessageByIDRequest\x12\x15\n" +
+	"\x06msg_id\x18\x01 \x01(\tR\x05msgId\"y\n" +
+	"\x16GetMessageByIDResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x121\n" +
+	"\amessage\x18\x02 \x01(\v2\x17.gochat.rpc.ChatMessageR\amessage\"\xdd\x03\n" +
+	"\x10ChatMessageEvent\x12\x15\n" +
+	"\x06msg_id\x18\x01 \x01(\tR\x05msgId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x1b\n" +
+	"\tsender_id\x18\x03 \x01(\x03R\bsenderId\x12\x1f\n" +
+	"\vreceiver_id\x18\x04 \x01(\x03R\n" +
+	"receiverId\x12\x19\n" +
+	"\bgroup_id\x18\x05 \x01(\x03R\agroupId\x12\x19\n" +
+	"\bmsg_type\x18\x06 \x01(\x05R\amsgType\x12\x18\n" +
+	"\acontent\x18\a \x01(\tR\acontent\x12\x1c\n" +
+	"\ttimestamp\x18\b \x01(\x03R\ttimestamp\x12\x19\n" +
+	"\btrace_id\x18\t \x01(\tR\atraceId\x12\x1d\n" +
+	"\n" +
+	"target_ids\x18\n" +
+	" \x03(\x03R\ttargetIds\x12.\n" +
+	"\x13sender_info_version\x18\v \x01(\x03R\x11senderInfoVersion\x12,\n" +
+	"\x12group_meta_version\x18\f \x01(\x03R\x10groupMetaVersion\x12)\n" +
+	"\x10relation_version\x18\r \x01(\x03R\x0frelationVersion\x12\x1a\n" +
+	"\bsequence\x18\x0e \x01(\x03R\bsequence\"L\n" +
+	"\x12SaveMessageRequest\x126\n" +
+	"\amessage\x18\x01 \x01(\v2\x1c.gochat.rpc.ChatMessageEventR\amessage\"_\n" +
+	"\x13SaveMessageResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\"D\n" +
+	"\x19DeleteConversationRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\"J\n" +
+	"\x1aDeleteConversationResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\"\x8d\x01\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x126\n" +
+	"\amessage\x18\x02 \x01(\v2\x1c.gochat.rpc.ChatMessageEventR\amessage\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\"K\n" +
+	"\x18ListNotificationsRequest\x12\x19\n" +
+	"\bafter_id\x18\x01 \x01(\x03R\aafterId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xa4\x01\n" +
+	"\x19ListNotificationsResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x12>\n" +
+	"\rnotifications\x18\x02 \x03(\v2\x18.gochat.rpc.NotificationR\rnotifications\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"+\n" +
+	"\x17AckNotificationsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\"^\n" +
+	"\x18AckNotificationsResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x12\x14\n" +
+	"\x05acked\x18\x02 \x01(\x05R\x05acked\"\xea\x02\n" +
+	"\x12QuarantinedMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05topic\x18\x02 \x01(\tR\x05topic\x12\x1c\n" +
+	"\tpartition\x18\x03 \x01(\x05R\tpartition\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x03R\x06offset\x12\x10\n" +
+	"\x03key\x18\x05 \x01(\fR\x03key\x12\x14\n" +
+	"\x05value\x18\x06 \x01(\fR\x05value\x12E\n" +
+	"\aheaders\x18\a \x03(\v2+.gochat.rpc.QuarantinedMessage.HeadersEntryR\aheaders\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\x12\x1a\n" +
+	"\battempts\x18\t \x01(\x05R\battempts\x12\x1b\n" +
+	"\tfailed_at\x18\n" +
+	" \x01(\x03R\bfailedAt\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"N\n" +
+	"\x1eListQuarantinedMessagesRequest\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x03R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\"\xa1\x01\n" +
+	"\x1fListQuarantinedMessagesResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x12:\n" +
+	"\bmessages\x18\x02 \x03(\v2\x1e.gochat.rpc.QuarantinedMessageR\bmessages\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\"N\n" +
+	" ReplayQuarantinedMessagesRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12\x18\n" +
+	"\adiscard\x18\x02 \x01(\bR\adiscard\"\x93\x01\n" +
+	"!ReplayQuarantinedMessagesResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x12!\n" +
+	"\freplayed_ids\x18\x02 \x03(\tR\vreplayedIds\x12\x1d\n" +
+	"\n" +
+	"failed_ids\x18\x03 \x03(\tR\tfailedIds\"Y\n" +
+	"\rModerationHit\x12\x16\n" +
+	"\x06filter\x18\x01 \x01(\tR\x06filter\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x18\n" +
+	"\amatches\x18\x03 \x03(\tR\amatches\"\x9d\x02\n" +
+	"\x0eFlaggedMessage\x12\x15\n" +
+	"\x06msg_id\x18\x01 \x01(\tR\x05msgId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x1b\n" +
+	"\tsender_id\x18\x03 \x01(\x03R\bsenderId\x12\x19\n" +
+	"\bgroup_id\x18\x04 \x01(\x03R\agroupId\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12+\n" +
+	"\x11delivered_content\x18\x06 \x01(\tR\x10deliveredContent\x12-\n" +
+	"\x04hits\x18\a \x03(\v2\x19.gochat.rpc.ModerationHitR\x04hits\x12\x1d\n" +
+	"\n" +
+	"flagged_at\x18\b \x01(\x03R\tflaggedAt\"J\n" +
+	"\x1aListFlaggedMessagesRequest\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x03R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\"\x99\x01\n" +
+	"\x1bListFlaggedMessagesResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x126\n" +
+	"\bmessages\x18\x02 \x03(\v2\x1a.gochat.rpc.FlaggedMessageR\bmessages\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\"8\n" +
+	"\x1dResolveFlaggedMessagesRequest\x12\x17\n" +
+	"\amsg_ids\x18\x01 \x03(\tR\x06msgIds\"j\n" +
+	"\x1eResolveFlaggedMessagesResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x12\x1a\n" +
+	"\bresolved\x18\x02 \x01(\x05R\bresolved2\x8d\n" +
+	"\n" +
 	"\x0eMessageService\x12N\n" +
 	"\vGetMessages\x12\x1e.gochat.rpc.GetMessagesRequest\x1a\x1f.gochat.rpc.GetMessagesResponse\x12]\n" +
 	"\x10GetConversations\x12#.gochat.rpc.GetConversationsRequest\x1a$.gochat.rpc.GetConversationsResponse\x12N\n" +
@@ -1219,7 +2287,13 @@ const file_message_proto_rawDesc = "" +
 	"\x0eGetMessageByID\x12!.gochat.rpc.GetMessageByIDRequest\x1a\".gochat.rpc.GetMessageByIDResponse\x12N\n" +
 	"\vSaveMessage\x12\x1e.gochat.rpc.SaveMessageRequest\x1a\x1f.gochat.rpc.SaveMessageResponse\x12f\n" +
 	"\x13RestoreConversation\x12&.gochat.rpc.RestoreConversationRequest\x1a'.gochat.rpc.RestoreConversationResponse\x12c\n" +
-	"\x12DeleteConversation\x12%.gochat.rpc.DeleteConversationRequest\x1a&.gochat.rpc.DeleteConversationResponseB\x06Z\x04./pbb\x06proto3"
+	"\x12DeleteConversation\x12%.gochat.rpc.DeleteConversationRequest\x1a&.gochat.rpc.DeleteConversationResponse\x12`\n" +
+	"\x11ListNotifications\x12$.gochat.rpc.ListNotificationsRequest\x1a%.gochat.rpc.ListNotificationsResponse\x12]\n" +
+	"\x10AckNotifications\x12#.gochat.rpc.AckNotificationsRequest\x1a$.gochat.rpc.AckNotificationsResponse\x12r\n" +
+	"\x17ListQuarantinedMessages\x12*.gochat.rpc.ListQuarantinedMessagesRequest\x1a+.gochat.rpc.ListQuarantinedMessagesResponse\x12x\n" +
+	"\x19ReplayQuarantinedMessages\x12,.gochat.rpc.ReplayQuarantinedMessagesRequest\x1a-.gochat.rpc.ReplayQuarantinedMessagesResponse\x12f\n" +
+	"\x13ListFlaggedMessages\x12&.gochat.rpc.ListFlaggedMessagesRequest\x1a'.gochat.rpc.ListFlaggedMessagesResponse\x12o\n" +
+	"\x16ResolveFlaggedMessages\x12).gochat.rpc.ResolveFlaggedMessagesRequest\x1a*.gochat.rpc.ResolveFlaggedMessagesResponseB\x06Z\x04./pbb\x06proto3"
 
 var (
 	file_message_proto_rawDescOnce sync.Once
@@ -1233,58 +2307,99 @@ func file_message_proto_rawDescGZIP() []byte {
 	return file_message_proto_rawDescData
 }
 
-var file_message_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_message_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_message_proto_goTypes = []any{
-	(*RestoreConversationRequest)(nil),  // 0: gochat.rpc.RestoreConversationRequest
-	(*RestoreConversationResponse)(nil), // 1: gochat.rpc.RestoreConversationResponse
-	(*ChatMessage)(nil),                 // 2: gochat.rpc.ChatMessage
-	(*ConversationInfo)(nil),            // 3: gochat.rpc.ConversationInfo
-	(*GetMessagesRequest)(nil),          // 4: gochat.rpc.GetMessagesRequest
-	(*GetMessagesResponse)(nil),         // 5: gochat.rpc.GetMessagesResponse
-	(*GetConversationsRequest)(nil),     // 6: gochat.rpc.GetConversationsRequest
-	(*GetConversationsResponse)(nil),    // 7: gochat.rpc.GetConversationsResponse
-	(*ClearUnreadRequest)(nil),          // 8: gochat.rpc.ClearUnreadRequest
-	(*ClearUnreadResponse)(nil),         // 9: gochat.rpc.ClearUnreadResponse
-	(*GetMessageByIDRequest)(nil),       // 10: gochat.rpc.GetMessageByIDRequest
-	(*GetMessageByIDResponse)(nil),      // 11: gochat.rpc.GetMessageByIDResponse
-	(*ChatMessageEvent)(nil),            // 12: gochat.rpc.ChatMessageEvent
-	(*SaveMessageRequest)(nil),          // 13: gochat.rpc.SaveMessageRequest
-	(*SaveMessageResponse)(nil),         // 14: gochat.rpc.SaveMessageResponse
-	(*DeleteConversationRequest)(nil),   // 15: gochat.rpc.DeleteConversationRequest
-	(*DeleteConversationResponse)(nil),  // 16: gochat.rpc.DeleteConversationResponse
-	(*BaseResponse)(nil),                // 17: gochat.rpc.BaseResponse
+	(*RestoreConversationRequest)(nil),        // 0: gochat.rpc.RestoreConversationRequest
+	(*RestoreConversationResponse)(nil),       // 1: gochat.rpc.RestoreConversationResponse
+	(*ChatMessage)(nil),                       // 2: gochat.rpc.ChatMessage
+	(*ConversationInfo)(nil),                  // 3: gochat.rpc.ConversationInfo
+	(*GetMessagesRequest)(nil),                // 4: gochat.rpc.GetMessagesRequest
+	(*GetMessagesResponse)(nil),               // 5: gochat.rpc.GetMessagesResponse
+	(*GetConversationsRequest)(nil),           // 6: gochat.rpc.GetConversationsRequest
+	(*GetConversationsResponse)(nil),          // 7: gochat.rpc.GetConversationsResponse
+	(*ClearUnreadRequest)(nil),                // 8: gochat.rpc.ClearUnreadRequest
+	(*ClearUnreadResponse)(nil),               // 9: gochat.rpc.ClearUnreadResponse
+	(*GetMessageByIDRequest)(nil),             // 10: gochat.rpc.GetMessageByIDRequest
+	(*GetMessageByIDResponse)(nil),            // 11: gochat.rpc.GetMessageByIDResponse
+	(*ChatMessageEvent)(nil),                  // 12: gochat.rpc.ChatMessageEvent
+	(*SaveMessageRequest)(nil),                // 13: gochat.rpc.SaveMessageRequest
+	(*SaveMessageResponse)(nil),               // 14: gochat.rpc.SaveMessageResponse
+	(*DeleteConversationRequest)(nil),         // 15: gochat.rpc.DeleteConversationRequest
+	(*DeleteConversationResponse)(nil),        // 16: gochat.rpc.DeleteConversationResponse
+	(*Notification)(nil),                      // 17: gochat.rpc.Notification
+	(*ListNotificationsRequest)(nil),          // 18: gochat.rpc.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),         // 19: gochat.rpc.ListNotificationsResponse
+	(*AckNotificationsRequest)(nil),           // 20: gochat.rpc.AckNotificationsRequest
+	(*AckNotificationsResponse)(nil),          // 21: gochat.rpc.AckNotificationsResponse
+	(*QuarantinedMessage)(nil),                // 22: gochat.rpc.QuarantinedMessage
+	(*ListQuarantinedMessagesRequest)(nil),    // 23: gochat.rpc.ListQuarantinedMessagesRequest
+	(*ListQuarantinedMessagesResponse)(nil),   // 24: gochat.rpc.ListQuarantinedMessagesResponse
+	(*ReplayQuarantinedMessagesRequest)(nil),  // 25: gochat.rpc.ReplayQuarantinedMessagesRequest
+	(*ReplayQuarantinedMessagesResponse)(nil), // 26: gochat.rpc.ReplayQuarantinedMessagesResponse
+	(*ModerationHit)(nil),                     // 27: gochat.rpc.ModerationHit
+	(*FlaggedMessage)(nil),                    // 28: gochat.rpc.FlaggedMessage
+	(*ListFlaggedMessagesRequest)(nil),        // 29: gochat.rpc.ListFlaggedMessagesRequest
+	(*ListFlaggedMessagesResponse)(nil),       // 30: gochat.rpc.ListFlaggedMessagesResponse
+	(*ResolveFlaggedMessagesRequest)(nil),     // 31: gochat.rpc.ResolveFlaggedMessagesRequest
+	(*ResolveFlaggedMessagesResponse)(nil),    // 32: gochat.rpc.ResolveFlaggedMessagesResponse
+	nil,                                       // 33: gochat.rpc.QuarantinedMessage.HeadersEntry
+	(*BaseResponse)(nil),                      // 34: gochat.rpc.BaseResponse
 }
 var file_message_proto_depIdxs = []int32{
-	17, // 0: gochat.rpc.RestoreConversationResponse.base:type_name -> gochat.rpc.BaseResponse
-	17, // 1: gochat.rpc.GetMessagesResponse.base:type_name -> gochat.rpc.BaseResponse
+	34, // 0: gochat.rpc.RestoreConversationResponse.base:type_name -> gochat.rpc.BaseResponse
+	34, // 1: gochat.rpc.GetMessagesResponse.base:type_name -> gochat.rpc.BaseResponse
 	2,  // 2: gochat.rpc.GetMessagesResponse.messages:type_name -> gochat.rpc.ChatMessage
-	17, // 3: gochat.rpc.GetConversationsResponse.base:type_name -> gochat.rpc.BaseResponse
+	34, // 3: gochat.rpc.GetConversationsResponse.base:type_name -> gochat.rpc.BaseResponse
 	3,  // 4: gochat.rpc.GetConversationsResponse.conversations:type_name -> gochat.rpc.ConversationInfo
-	17, // 5: gochat.rpc.ClearUnreadResponse.base:type_name -> gochat.rpc.BaseResponse
-	17, // 6: gochat.rpc.GetMessageByIDResponse.base:type_name -> gochat.rpc.BaseResponse
+	34, // 5: gochat.rpc.ClearUnreadResponse.base:type_name -> gochat.rpc.BaseResponse
+	34, // 6: gochat.rpc.GetMessageByIDResponse.base:type_name -> gochat.rpc.BaseResponse
 	2,  // 7: gochat.rpc.GetMessageByIDResponse.message:type_name -> gochat.rpc.ChatMessage
 	12, // 8: gochat.rpc.SaveMessageRequest.message:type_name -> gochat.rpc.ChatMessageEvent
-	17, // 9: gochat.rpc.SaveMessageResponse.base:type_name -> gochat.rpc.BaseResponse
-	17, // 10: gochat.rpc.DeleteConversationResponse.base:type_name -> gochat.rpc.BaseResponse
-	4,  // 11: gochat.rpc.MessageService.GetMessages:input_type -> gochat.rpc.GetMessagesRequest
-	6,  // 12: gochat.rpc.MessageService.GetConversations:input_type -> gochat.rpc.GetConversationsRequest
-	8,  // 13: gochat.rpc.MessageService.ClearUnread:input_type -> gochat.rpc.ClearUnreadRequest
-	10, // 14: gochat.rpc.MessageService.GetMessageByID:input_type -> gochat.rpc.GetMessageByIDRequest
-	13, // 15: gochat.rpc.MessageService.SaveMessage:input_type -> gochat.rpc.SaveMessageRequest
-	0,  // 16: gochat.rpc.MessageService.RestoreConversation:input_type -> gochat.rpc.RestoreConversationRequest
-	15, // 17: gochat.rpc.MessageService.DeleteConversation:input_type -> gochat.rpc.DeleteConversationRequest
-	5,  // 18: gochat.rpc.MessageService.GetMessages:output_type -> gochat.rpc.GetMessagesResponse
-	7,  // 19: gochat.rpc.MessageService.GetConversations:output_type -> gochat.rpc.GetConversationsResponse
-	9,  // 20: gochat.rpc.MessageService.ClearUnread:output_type -> gochat.rpc.ClearUnreadResponse
-	11, // 21: gochat.rpc.MessageService.GetMessageByID:output_type -> gochat.rpc.GetMessageByIDResponse
-	14, // 22: gochat.rpc.MessageService.SaveMessage:output_type -> gochat.rpc.SaveMessageResponse
-	1,  // 23: gochat.rpc.MessageService.RestoreConversation:output_type -> gochat.rpc.RestoreConversationResponse
-	16, // 24: gochat.rpc.MessageService.DeleteConversation:output_type -> gochat.rpc.DeleteConversationResponse
-	18, // [18:25] is the sub-list for method output_type
-	11, // [11:18] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	34, // 9: gochat.rpc.SaveMessageResponse.base:type_name -> gochat.rpc.BaseResponse
+	34, // 10: gochat.rpc.DeleteConversationResponse.base:type_name -> gochat.rpc.BaseResponse
+	12, // 11: gochat.rpc.Notification.message:type_name -> gochat.rpc.ChatMessageEvent
+	34, // 12: gochat.rpc.ListNotificationsResponse.base:type_name -> gochat.rpc.BaseResponse
+	17, // 13: gochat.rpc.ListNotificationsResponse.notifications:type_name -> gochat.rpc.Notification
+	34, // 14: gochat.rpc.AckNotificationsResponse.base:type_name -> gochat.rpc.BaseResponse
+	33, // 15: gochat.rpc.QuarantinedMessage.headers:type_name -> gochat.rpc.QuarantinedMessage.HeadersEntry
+	34, // 16: gochat.rpc.ListQuarantinedMessagesResponse.base:type_name -> gochat.rpc.BaseResponse
+	22, // 17: gochat.rpc.ListQuarantinedMessagesResponse.messages:type_name -> gochat.rpc.QuarantinedMessage
+	34, // 18: gochat.rpc.ReplayQuarantinedMessagesResponse.base:type_name -> gochat.rpc.BaseResponse
+	27, // 19: gochat.rpc.FlaggedMessage.hits:type_name -> gochat.rpc.ModerationHit
+	34, // 20: gochat.rpc.ListFlaggedMessagesResponse.base:type_name -> gochat.rpc.BaseResponse
+	28, // 21: gochat.rpc.ListFlaggedMessagesResponse.messages:type_name -> gochat.rpc.FlaggedMessage
+	34, // 22: gochat.rpc.ResolveFlaggedMessagesResponse.base:type_name -> gochat.rpc.BaseResponse
+	4,  // 23: gochat.rpc.MessageService.GetMessages:input_type -> gochat.rpc.GetMessagesRequest
+	6,  // 24: gochat.rpc.MessageService.GetConversations:input_type -> gochat.rpc.GetConversationsRequest
+	8,  // 25: gochat.rpc.MessageService.ClearUnread:input_type -> gochat.rpc.ClearUnreadRequest
+	10, // 26: gochat.rpc.MessageService.GetMessageByID:input_type -> gochat.rpc.GetMessageByIDRequest
+	13, // 27: gochat.rpc.MessageService.SaveMessage:input_type -> gochat.rpc.SaveMessageRequest
+	0,  // 28: gochat.rpc.MessageService.RestoreConversation:input_type -> gochat.rpc.RestoreConversationRequest
+	15, // 29: gochat.rpc.MessageService.DeleteConversation:input_type -> gochat.rpc.DeleteConversationRequest
+	18, // 30: gochat.rpc.MessageService.ListNotifications:input_type -> gochat.rpc.ListNotificationsRequest
+	20, // 31: gochat.rpc.MessageService.AckNotifications:input_type -> gochat.rpc.AckNotificationsRequest
+	23, // 32: gochat.rpc.MessageService.ListQuarantinedMessages:input_type -> gochat.rpc.ListQuarantinedMessagesRequest
+	25, // 33: gochat.rpc.MessageService.ReplayQuarantinedMessages:input_type -> gochat.rpc.ReplayQuarantinedMessagesRequest
+	29, // 34: gochat.rpc.MessageService.ListFlaggedMessages:input_type -> gochat.rpc.ListFlaggedMessagesRequest
+	31, // 35: gochat.rpc.MessageService.ResolveFlaggedMessages:input_type -> gochat.rpc.ResolveFlaggedMessagesRequest
+	5,  // 36: gochat.rpc.MessageService.GetMessages:output_type -> gochat.rpc.GetMessagesResponse
+	7,  // 37: gochat.rpc.MessageService.GetConversations:output_type -> gochat.rpc.GetConversationsResponse
+	9,  // 38: gochat.rpc.MessageService.ClearUnread:output_type -> gochat.rpc.ClearUnreadResponse
+	11, // 39: gochat.rpc.MessageService.GetMessageByID:output_type -> gochat.rpc.GetMessageByIDResponse
+	14, // 40: gochat.rpc.MessageService.SaveMessage:output_type -> gochat.rpc.SaveMessageResponse
+	1,  // 41: gochat.rpc.MessageService.RestoreConversation:output_type -> gochat.rpc.RestoreConversationResponse
+	16, // 42: gochat.rpc.MessageService.DeleteConversation:output_type -> gochat.rpc.DeleteConversationResponse
+	19, // 43: gochat.rpc.MessageService.ListNotifications:output_type -> gochat.rpc.ListNotificationsResponse
+	21, // 44: gochat.rpc.MessageService.AckNotifications:output_type -> gochat.rpc.AckNotificationsResponse
+	24, // 45: gochat.rpc.MessageService.ListQuarantinedMessages:output_type -> gochat.rpc.ListQuarantinedMessagesResponse
+	26, // 46: gochat.rpc.MessageService.ReplayQuarantinedMessages:output_type -> gochat.rpc.ReplayQuarantinedMessagesResponse
+	30, // 47: gochat.rpc.MessageService.ListFlaggedMessages:output_type -> gochat.rpc.ListFlaggedMessagesResponse
+	32, // 48: gochat.rpc.MessageService.ResolveFlaggedMessages:output_type -> gochat.rpc.ResolveFlaggedMessagesResponse
+	36, // [36:49] is the sub-list for method output_type
+	23, // [23:36] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_message_proto_init() }
@@ -1299,7 +2414,7 @@ func file_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_message_proto_rawDesc), len(file_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
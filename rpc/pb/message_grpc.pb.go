@@ -19,13 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MessageService_GetMessages_FullMethodName         = "/gochat.rpc.MessageService/GetMessages"
-	MessageService_GetConversations_FullMethodName    = "/gochat.rpc.MessageService/GetConversations"
-	MessageService_ClearUnread_FullMethodName         = "/gochat.rpc.MessageService/ClearUnread"
-	MessageService_GetMessageByID_FullMethodName      = "/gochat.rpc.MessageService/GetMessageByID"
-	MessageService_SaveMessage_FullMethodName         = "/gochat.rpc.MessageService/SaveMessage"
-	MessageService_RestoreConversation_FullMethodName = "/gochat.rpc.MessageService/RestoreConversation"
-	MessageService_DeleteConversation_FullMethodName  = "/gochat.rpc.MessageService/DeleteConversation"
+	MessageService_GetMessages_FullMethodName               = "/gochat.rpc.MessageService/GetMessages"
+	MessageService_GetConversations_FullMethodName          = "/gochat.rpc.MessageService/GetConversations"
+	MessageService_ClearUnread_FullMethodName               = "/gochat.rpc.MessageService/ClearUnread"
+	MessageService_GetMessageByID_FullMethodName            = "/gochat.rpc.MessageService/GetMessageByID"
+	MessageService_SaveMessage_FullMethodName               = "/gochat.rpc.MessageService/SaveMessage"
+	MessageService_RestoreConversation_FullMethodName       = "/gochat.rpc.MessageService/RestoreConversation"
+	MessageService_DeleteConversation_FullMethodName        = "/gochat.rpc.MessageService/DeleteConversation"
+	MessageService_ListNotifications_FullMethodName         = "/gochat.rpc.MessageService/ListNotifications"
+	MessageService_AckNotifications_FullMethodName          = "/gochat.rpc.MessageService/AckNotifications"
+	MessageService_ListQuarantinedMessages_FullMethodName   = "/gochat.rpc.MessageService/ListQuarantinedMessages"
+	MessageService_ReplayQuarantinedMessages_FullMethodName = "/gochat.rpc.MessageService/ReplayQuarantinedMessages"
+	MessageService_ListFlaggedMessages_FullMethodName       = "/gochat.rpc.MessageService/ListFlaggedMessages"
+	MessageService_ResolveFlaggedMessages_FullMethodName    = "/gochat.rpc.MessageService/ResolveFlaggedMessages"
 )
 
 // MessageServiceClient is the client API for MessageService service.
@@ -39,6 +45,15 @@ type MessageServiceClient interface {
 	SaveMessage(ctx context.Context, in *SaveMessageRequest, opts ...grpc.CallOption) (*SaveMessageResponse, error)
 	RestoreConversation(ctx context.Context, in *RestoreConversationRequest, opts ...grpc.CallOption) (*RestoreConversationResponse, error)
 	DeleteConversation(ctx context.Context, in *DeleteConversationRequest, opts ...grpc.CallOption) (*DeleteConversationResponse, error)
+	// Messages whose realtime push gave up for good, kept until the user acknowledges them
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error)
+	AckNotifications(ctx context.Context, in *AckNotificationsRequest, opts ...grpc.CallOption) (*AckNotificationsResponse, error)
+	// Admin: inspect and replay records quarantined on the dead-letter topic
+	ListQuarantinedMessages(ctx context.Context, in *ListQuarantinedMessagesRequest, opts ...grpc.CallOption) (*ListQuarantinedMessagesResponse, error)
+	ReplayQuarantinedMessages(ctx context.Context, in *ReplayQuarantinedMessagesRequest, opts ...grpc.CallOption) (*ReplayQuarantinedMessagesResponse, error)
+	// Admin: review messages flagged by content moderation
+	ListFlaggedMessages(ctx context.Context, in *ListFlaggedMessagesRequest, opts ...grpc.CallOption) (*ListFlaggedMessagesResponse, error)
+	ResolveFlaggedMessages(ctx context.Context, in *ResolveFlaggedMessagesRequest, opts ...grpc.CallOption) (*ResolveFlaggedMessagesResponse, error)
 }
 
 type messageServiceClient struct {
@@ -119,6 +134,66 @@ func (c *messageServiceClient) DeleteConversation(ctx context.Context, in *Delet
 	return out, nil
 }
 
+func (c *messageServiceClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotificationsResponse)
+	err := c.cc.Invoke(ctx, MessageService_ListNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) AckNotifications(ctx context.Context, in *AckNotificationsRequest, opts ...grpc.CallOption) (*AckNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AckNotificationsResponse)
+	err := c.cc.Invoke(ctx, MessageService_AckNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) ListQuarantinedMessages(ctx context.Context, in *ListQuarantinedMessagesRequest, opts ...grpc.CallOption) (*ListQuarantinedMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuarantinedMessagesResponse)
+	err := c.cc.Invoke(ctx, MessageService_ListQuarantinedMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) ReplayQuarantinedMessages(ctx context.Context, in *ReplayQuarantinedMessagesRequest, opts ...grpc.CallOption) (*ReplayQuarantinedMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayQuarantinedMessagesResponse)
+	err := c.cc.Invoke(ctx, MessageService_ReplayQuarantinedMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) ListFlaggedMessages(ctx context.Context, in *ListFlaggedMessagesRequest, opts ...grpc.CallOption) (*ListFlaggedMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFlaggedMessagesResponse)
+	err := c.cc.Invoke(ctx, MessageService_ListFlaggedMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) ResolveFlaggedMessages(ctx context.Context, in *ResolveFlaggedMessagesRequest, opts ...grpc.CallOption) (*ResolveFlaggedMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveFlaggedMessagesResponse)
+	err := c.cc.Invoke(ctx, MessageService_ResolveFlaggedMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MessageServiceServer is the server API for MessageService service.
 // All implementations must embed UnimplementedMessageServiceServer
 // for forward compatibility.
@@ -130,6 +205,15 @@ type MessageServiceServer interface {
 	SaveMessage(context.Context, *SaveMessageRequest) (*SaveMessageResponse, error)
 	RestoreConversation(context.Context, *RestoreConversationRequest) (*RestoreConversationResponse, error)
 	DeleteConversation(context.Context, *DeleteConversationRequest) (*DeleteConversationResponse, error)
+	// Messages whose realtime push gave up for good, kept until the user acknowledges them
+	ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error)
+	AckNotifications(context.Context, *AckNotificationsRequest) (*AckNotificationsResponse, error)
+	// Admin: inspect and replay records quarantined on the dead-letter topic
+	ListQuarantinedMessages(context.Context, *ListQuarantinedMessagesRequest) (*ListQuarantinedMessagesResponse, error)
+	ReplayQuarantinedMessages(context.Context, *ReplayQuarantinedMessagesRequest) (*ReplayQuarantinedMessagesResponse, error)
+	// Admin: review messages flagged by content moderation
+	ListFlaggedMessages(context.Context, *ListFlaggedMessagesRequest) (*ListFlaggedMessagesResponse, error)
+	ResolveFlaggedMessages(context.Context, *ResolveFlaggedMessagesRequest) (*ResolveFlaggedMessagesResponse, error)
 	mustEmbedUnimplementedMessageServiceServer()
 }
 
@@ -161,6 +245,24 @@ func (UnimplementedMessageServiceServer) RestoreConversation(context.Context, *R
 func (UnimplementedMessageServiceServer) DeleteConversation(context.Context, *DeleteConversationRequest) (*DeleteConversationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteConversation not implemented")
 }
+func (UnimplementedMessageServiceServer) ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedMessageServiceServer) AckNotifications(context.Context, *AckNotificationsRequest) (*AckNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckNotifications not implemented")
+}
+func (UnimplementedMessageServiceServer) ListQuarantinedMessages(context.Context, *ListQuarantinedMessagesRequest) (*ListQuarantinedMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuarantinedMessages not implemented")
+}
+func (UnimplementedMessageServiceServer) ReplayQuarantinedMessages(context.Context, *ReplayQuarantinedMessagesRequest) (*ReplayQuarantinedMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayQuarantinedMessages not implemented")
+}
+func (UnimplementedMessageServiceServer) ListFlaggedMessages(context.Context, *ListFlaggedMessagesRequest) (*ListFlaggedMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFlaggedMessages not implemented")
+}
+func (UnimplementedMessageServiceServer) ResolveFlaggedMessages(context.Context, *ResolveFlaggedMessagesRequest) (*ResolveFlaggedMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveFlaggedMessages not implemented")
+}
 func (UnimplementedMessageServiceServer) mustEmbedUnimplementedMessageServiceServer() {}
 func (UnimplementedMessageServiceServer) testEmbeddedByValue()                        {}
 
@@ -308,6 +410,114 @@ func _MessageService_DeleteConversation_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MessageService_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_ListNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).ListNotifications(ctx, req.(*ListNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_AckNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).AckNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_AckNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).AckNotifications(ctx, req.(*AckNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_ListQuarantinedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuarantinedMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).ListQuarantinedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_ListQuarantinedMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).ListQuarantinedMessages(ctx, req.(*ListQuarantinedMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_ReplayQuarantinedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayQuarantinedMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).ReplayQuarantinedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_ReplayQuarantinedMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).ReplayQuarantinedMessages(ctx, req.(*ReplayQuarantinedMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_ListFlaggedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFlaggedMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).ListFlaggedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_ListFlaggedMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).ListFlaggedMessages(ctx, req.(*ListFlaggedMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_ResolveFlaggedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveFlaggedMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).ResolveFlaggedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_ResolveFlaggedMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).ResolveFlaggedMessages(ctx, req.(*ResolveFlaggedMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MessageService_ServiceDesc is the grpc.ServiceDesc for MessageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -343,6 +553,30 @@ var MessageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteConversation",
 			Handler:    _MessageService_DeleteConversation_Handler,
 		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _MessageService_ListNotifications_Handler,
+		},
+		{
+			MethodName: "AckNotifications",
+			Handler:    _MessageService_AckNotifications_Handler,
+		},
+		{
+			MethodName: "ListQuarantinedMessages",
+			Handler:    _MessageService_ListQuarantinedMessages_Handler,
+		},
+		{
+			MethodName: "ReplayQuarantinedMessages",
+			Handler:    _MessageService_ReplayQuarantinedMessages_Handler,
+		},
+		{
+			MethodName: "ListFlaggedMessages",
+			Handler:    _MessageService_ListFlaggedMessages_Handler,
+		},
+		{
+			MethodName: "ResolveFlaggedMessages",
+			Handler:    _MessageService_ResolveFlaggedMessages_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "message.proto",
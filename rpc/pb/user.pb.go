@@ -21,10 +21,12 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Sends a reset code to the user's verified email or phone. The response is the same whether
+// or not the user exists or has a verified contact.
 type ForgotPasswordRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	Channel       string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"` // "email" or "sms"; empty picks the verified email, then the verified phone
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,9 +68,9 @@ func (x *ForgotPasswordRequest) GetUsername() string {
 	return ""
 }
 
-func (x *ForgotPasswordRequest) GetNewPassword() string {
+func (x *ForgotPasswordRequest) GetChannel() string {
 	if x != nil {
-		return x.NewPassword
+		return x.Channel
 	}
 	return ""
 }
@@ -117,37 +119,29 @@ func (x *ForgotPasswordResponse) GetBase() *BaseResponse {
 	return nil
 }
 
-type User struct {
+type ResetPasswordRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Nickname      string                 `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Avatar        string                 `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
-	Email         string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
-	Gender        int32                  `protobuf:"varint,7,opt,name=gender,proto3" json:"gender,omitempty"`
-	Status        int32                  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	InfoVersion   int64                  `protobuf:"varint,11,opt,name=info_version,json=infoVersion,proto3" json:"info_version,omitempty"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *User) Reset() {
-	*x = User{}
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
 	mi := &file_user_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *User) String() string {
+func (x *ResetPasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*ResetPasswordRequest) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -159,112 +153,99 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *User) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *User) GetUsername() string {
+func (x *ResetPasswordRequest) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *User) GetNickname() string {
+func (x *ResetPasswordRequest) GetCode() string {
 	if x != nil {
-		return x.Nickname
+		return x.Code
 	}
 	return ""
 }
 
-func (x *User) GetAvatar() string {
+func (x *ResetPasswordRequest) GetNewPassword() string {
 	if x != nil {
-		return x.Avatar
+		return x.NewPassword
 	}
 	return ""
 }
 
-func (x *User) GetPhone() string {
-	if x != nil {
-		return x.Phone
-	}
-	return ""
+type ResetPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *User) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
+	mi := &file_user_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *User) GetGender() int32 {
-	if x != nil {
-		return x.Gender
-	}
-	return 0
+func (x *ResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *User) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
+func (*ResetPasswordResponse) ProtoMessage() {}
 
-func (x *User) GetCreatedAt() int64 {
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[3]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *User) GetUpdatedAt() int64 {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return 0
+// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *User) GetInfoVersion() int64 {
+func (x *ResetPasswordResponse) GetBase() *BaseResponse {
 	if x != nil {
-		return x.InfoVersion
+		return x.Base
 	}
-	return 0
+	return nil
 }
 
-type RegisterRequest struct {
+// Sends a code to the caller's (from metadata) current email or phone
+type SendVerificationCodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	Nickname      string                 `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"` // "email" or "sms"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterRequest) Reset() {
-	*x = RegisterRequest{}
-	mi := &file_user_proto_msgTypes[3]
+func (x *SendVerificationCodeRequest) Reset() {
+	*x = SendVerificationCodeRequest{}
+	mi := &file_user_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterRequest) String() string {
+func (x *SendVerificationCodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterRequest) ProtoMessage() {}
+func (*SendVerificationCodeRequest) ProtoMessage() {}
 
-func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[3]
+func (x *SendVerificationCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -275,55 +256,91 @@ func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
-func (*RegisterRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use SendVerificationCodeRequest.ProtoReflect.Descriptor instead.
+func (*SendVerificationCodeRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *RegisterRequest) GetUsername() string {
+func (x *SendVerificationCodeRequest) GetChannel() string {
 	if x != nil {
-		return x.Username
+		return x.Channel
 	}
 	return ""
 }
 
-func (x *RegisterRequest) GetPassword() string {
+type SendVerificationCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendVerificationCodeResponse) Reset() {
+	*x = SendVerificationCodeResponse{}
+	mi := &file_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendVerificationCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendVerificationCodeResponse) ProtoMessage() {}
+
+func (x *SendVerificationCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[5]
 	if x != nil {
-		return x.Password
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RegisterRequest) GetNickname() string {
+// Deprecated: Use SendVerificationCodeResponse.ProtoReflect.Descriptor instead.
+func (*SendVerificationCodeResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SendVerificationCodeResponse) GetBase() *BaseResponse {
 	if x != nil {
-		return x.Nickname
+		return x.Base
 	}
-	return ""
+	return nil
 }
 
-type RegisterResponse struct {
+// One sign-in attempt on the caller's (from metadata) account
+type LoginRecord struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Platform      string                 `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`
+	Ip            string                 `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
+	Success       bool                   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`                         // why a rejected attempt failed: bad_password, bad_mfa_code, locked_out, banned or deactivated
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // unix seconds
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterResponse) Reset() {
-	*x = RegisterResponse{}
-	mi := &file_user_proto_msgTypes[4]
+func (x *LoginRecord) Reset() {
+	*x = LoginRecord{}
+	mi := &file_user_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterResponse) String() string {
+func (x *LoginRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterResponse) ProtoMessage() {}
+func (*LoginRecord) ProtoMessage() {}
 
-func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[4]
+func (x *LoginRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -334,48 +351,83 @@ func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
-func (*RegisterResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use LoginRecord.ProtoReflect.Descriptor instead.
+func (*LoginRecord) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *RegisterResponse) GetBase() *BaseResponse {
+func (x *LoginRecord) GetId() int64 {
 	if x != nil {
-		return x.Base
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *RegisterResponse) GetUser() *User {
+func (x *LoginRecord) GetDeviceId() string {
 	if x != nil {
-		return x.User
+		return x.DeviceId
 	}
-	return nil
+	return ""
 }
 
-type LoginRequest struct {
+func (x *LoginRecord) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *LoginRecord) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *LoginRecord) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LoginRecord) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LoginRecord) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListLoginHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	BeforeId      int64                  `protobuf:"varint,1,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"` // page below this record id; 0 for the newest
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginRequest) Reset() {
-	*x = LoginRequest{}
-	mi := &file_user_proto_msgTypes[5]
+func (x *ListLoginHistoryRequest) Reset() {
+	*x = ListLoginHistoryRequest{}
+	mi := &file_user_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginRequest) String() string {
+func (x *ListLoginHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginRequest) ProtoMessage() {}
+func (*ListLoginHistoryRequest) ProtoMessage() {}
 
-func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[5]
+func (x *ListLoginHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -386,49 +438,48 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
-func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListLoginHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListLoginHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *LoginRequest) GetUsername() string {
+func (x *ListLoginHistoryRequest) GetBeforeId() int64 {
 	if x != nil {
-		return x.Username
+		return x.BeforeId
 	}
-	return ""
+	return 0
 }
 
-func (x *LoginRequest) GetPassword() string {
+func (x *ListLoginHistoryRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Password
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-type LoginResponse struct {
+type ListLoginHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	User          *User                  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Records       []*LoginRecord         `protobuf:"bytes,2,rep,name=records,proto3" json:"records,omitempty"` // newest first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginResponse) Reset() {
-	*x = LoginResponse{}
-	mi := &file_user_proto_msgTypes[6]
+func (x *ListLoginHistoryResponse) Reset() {
+	*x = ListLoginHistoryResponse{}
+	mi := &file_user_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginResponse) String() string {
+func (x *ListLoginHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginResponse) ProtoMessage() {}
+func (*ListLoginHistoryResponse) ProtoMessage() {}
 
-func (x *LoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[6]
+func (x *ListLoginHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -439,54 +490,48 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
-func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListLoginHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListLoginHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *LoginResponse) GetBase() *BaseResponse {
+func (x *ListLoginHistoryResponse) GetBase() *BaseResponse {
 	if x != nil {
 		return x.Base
 	}
 	return nil
 }
 
-func (x *LoginResponse) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
-}
-
-func (x *LoginResponse) GetUser() *User {
+func (x *ListLoginHistoryResponse) GetRecords() []*LoginRecord {
 	if x != nil {
-		return x.User
+		return x.Records
 	}
 	return nil
 }
 
-type GetUserRequest struct {
+type VerifyContactRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserRequest) Reset() {
-	*x = GetUserRequest{}
-	mi := &file_user_proto_msgTypes[7]
+func (x *VerifyContactRequest) Reset() {
+	*x = VerifyContactRequest{}
+	mi := &file_user_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserRequest) String() string {
+func (x *VerifyContactRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserRequest) ProtoMessage() {}
+func (*VerifyContactRequest) ProtoMessage() {}
 
-func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[7]
+func (x *VerifyContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -497,41 +542,3988 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
-func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use VerifyContactRequest.ProtoReflect.Descriptor instead.
+func (*VerifyContactRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *VerifyContactRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *VerifyContactRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type VerifyContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyContactResponse) Reset() {
+	*x = VerifyContactResponse{}
+	mi := &file_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyContactResponse) ProtoMessage() {}
+
+func (x *VerifyContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyContactResponse.ProtoReflect.Descriptor instead.
+func (*VerifyContactResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *VerifyContactResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *VerifyContactResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Nickname      string                 `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Avatar        string                 `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email         string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	Gender        int32                  `protobuf:"varint,7,opt,name=gender,proto3" json:"gender,omitempty"`
+	Status        int32                  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	InfoVersion   int64                  `protobuf:"varint,11,opt,name=info_version,json=infoVersion,proto3" json:"info_version,omitempty"`
+	EmailVerified bool                   `protobuf:"varint,12,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	PhoneVerified bool                   `protobuf:"varint,13,opt,name=phone_verified,json=phoneVerified,proto3" json:"phone_verified,omitempty"`
+	MfaEnabled    bool                   `protobuf:"varint,14,opt,name=mfa_enabled,json=mfaEnabled,proto3" json:"mfa_enabled,omitempty"`
+	// Only set by GetUser for other users: how to send them a friend request
+	FriendRequest  FriendRequestPolicy `protobuf:"varint,15,opt,name=friend_request,json=friendRequest,proto3,enum=gochat.rpc.FriendRequestPolicy" json:"friend_request,omitempty"`
+	FriendQuestion string              `protobuf:"bytes,16,opt,name=friend_question,json=friendQuestion,proto3" json:"friend_question,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *User) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *User) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *User) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetGender() int32 {
+	if x != nil {
+		return x.Gender
+	}
+	return 0
+}
+
+func (x *User) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *User) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *User) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *User) GetInfoVersion() int64 {
+	if x != nil {
+		return x.InfoVersion
+	}
+	return 0
+}
+
+func (x *User) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *User) GetPhoneVerified() bool {
+	if x != nil {
+		return x.PhoneVerified
+	}
+	return false
+}
+
+func (x *User) GetMfaEnabled() bool {
+	if x != nil {
+		return x.MfaEnabled
+	}
+	return false
+}
+
+func (x *User) GetFriendRequest() FriendRequestPolicy {
+	if x != nil {
+		return x.FriendRequest
+	}
+	return FriendRequestPolicy_FRIEND_REQUEST_ANYONE
+}
+
+func (x *User) GetFriendQuestion() string {
+	if x != nil {
+		return x.FriendQuestion
+	}
+	return ""
+}
+
+type RegisterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Nickname      string                 `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterRequest) Reset() {
+	*x = RegisterRequest{}
+	mi := &file_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterRequest) ProtoMessage() {}
+
+func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RegisterRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+type RegisterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterResponse) Reset() {
+	*x = RegisterResponse{}
+	mi := &file_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterResponse) ProtoMessage() {}
+
+func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
+func (*RegisterResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RegisterResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *RegisterResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type LoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"` // generated when empty and returned in the response
+	Platform      DevicePlatform         `protobuf:"varint,4,opt,name=platform,proto3,enum=gochat.rpc.DevicePlatform" json:"platform,omitempty"`
+	Ip            string                 `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"` // client address the gateway resolved through its trusted proxies, for attempt limiting and login history
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	mi := &file_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LoginRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPlatform() DevicePlatform {
+	if x != nil {
+		return x.Platform
+	}
+	return DevicePlatform_PLATFORM_UNSPECIFIED
+}
+
+func (x *LoginRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type LoginResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Base         *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Token        string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"` // short-lived access token
+	User         *User                  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	DeviceId     string                 `protobuf:"bytes,4,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	RefreshToken string                 `protobuf:"bytes,5,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresIn    int64                  `protobuf:"varint,6,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"` // seconds the access token is valid for
+	// Set instead of the tokens when the account uses 2FA: finish with VerifyMfa, or, when
+	// mfa_enrollment_required, enrol first through EnrollTotp and ConfirmTotp with mfa_token
+	MfaRequired           bool   `protobuf:"varint,7,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	MfaToken              string `protobuf:"bytes,8,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	MfaEnrollmentRequired bool   `protobuf:"varint,9,opt,name=mfa_enrollment_required,json=mfaEnrollmentRequired,proto3" json:"mfa_enrollment_required,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *LoginResponse) Reset() {
+	*x = LoginResponse{}
+	mi := &file_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginResponse) ProtoMessage() {}
+
+func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LoginResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *LoginResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *LoginResponse) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *LoginResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *LoginResponse) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetMfaEnrollmentRequired() bool {
+	if x != nil {
+		return x.MfaEnrollmentRequired
+	}
+	return false
+}
+
+// Second sign-in step: a TOTP code or one of the recovery codes
+type VerifyMfaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MfaToken      string                 `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	RecoveryCode  string                 `protobuf:"bytes,3,opt,name=recovery_code,json=recoveryCode,proto3" json:"recovery_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyMfaRequest) Reset() {
+	*x = VerifyMfaRequest{}
+	mi := &file_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMfaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMfaRequest) ProtoMessage() {}
+
+func (x *VerifyMfaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMfaRequest.ProtoReflect.Descriptor instead.
+func (*VerifyMfaRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *VerifyMfaRequest) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *VerifyMfaRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *VerifyMfaRequest) GetRecoveryCode() string {
+	if x != nil {
+		return x.RecoveryCode
+	}
+	return ""
+}
+
+// Starts TOTP enrolment for the caller (from metadata), or for the sign-in of mfa_token
+type EnrollTotpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MfaToken      string                 `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTotpRequest) Reset() {
+	*x = EnrollTotpRequest{}
+	mi := &file_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTotpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTotpRequest) ProtoMessage() {}
+
+func (x *EnrollTotpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTotpRequest.ProtoReflect.Descriptor instead.
+func (*EnrollTotpRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *EnrollTotpRequest) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+type EnrollTotpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`                           // base32, for manual entry
+	OtpauthUri    string                 `protobuf:"bytes,3,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"` // shown as a QR code
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTotpResponse) Reset() {
+	*x = EnrollTotpResponse{}
+	mi := &file_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTotpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTotpResponse) ProtoMessage() {}
+
+func (x *EnrollTotpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTotpResponse.ProtoReflect.Descriptor instead.
+func (*EnrollTotpResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *EnrollTotpResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *EnrollTotpResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollTotpResponse) GetOtpauthUri() string {
+	if x != nil {
+		return x.OtpauthUri
+	}
+	return ""
+}
+
+type ConfirmTotpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	MfaToken      string                 `protobuf:"bytes,2,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTotpRequest) Reset() {
+	*x = ConfirmTotpRequest{}
+	mi := &file_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTotpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTotpRequest) ProtoMessage() {}
+
+func (x *ConfirmTotpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTotpRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTotpRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ConfirmTotpRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ConfirmTotpRequest) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+type ConfirmTotpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	RecoveryCodes []string               `protobuf:"bytes,2,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"` // shown once; each signs in a single time without the app
+	Login         *LoginResponse         `protobuf:"bytes,3,opt,name=login,proto3" json:"login,omitempty"`                                      // set when enrolling during sign-in
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTotpResponse) Reset() {
+	*x = ConfirmTotpResponse{}
+	mi := &file_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTotpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTotpResponse) ProtoMessage() {}
+
+func (x *ConfirmTotpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTotpResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmTotpResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ConfirmTotpResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ConfirmTotpResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+func (x *ConfirmTotpResponse) GetLogin() *LoginResponse {
+	if x != nil {
+		return x.Login
+	}
+	return nil
+}
+
+// Turning 2FA off needs the password and a current TOTP or recovery code
+type DisableTotpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	RecoveryCode  string                 `protobuf:"bytes,3,opt,name=recovery_code,json=recoveryCode,proto3" json:"recovery_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTotpRequest) Reset() {
+	*x = DisableTotpRequest{}
+	mi := &file_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTotpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTotpRequest) ProtoMessage() {}
+
+func (x *DisableTotpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTotpRequest.ProtoReflect.Descriptor instead.
+func (*DisableTotpRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DisableTotpRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *DisableTotpRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DisableTotpRequest) GetRecoveryCode() string {
+	if x != nil {
+		return x.RecoveryCode
+	}
+	return ""
+}
+
+type DisableTotpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTotpResponse) Reset() {
+	*x = DisableTotpResponse{}
+	mi := &file_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTotpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTotpResponse) ProtoMessage() {}
+
+func (x *DisableTotpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTotpResponse.ProtoReflect.Descriptor instead.
+func (*DisableTotpResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DisableTotpResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type OidcProvider struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OidcProvider) Reset() {
+	*x = OidcProvider{}
+	mi := &file_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OidcProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OidcProvider) ProtoMessage() {}
+
+func (x *OidcProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OidcProvider.ProtoReflect.Descriptor instead.
+func (*OidcProvider) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *OidcProvider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OidcProvider) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type ListOidcProvidersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOidcProvidersRequest) Reset() {
+	*x = ListOidcProvidersRequest{}
+	mi := &file_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOidcProvidersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOidcProvidersRequest) ProtoMessage() {}
+
+func (x *ListOidcProvidersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOidcProvidersRequest.ProtoReflect.Descriptor instead.
+func (*ListOidcProvidersRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{24}
+}
+
+type ListOidcProvidersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Providers     []*OidcProvider        `protobuf:"bytes,2,rep,name=providers,proto3" json:"providers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOidcProvidersResponse) Reset() {
+	*x = ListOidcProvidersResponse{}
+	mi := &file_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOidcProvidersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOidcProvidersResponse) ProtoMessage() {}
+
+func (x *ListOidcProvidersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOidcProvidersResponse.ProtoReflect.Descriptor instead.
+func (*ListOidcProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListOidcProvidersResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListOidcProvidersResponse) GetProviders() []*OidcProvider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+type StartOidcLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"` // generated when empty, as for Login
+	Platform      DevicePlatform         `protobuf:"varint,3,opt,name=platform,proto3,enum=gochat.rpc.DevicePlatform" json:"platform,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartOidcLoginRequest) Reset() {
+	*x = StartOidcLoginRequest{}
+	mi := &file_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartOidcLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartOidcLoginRequest) ProtoMessage() {}
+
+func (x *StartOidcLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartOidcLoginRequest.ProtoReflect.Descriptor instead.
+func (*StartOidcLoginRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *StartOidcLoginRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *StartOidcLoginRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *StartOidcLoginRequest) GetPlatform() DevicePlatform {
+	if x != nil {
+		return x.Platform
+	}
+	return DevicePlatform_PLATFORM_UNSPECIFIED
+}
+
+type StartOidcLoginResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Base             *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	AuthorizationUrl string                 `protobuf:"bytes,2,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"` // where to send the browser
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StartOidcLoginResponse) Reset() {
+	*x = StartOidcLoginResponse{}
+	mi := &file_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartOidcLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartOidcLoginResponse) ProtoMessage() {}
+
+func (x *StartOidcLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartOidcLoginResponse.ProtoReflect.Descriptor instead.
+func (*StartOidcLoginResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StartOidcLoginResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *StartOidcLoginResponse) GetAuthorizationUrl() string {
+	if x != nil {
+		return x.AuthorizationUrl
+	}
+	return ""
+}
+
+// The provider redirects back with code and state; the account is found by the provider's
+// subject, linked by verified email, or created on first sign-in
+type OidcLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OidcLoginRequest) Reset() {
+	*x = OidcLoginRequest{}
+	mi := &file_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OidcLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OidcLoginRequest) ProtoMessage() {}
+
+func (x *OidcLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OidcLoginRequest.ProtoReflect.Descriptor instead.
+func (*OidcLoginRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *OidcLoginRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *OidcLoginRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *OidcLoginRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type RefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // the presented one is no longer valid
+	ExpiresIn     int64                  `protobuf:"varint,4,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenResponse) Reset() {
+	*x = RefreshTokenResponse{}
+	mi := &file_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenResponse) ProtoMessage() {}
+
+func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RefreshTokenResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *RefreshTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// Signs the caller's device (from metadata) out, or the device of the refresh token
+type LogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LogoutRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *LogoutRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type LogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResponse) ProtoMessage() {}
+
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *LogoutResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	mi := &file_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetUserResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetCurrentUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrentUserRequest) Reset() {
+	*x = GetCurrentUserRequest{}
+	mi := &file_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrentUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentUserRequest) ProtoMessage() {}
+
+func (x *GetCurrentUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentUserRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{35}
+}
+
+type GetCurrentUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrentUserResponse) Reset() {
+	*x = GetCurrentUserResponse{}
+	mi := &file_user_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrentUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentUserResponse) ProtoMessage() {}
+
+func (x *GetCurrentUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentUserResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrentUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetCurrentUserResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetCurrentUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type UpdateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nickname      string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Avatar        string                 `protobuf:"bytes,2,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Gender        int32                  `protobuf:"varint,5,opt,name=gender,proto3" json:"gender,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	mi := &file_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest) ProtoMessage() {}
+
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateUserRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetGender() int32 {
+	if x != nil {
+		return x.Gender
+	}
+	return 0
+}
+
+type UpdateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserResponse) Reset() {
+	*x = UpdateUserResponse{}
+	mi := &file_user_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserResponse) ProtoMessage() {}
+
+func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateUserResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UpdateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type SearchUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
+	mi := &file_user_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersRequest) ProtoMessage() {}
+
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SearchUsersRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Users         []*User                `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersResponse) Reset() {
+	*x = SearchUsersResponse{}
+	mi := &file_user_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersResponse) ProtoMessage() {}
+
+func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
+func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SearchUsersResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *SearchUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type GetUsersByIdsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []int64                `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersByIdsRequest) Reset() {
+	*x = GetUsersByIdsRequest{}
+	mi := &file_user_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersByIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersByIdsRequest) ProtoMessage() {}
+
+func (x *GetUsersByIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersByIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetUsersByIdsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetUsersByIdsRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type GetUsersByIdsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Users         []*User                `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersByIdsResponse) Reset() {
+	*x = GetUsersByIdsResponse{}
+	mi := &file_user_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersByIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersByIdsResponse) ProtoMessage() {}
+
+func (x *GetUsersByIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersByIdsResponse.ProtoReflect.Descriptor instead.
+func (*GetUsersByIdsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetUsersByIdsResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetUsersByIdsResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// Do-not-disturb hours, in minutes of the day in the user's timezone; start > end spans midnight
+type NotifySetting struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DndEnabled    bool                   `protobuf:"varint,1,opt,name=dnd_enabled,json=dndEnabled,proto3" json:"dnd_enabled,omitempty"`
+	DndStart      int32                  `protobuf:"varint,2,opt,name=dnd_start,json=dndStart,proto3" json:"dnd_start,omitempty"`
+	DndEnd        int32                  `protobuf:"varint,3,opt,name=dnd_end,json=dndEnd,proto3" json:"dnd_end,omitempty"`
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifySetting) Reset() {
+	*x = NotifySetting{}
+	mi := &file_user_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifySetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifySetting) ProtoMessage() {}
+
+func (x *NotifySetting) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifySetting.ProtoReflect.Descriptor instead.
+func (*NotifySetting) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *NotifySetting) GetDndEnabled() bool {
+	if x != nil {
+		return x.DndEnabled
+	}
+	return false
+}
+
+func (x *NotifySetting) GetDndStart() int32 {
+	if x != nil {
+		return x.DndStart
+	}
+	return 0
+}
+
+func (x *NotifySetting) GetDndEnd() int32 {
+	if x != nil {
+		return x.DndEnd
+	}
+	return 0
+}
+
+func (x *NotifySetting) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type GetNotifySettingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotifySettingRequest) Reset() {
+	*x = GetNotifySettingRequest{}
+	mi := &file_user_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotifySettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotifySettingRequest) ProtoMessage() {}
+
+func (x *GetNotifySettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotifySettingRequest.ProtoReflect.Descriptor instead.
+func (*GetNotifySettingRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{44}
+}
+
+type GetNotifySettingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Setting       *NotifySetting         `protobuf:"bytes,2,opt,name=setting,proto3" json:"setting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotifySettingResponse) Reset() {
+	*x = GetNotifySettingResponse{}
+	mi := &file_user_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotifySettingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotifySettingResponse) ProtoMessage() {}
+
+func (x *GetNotifySettingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotifySettingResponse.ProtoReflect.Descriptor instead.
+func (*GetNotifySettingResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetNotifySettingResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetNotifySettingResponse) GetSetting() *NotifySetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+type UpdateNotifySettingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Setting       *NotifySetting         `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotifySettingRequest) Reset() {
+	*x = UpdateNotifySettingRequest{}
+	mi := &file_user_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotifySettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotifySettingRequest) ProtoMessage() {}
+
+func (x *UpdateNotifySettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotifySettingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotifySettingRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdateNotifySettingRequest) GetSetting() *NotifySetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+type UpdateNotifySettingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Setting       *NotifySetting         `protobuf:"bytes,2,opt,name=setting,proto3" json:"setting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotifySettingResponse) Reset() {
+	*x = UpdateNotifySettingResponse{}
+	mi := &file_user_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotifySettingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotifySettingResponse) ProtoMessage() {}
+
+func (x *UpdateNotifySettingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotifySettingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotifySettingResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdateNotifySettingResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UpdateNotifySettingResponse) GetSetting() *NotifySetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+type PushDevice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	PushToken     string                 `protobuf:"bytes,3,opt,name=push_token,json=pushToken,proto3" json:"push_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushDevice) Reset() {
+	*x = PushDevice{}
+	mi := &file_user_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushDevice) ProtoMessage() {}
+
+func (x *PushDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushDevice.ProtoReflect.Descriptor instead.
+func (*PushDevice) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PushDevice) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *PushDevice) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *PushDevice) GetPushToken() string {
+	if x != nil {
+		return x.PushToken
+	}
+	return ""
+}
+
+type PushTarget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Devices       []*PushDevice          `protobuf:"bytes,2,rep,name=devices,proto3" json:"devices,omitempty"`
+	Setting       *NotifySetting         `protobuf:"bytes,3,opt,name=setting,proto3" json:"setting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushTarget) Reset() {
+	*x = PushTarget{}
+	mi := &file_user_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushTarget) ProtoMessage() {}
+
+func (x *PushTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushTarget.ProtoReflect.Descriptor instead.
+func (*PushTarget) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PushTarget) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PushTarget) GetDevices() []*PushDevice {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+func (x *PushTarget) GetSetting() *NotifySetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+type GetPushTargetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []int64                `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPushTargetsRequest) Reset() {
+	*x = GetPushTargetsRequest{}
+	mi := &file_user_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPushTargetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPushTargetsRequest) ProtoMessage() {}
+
+func (x *GetPushTargetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPushTargetsRequest.ProtoReflect.Descriptor instead.
+func (*GetPushTargetsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetPushTargetsRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type GetPushTargetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Targets       []*PushTarget          `protobuf:"bytes,2,rep,name=targets,proto3" json:"targets,omitempty"` // users without any push token are left out
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPushTargetsResponse) Reset() {
+	*x = GetPushTargetsResponse{}
+	mi := &file_user_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPushTargetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPushTargetsResponse) ProtoMessage() {}
+
+func (x *GetPushTargetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPushTargetsResponse.ProtoReflect.Descriptor instead.
+func (*GetPushTargetsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetPushTargetsResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetPushTargetsResponse) GetTargets() []*PushTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type Device struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Platform      DevicePlatform         `protobuf:"varint,2,opt,name=platform,proto3,enum=gochat.rpc.DevicePlatform" json:"platform,omitempty"`
+	HasPushToken  bool                   `protobuf:"varint,3,opt,name=has_push_token,json=hasPushToken,proto3" json:"has_push_token,omitempty"`
+	LastActiveAt  int64                  `protobuf:"varint,4,opt,name=last_active_at,json=lastActiveAt,proto3" json:"last_active_at,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Current       bool                   `protobuf:"varint,6,opt,name=current,proto3" json:"current,omitempty"` // the device the request was made from
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Device) Reset() {
+	*x = Device{}
+	mi := &file_user_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Device) ProtoMessage() {}
+
+func (x *Device) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Device.ProtoReflect.Descriptor instead.
+func (*Device) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Device) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *Device) GetPlatform() DevicePlatform {
+	if x != nil {
+		return x.Platform
+	}
+	return DevicePlatform_PLATFORM_UNSPECIFIED
+}
+
+func (x *Device) GetHasPushToken() bool {
+	if x != nil {
+		return x.HasPushToken
+	}
+	return false
+}
+
+func (x *Device) GetLastActiveAt() int64 {
+	if x != nil {
+		return x.LastActiveAt
+	}
+	return 0
+}
+
+func (x *Device) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Device) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+// user_id and device_id of the caller come from metadata
+type RegisterDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Platform      DevicePlatform         `protobuf:"varint,1,opt,name=platform,proto3,enum=gochat.rpc.DevicePlatform" json:"platform,omitempty"`
+	PushToken     string                 `protobuf:"bytes,2,opt,name=push_token,json=pushToken,proto3" json:"push_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterDeviceRequest) Reset() {
+	*x = RegisterDeviceRequest{}
+	mi := &file_user_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDeviceRequest) ProtoMessage() {}
+
+func (x *RegisterDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDeviceRequest.ProtoReflect.Descriptor instead.
+func (*RegisterDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RegisterDeviceRequest) GetPlatform() DevicePlatform {
+	if x != nil {
+		return x.Platform
+	}
+	return DevicePlatform_PLATFORM_UNSPECIFIED
+}
+
+func (x *RegisterDeviceRequest) GetPushToken() string {
+	if x != nil {
+		return x.PushToken
+	}
+	return ""
+}
+
+type RegisterDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Device        *Device                `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterDeviceResponse) Reset() {
+	*x = RegisterDeviceResponse{}
+	mi := &file_user_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDeviceResponse) ProtoMessage() {}
+
+func (x *RegisterDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDeviceResponse.ProtoReflect.Descriptor instead.
+func (*RegisterDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RegisterDeviceResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *RegisterDeviceResponse) GetDevice() *Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+type ListDevicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDevicesRequest) Reset() {
+	*x = ListDevicesRequest{}
+	mi := &file_user_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesRequest) ProtoMessage() {}
+
+func (x *ListDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesRequest.ProtoReflect.Descriptor instead.
+func (*ListDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{55}
+}
+
+type ListDevicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Devices       []*Device              `protobuf:"bytes,2,rep,name=devices,proto3" json:"devices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDevicesResponse) Reset() {
+	*x = ListDevicesResponse{}
+	mi := &file_user_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesResponse) ProtoMessage() {}
+
+func (x *ListDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesResponse.ProtoReflect.Descriptor instead.
+func (*ListDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListDevicesResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListDevicesResponse) GetDevices() []*Device {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type RemoveDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDeviceRequest) Reset() {
+	*x = RemoveDeviceRequest{}
+	mi := &file_user_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDeviceRequest) ProtoMessage() {}
+
+func (x *RemoveDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDeviceRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RemoveDeviceRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+type RemoveDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDeviceResponse) Reset() {
+	*x = RemoveDeviceResponse{}
+	mi := &file_user_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDeviceResponse) ProtoMessage() {}
+
+func (x *RemoveDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDeviceResponse.ProtoReflect.Descriptor instead.
+func (*RemoveDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RemoveDeviceResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type KickUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"` // empty kicks every device
+	Reason        KickReason             `protobuf:"varint,3,opt,name=reason,proto3,enum=gochat.rpc.KickReason" json:"reason,omitempty"`
+	Revoke        bool                   `protobuf:"varint,4,opt,name=revoke,proto3" json:"revoke,omitempty"` // also sign the kicked devices out
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickUserRequest) Reset() {
+	*x = KickUserRequest{}
+	mi := &file_user_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickUserRequest) ProtoMessage() {}
+
+func (x *KickUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickUserRequest.ProtoReflect.Descriptor instead.
+func (*KickUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *KickUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *KickUserRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *KickUserRequest) GetReason() KickReason {
+	if x != nil {
+		return x.Reason
+	}
+	return KickReason_KICK_REASON_UNSPECIFIED
+}
+
+func (x *KickUserRequest) GetRevoke() bool {
+	if x != nil {
+		return x.Revoke
+	}
+	return false
+}
+
+type KickUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickUserResponse) Reset() {
+	*x = KickUserResponse{}
+	mi := &file_user_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickUserResponse) ProtoMessage() {}
+
+func (x *KickUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickUserResponse.ProtoReflect.Descriptor instead.
+func (*KickUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *KickUserResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type SetUserStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`                           // 0 banned or 1 normal, the other statuses are up to the user
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                            // why the user is banned, told to them when signing in is refused
+	ExpiresAt     int64                  `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`    // unix seconds a temporary ban ends at, 0 bans for good
+	OperatorId    int64                  `protobuf:"varint,5,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // admin making the change
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserStatusRequest) Reset() {
+	*x = SetUserStatusRequest{}
+	mi := &file_user_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserStatusRequest) ProtoMessage() {}
+
+func (x *SetUserStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetUserStatusRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SetUserStatusRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetUserStatusRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SetUserStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SetUserStatusRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *SetUserStatusRequest) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+type SetUserStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserStatusResponse) Reset() {
+	*x = SetUserStatusResponse{}
+	mi := &file_user_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserStatusResponse) ProtoMessage() {}
+
+func (x *SetUserStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserStatusResponse.ProtoReflect.Descriptor instead.
+func (*SetUserStatusResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SetUserStatusResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type DevicePresence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	State         PresenceState          `protobuf:"varint,2,opt,name=state,proto3,enum=gochat.rpc.PresenceState" json:"state,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DevicePresence) Reset() {
+	*x = DevicePresence{}
+	mi := &file_user_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DevicePresence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DevicePresence) ProtoMessage() {}
+
+func (x *DevicePresence) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DevicePresence.ProtoReflect.Descriptor instead.
+func (*DevicePresence) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DevicePresence) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *DevicePresence) GetState() PresenceState {
+	if x != nil {
+		return x.State
+	}
+	return PresenceState_PRESENCE_STATE_OFFLINE
+}
+
+func (x *DevicePresence) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type Presence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	State         PresenceState          `protobuf:"varint,2,opt,name=state,proto3,enum=gochat.rpc.PresenceState" json:"state,omitempty"`
+	LastSeen      int64                  `protobuf:"varint,3,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"` // 0 when unknown or hidden by the user
+	Devices       []*DevicePresence      `protobuf:"bytes,4,rep,name=devices,proto3" json:"devices,omitempty"`                    // only for the caller's own presence
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Presence) Reset() {
+	*x = Presence{}
+	mi := &file_user_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Presence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Presence) ProtoMessage() {}
+
+func (x *Presence) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Presence.ProtoReflect.Descriptor instead.
+func (*Presence) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Presence) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Presence) GetState() PresenceState {
+	if x != nil {
+		return x.State
+	}
+	return PresenceState_PRESENCE_STATE_OFFLINE
+}
+
+func (x *Presence) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *Presence) GetDevices() []*DevicePresence {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type GetPresenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPresenceRequest) Reset() {
+	*x = GetPresenceRequest{}
+	mi := &file_user_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPresenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPresenceRequest) ProtoMessage() {}
+
+func (x *GetPresenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPresenceRequest.ProtoReflect.Descriptor instead.
+func (*GetPresenceRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetPresenceRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetPresenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Presence      *Presence              `protobuf:"bytes,2,opt,name=presence,proto3" json:"presence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPresenceResponse) Reset() {
+	*x = GetPresenceResponse{}
+	mi := &file_user_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPresenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPresenceResponse) ProtoMessage() {}
+
+func (x *GetPresenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPresenceResponse.ProtoReflect.Descriptor instead.
+func (*GetPresenceResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetPresenceResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetPresenceResponse) GetPresence() *Presence {
+	if x != nil {
+		return x.Presence
+	}
+	return nil
+}
+
+type BatchGetPresenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []int64                `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetPresenceRequest) Reset() {
+	*x = BatchGetPresenceRequest{}
+	mi := &file_user_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetPresenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetPresenceRequest) ProtoMessage() {}
+
+func (x *BatchGetPresenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetPresenceRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetPresenceRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *BatchGetPresenceRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type BatchGetPresenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Presences     []*Presence            `protobuf:"bytes,2,rep,name=presences,proto3" json:"presences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetPresenceResponse) Reset() {
+	*x = BatchGetPresenceResponse{}
+	mi := &file_user_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetPresenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetPresenceResponse) ProtoMessage() {}
+
+func (x *BatchGetPresenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetPresenceResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetPresenceResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *BatchGetPresenceResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *BatchGetPresenceResponse) GetPresences() []*Presence {
+	if x != nil {
+		return x.Presences
+	}
+	return nil
+}
+
+type PrivacySetting struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	SearchableByUsername bool                   `protobuf:"varint,2,opt,name=searchable_by_username,json=searchableByUsername,proto3" json:"searchable_by_username,omitempty"` // also covers the nickname
+	SearchableByPhone    bool                   `protobuf:"varint,3,opt,name=searchable_by_phone,json=searchableByPhone,proto3" json:"searchable_by_phone,omitempty"`
+	SearchableByEmail    bool                   `protobuf:"varint,4,opt,name=searchable_by_email,json=searchableByEmail,proto3" json:"searchable_by_email,omitempty"`
+	FriendRequest        FriendRequestPolicy    `protobuf:"varint,5,opt,name=friend_request,json=friendRequest,proto3,enum=gochat.rpc.FriendRequestPolicy" json:"friend_request,omitempty"`
+	FriendQuestion       string                 `protobuf:"bytes,6,opt,name=friend_question,json=friendQuestion,proto3" json:"friend_question,omitempty"`
+	FriendAnswer         string                 `protobuf:"bytes,7,opt,name=friend_answer,json=friendAnswer,proto3" json:"friend_answer,omitempty"` // write only: never returned, empty keeps the current answer
+	GroupInvite          Audience               `protobuf:"varint,8,opt,name=group_invite,json=groupInvite,proto3,enum=gochat.rpc.Audience" json:"group_invite,omitempty"`
+	Phone                Audience               `protobuf:"varint,9,opt,name=phone,proto3,enum=gochat.rpc.Audience" json:"phone,omitempty"`
+	Email                Audience               `protobuf:"varint,10,opt,name=email,proto3,enum=gochat.rpc.Audience" json:"email,omitempty"`
+	Gender               Audience               `protobuf:"varint,11,opt,name=gender,proto3,enum=gochat.rpc.Audience" json:"gender,omitempty"`
+	LastSeen             Audience               `protobuf:"varint,12,opt,name=last_seen,json=lastSeen,proto3,enum=gochat.rpc.Audience" json:"last_seen,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *PrivacySetting) Reset() {
+	*x = PrivacySetting{}
+	mi := &file_user_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivacySetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacySetting) ProtoMessage() {}
+
+func (x *PrivacySetting) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivacySetting.ProtoReflect.Descriptor instead.
+func (*PrivacySetting) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *PrivacySetting) GetSearchableByUsername() bool {
+	if x != nil {
+		return x.SearchableByUsername
+	}
+	return false
+}
+
+func (x *PrivacySetting) GetSearchableByPhone() bool {
+	if x != nil {
+		return x.SearchableByPhone
+	}
+	return false
+}
+
+func (x *PrivacySetting) GetSearchableByEmail() bool {
+	if x != nil {
+		return x.SearchableByEmail
+	}
+	return false
+}
+
+func (x *PrivacySetting) GetFriendRequest() FriendRequestPolicy {
+	if x != nil {
+		return x.FriendRequest
+	}
+	return FriendRequestPolicy_FRIEND_REQUEST_ANYONE
+}
+
+func (x *PrivacySetting) GetFriendQuestion() string {
+	if x != nil {
+		return x.FriendQuestion
+	}
+	return ""
+}
+
+func (x *PrivacySetting) GetFriendAnswer() string {
+	if x != nil {
+		return x.FriendAnswer
+	}
+	return ""
+}
+
+func (x *PrivacySetting) GetGroupInvite() Audience {
+	if x != nil {
+		return x.GroupInvite
+	}
+	return Audience_AUDIENCE_EVERYONE
+}
+
+func (x *PrivacySetting) GetPhone() Audience {
+	if x != nil {
+		return x.Phone
+	}
+	return Audience_AUDIENCE_EVERYONE
+}
+
+func (x *PrivacySetting) GetEmail() Audience {
+	if x != nil {
+		return x.Email
+	}
+	return Audience_AUDIENCE_EVERYONE
+}
+
+func (x *PrivacySetting) GetGender() Audience {
+	if x != nil {
+		return x.Gender
+	}
+	return Audience_AUDIENCE_EVERYONE
+}
+
+func (x *PrivacySetting) GetLastSeen() Audience {
+	if x != nil {
+		return x.LastSeen
+	}
+	return Audience_AUDIENCE_EVERYONE
+}
+
+type GetPrivacySettingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrivacySettingRequest) Reset() {
+	*x = GetPrivacySettingRequest{}
+	mi := &file_user_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrivacySettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivacySettingRequest) ProtoMessage() {}
+
+func (x *GetPrivacySettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivacySettingRequest.ProtoReflect.Descriptor instead.
+func (*GetPrivacySettingRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{70}
+}
+
+type GetPrivacySettingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Setting       *PrivacySetting        `protobuf:"bytes,2,opt,name=setting,proto3" json:"setting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrivacySettingResponse) Reset() {
+	*x = GetPrivacySettingResponse{}
+	mi := &file_user_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrivacySettingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivacySettingResponse) ProtoMessage() {}
+
+func (x *GetPrivacySettingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivacySettingResponse.ProtoReflect.Descriptor instead.
+func (*GetPrivacySettingResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetPrivacySettingResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetPrivacySettingResponse) GetSetting() *PrivacySetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+type UpdatePrivacySettingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Setting       *PrivacySetting        `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePrivacySettingRequest) Reset() {
+	*x = UpdatePrivacySettingRequest{}
+	mi := &file_user_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePrivacySettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePrivacySettingRequest) ProtoMessage() {}
+
+func (x *UpdatePrivacySettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePrivacySettingRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePrivacySettingRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *UpdatePrivacySettingRequest) GetSetting() *PrivacySetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+type UpdatePrivacySettingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Setting       *PrivacySetting        `protobuf:"bytes,2,opt,name=setting,proto3" json:"setting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePrivacySettingResponse) Reset() {
+	*x = UpdatePrivacySettingResponse{}
+	mi := &file_user_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePrivacySettingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePrivacySettingResponse) ProtoMessage() {}
+
+func (x *UpdatePrivacySettingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePrivacySettingResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePrivacySettingResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UpdatePrivacySettingResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UpdatePrivacySettingResponse) GetSetting() *PrivacySetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+type ContactPolicy struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FriendRequest  FriendRequestPolicy    `protobuf:"varint,2,opt,name=friend_request,json=friendRequest,proto3,enum=gochat.rpc.FriendRequestPolicy" json:"friend_request,omitempty"`
+	FriendQuestion string                 `protobuf:"bytes,3,opt,name=friend_question,json=friendQuestion,proto3" json:"friend_question,omitempty"`
+	GroupInvite    Audience               `protobuf:"varint,4,opt,name=group_invite,json=groupInvite,proto3,enum=gochat.rpc.Audience" json:"group_invite,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ContactPolicy) Reset() {
+	*x = ContactPolicy{}
+	mi := &file_user_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContactPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactPolicy) ProtoMessage() {}
+
+func (x *ContactPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactPolicy.ProtoReflect.Descriptor instead.
+func (*ContactPolicy) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ContactPolicy) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ContactPolicy) GetFriendRequest() FriendRequestPolicy {
+	if x != nil {
+		return x.FriendRequest
+	}
+	return FriendRequestPolicy_FRIEND_REQUEST_ANYONE
+}
+
+func (x *ContactPolicy) GetFriendQuestion() string {
+	if x != nil {
+		return x.FriendQuestion
+	}
+	return ""
+}
+
+func (x *ContactPolicy) GetGroupInvite() Audience {
+	if x != nil {
+		return x.GroupInvite
+	}
+	return Audience_AUDIENCE_EVERYONE
+}
+
+type GetContactPoliciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []int64                `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContactPoliciesRequest) Reset() {
+	*x = GetContactPoliciesRequest{}
+	mi := &file_user_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContactPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactPoliciesRequest) ProtoMessage() {}
+
+func (x *GetContactPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*GetContactPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetContactPoliciesRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+// One policy per requested user, in request order; users who never saved settings get the defaults
+type GetContactPoliciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Policies      []*ContactPolicy       `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContactPoliciesResponse) Reset() {
+	*x = GetContactPoliciesResponse{}
+	mi := &file_user_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContactPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactPoliciesResponse) ProtoMessage() {}
+
+func (x *GetContactPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*GetContactPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetContactPoliciesResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetContactPoliciesResponse) GetPolicies() []*ContactPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type CheckFriendAnswerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Answer        string                 `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckFriendAnswerRequest) Reset() {
+	*x = CheckFriendAnswerRequest{}
+	mi := &file_user_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckFriendAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckFriendAnswerRequest) ProtoMessage() {}
+
+func (x *CheckFriendAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckFriendAnswerRequest.ProtoReflect.Descriptor instead.
+func (*CheckFriendAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CheckFriendAnswerRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CheckFriendAnswerRequest) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+type CheckFriendAnswerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Correct       bool                   `protobuf:"varint,2,opt,name=correct,proto3" json:"correct,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckFriendAnswerResponse) Reset() {
+	*x = CheckFriendAnswerResponse{}
+	mi := &file_user_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckFriendAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckFriendAnswerResponse) ProtoMessage() {}
+
+func (x *CheckFriendAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckFriendAnswerResponse.ProtoReflect.Descriptor instead.
+func (*CheckFriendAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CheckFriendAnswerResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CheckFriendAnswerResponse) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+// user_id of the caller comes from metadata
+type DeactivateAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateAccountRequest) Reset() {
+	*x = DeactivateAccountRequest{}
+	mi := &file_user_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateAccountRequest) ProtoMessage() {}
+
+func (x *DeactivateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *GetUserRequest) GetUserId() int64 {
+func (x *DeactivateAccountRequest) GetPassword() string {
 	if x != nil {
-		return x.UserId
+		return x.Password
 	}
-	return 0
+	return ""
 }
 
-type GetUserResponse struct {
+type DeactivateAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserResponse) Reset() {
-	*x = GetUserResponse{}
-	mi := &file_user_proto_msgTypes[8]
+func (x *DeactivateAccountResponse) Reset() {
+	*x = DeactivateAccountResponse{}
+	mi := &file_user_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserResponse) String() string {
+func (x *DeactivateAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserResponse) ProtoMessage() {}
+func (*DeactivateAccountResponse) ProtoMessage() {}
 
-func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[8]
+func (x *DeactivateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -542,46 +4534,41 @@ func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
-func (*GetUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use DeactivateAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *GetUserResponse) GetBase() *BaseResponse {
+func (x *DeactivateAccountResponse) GetBase() *BaseResponse {
 	if x != nil {
 		return x.Base
 	}
 	return nil
 }
 
-func (x *GetUserResponse) GetUser() *User {
-	if x != nil {
-		return x.User
-	}
-	return nil
-}
-
-type GetCurrentUserRequest struct {
+// user_id of the caller comes from metadata
+type DeleteAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCurrentUserRequest) Reset() {
-	*x = GetCurrentUserRequest{}
-	mi := &file_user_proto_msgTypes[9]
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_user_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrentUserRequest) String() string {
+func (x *DeleteAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrentUserRequest) ProtoMessage() {}
+func (*DeleteAccountRequest) ProtoMessage() {}
 
-func (x *GetCurrentUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[9]
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -592,34 +4579,41 @@ func (x *GetCurrentUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrentUserRequest.ProtoReflect.Descriptor instead.
-func (*GetCurrentUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{81}
 }
 
-type GetCurrentUserResponse struct {
+func (x *DeleteAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type DeleteAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	DeleteAfter   int64                  `protobuf:"varint,2,opt,name=delete_after,json=deleteAfter,proto3" json:"delete_after,omitempty"` // unix seconds; until then ReactivateAccount cancels the deletion
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCurrentUserResponse) Reset() {
-	*x = GetCurrentUserResponse{}
-	mi := &file_user_proto_msgTypes[10]
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	mi := &file_user_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrentUserResponse) String() string {
+func (x *DeleteAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrentUserResponse) ProtoMessage() {}
+func (*DeleteAccountResponse) ProtoMessage() {}
 
-func (x *GetCurrentUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[10]
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -630,51 +4624,52 @@ func (x *GetCurrentUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrentUserResponse.ProtoReflect.Descriptor instead.
-func (*GetCurrentUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *GetCurrentUserResponse) GetBase() *BaseResponse {
+func (x *DeleteAccountResponse) GetBase() *BaseResponse {
 	if x != nil {
 		return x.Base
 	}
 	return nil
 }
 
-func (x *GetCurrentUserResponse) GetUser() *User {
+func (x *DeleteAccountResponse) GetDeleteAfter() int64 {
 	if x != nil {
-		return x.User
+		return x.DeleteAfter
 	}
-	return nil
+	return 0
 }
 
-type UpdateUserRequest struct {
+// Signed-out call, so the account is proven like at sign-in
+type ReactivateAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Nickname      string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Avatar        string                 `protobuf:"bytes,2,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
-	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	Gender        int32                  `protobuf:"varint,5,opt,name=gender,proto3" json:"gender,omitempty"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`                                     // TOTP code, when 2FA is on
+	RecoveryCode  string                 `protobuf:"bytes,4,opt,name=recovery_code,json=recoveryCode,proto3" json:"recovery_code,omitempty"` // instead of code
+	Ip            string                 `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserRequest) Reset() {
-	*x = UpdateUserRequest{}
-	mi := &file_user_proto_msgTypes[11]
+func (x *ReactivateAccountRequest) Reset() {
+	*x = ReactivateAccountRequest{}
+	mi := &file_user_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserRequest) String() string {
+func (x *ReactivateAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserRequest) ProtoMessage() {}
+func (*ReactivateAccountRequest) ProtoMessage() {}
 
-func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[11]
+func (x *ReactivateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -685,69 +4680,68 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ReactivateAccountRequest.ProtoReflect.Descriptor instead.
+func (*ReactivateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *UpdateUserRequest) GetNickname() string {
+func (x *ReactivateAccountRequest) GetUsername() string {
 	if x != nil {
-		return x.Nickname
+		return x.Username
 	}
 	return ""
 }
 
-func (x *UpdateUserRequest) GetAvatar() string {
+func (x *ReactivateAccountRequest) GetPassword() string {
 	if x != nil {
-		return x.Avatar
+		return x.Password
 	}
 	return ""
 }
 
-func (x *UpdateUserRequest) GetPhone() string {
+func (x *ReactivateAccountRequest) GetCode() string {
 	if x != nil {
-		return x.Phone
+		return x.Code
 	}
 	return ""
 }
 
-func (x *UpdateUserRequest) GetEmail() string {
+func (x *ReactivateAccountRequest) GetRecoveryCode() string {
 	if x != nil {
-		return x.Email
+		return x.RecoveryCode
 	}
 	return ""
 }
 
-func (x *UpdateUserRequest) GetGender() int32 {
+func (x *ReactivateAccountRequest) GetIp() string {
 	if x != nil {
-		return x.Gender
+		return x.Ip
 	}
-	return 0
+	return ""
 }
 
-type UpdateUserResponse struct {
+type ReactivateAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserResponse) Reset() {
-	*x = UpdateUserResponse{}
-	mi := &file_user_proto_msgTypes[12]
+func (x *ReactivateAccountResponse) Reset() {
+	*x = ReactivateAccountResponse{}
+	mi := &file_user_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserResponse) String() string {
+func (x *ReactivateAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserResponse) ProtoMessage() {}
+func (*ReactivateAccountResponse) ProtoMessage() {}
 
-func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[12]
+func (x *ReactivateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -758,48 +4752,132 @@ func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
-func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ReactivateAccountResponse.ProtoReflect.Descriptor instead.
+func (*ReactivateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *UpdateUserResponse) GetBase() *BaseResponse {
+func (x *ReactivateAccountResponse) GetBase() *BaseResponse {
 	if x != nil {
 		return x.Base
 	}
 	return nil
 }
 
-func (x *UpdateUserResponse) GetUser() *User {
+type AccountDeletion struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	State          string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"` // scheduled, cleaning, done or cancelled
+	DeleteAfter    int64                  `protobuf:"varint,3,opt,name=delete_after,json=deleteAfter,proto3" json:"delete_after,omitempty"`
+	CompletedSteps []string               `protobuf:"bytes,4,rep,name=completed_steps,json=completedSteps,proto3" json:"completed_steps,omitempty"`
+	PendingSteps   []string               `protobuf:"bytes,5,rep,name=pending_steps,json=pendingSteps,proto3" json:"pending_steps,omitempty"`
+	Attempts       int64                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"` // times the deletion was announced to the other services
+	NextAttemptAt  int64                  `protobuf:"varint,7,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AccountDeletion) Reset() {
+	*x = AccountDeletion{}
+	mi := &file_user_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountDeletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountDeletion) ProtoMessage() {}
+
+func (x *AccountDeletion) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[85]
 	if x != nil {
-		return x.User
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountDeletion.ProtoReflect.Descriptor instead.
+func (*AccountDeletion) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *AccountDeletion) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AccountDeletion) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *AccountDeletion) GetDeleteAfter() int64 {
+	if x != nil {
+		return x.DeleteAfter
+	}
+	return 0
+}
+
+func (x *AccountDeletion) GetCompletedSteps() []string {
+	if x != nil {
+		return x.CompletedSteps
 	}
 	return nil
 }
 
-type SearchUsersRequest struct {
+func (x *AccountDeletion) GetPendingSteps() []string {
+	if x != nil {
+		return x.PendingSteps
+	}
+	return nil
+}
+
+func (x *AccountDeletion) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *AccountDeletion) GetNextAttemptAt() int64 {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return 0
+}
+
+type GetAccountDeletionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchUsersRequest) Reset() {
-	*x = SearchUsersRequest{}
-	mi := &file_user_proto_msgTypes[13]
+func (x *GetAccountDeletionRequest) Reset() {
+	*x = GetAccountDeletionRequest{}
+	mi := &file_user_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchUsersRequest) String() string {
+func (x *GetAccountDeletionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUsersRequest) ProtoMessage() {}
+func (*GetAccountDeletionRequest) ProtoMessage() {}
 
-func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[13]
+func (x *GetAccountDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -810,48 +4888,41 @@ func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
-func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *SearchUsersRequest) GetKeyword() string {
-	if x != nil {
-		return x.Keyword
-	}
-	return ""
+// Deprecated: Use GetAccountDeletionRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *SearchUsersRequest) GetLimit() int32 {
+func (x *GetAccountDeletionRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Limit
+		return x.UserId
 	}
 	return 0
 }
 
-type SearchUsersResponse struct {
+type GetAccountDeletionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Users         []*User                `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	Deletion      *AccountDeletion       `protobuf:"bytes,2,opt,name=deletion,proto3" json:"deletion,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchUsersResponse) Reset() {
-	*x = SearchUsersResponse{}
-	mi := &file_user_proto_msgTypes[14]
+func (x *GetAccountDeletionResponse) Reset() {
+	*x = GetAccountDeletionResponse{}
+	mi := &file_user_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchUsersResponse) String() string {
+func (x *GetAccountDeletionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUsersResponse) ProtoMessage() {}
+func (*GetAccountDeletionResponse) ProtoMessage() {}
 
-func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[14]
+func (x *GetAccountDeletionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -862,47 +4933,49 @@ func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
-func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetAccountDeletionResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountDeletionResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *SearchUsersResponse) GetBase() *BaseResponse {
+func (x *GetAccountDeletionResponse) GetBase() *BaseResponse {
 	if x != nil {
 		return x.Base
 	}
 	return nil
 }
 
-func (x *SearchUsersResponse) GetUsers() []*User {
+func (x *GetAccountDeletionResponse) GetDeletion() *AccountDeletion {
 	if x != nil {
-		return x.Users
+		return x.Deletion
 	}
 	return nil
 }
 
-type GetUsersByIdsRequest struct {
+// Sent by a service once it removed the deleted user's data; repeating it is harmless
+type CompleteDeletionStepRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserIds       []int64                `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Step          string                 `protobuf:"bytes,2,opt,name=step,proto3" json:"step,omitempty"` // relation, group or message
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUsersByIdsRequest) Reset() {
-	*x = GetUsersByIdsRequest{}
-	mi := &file_user_proto_msgTypes[15]
+func (x *CompleteDeletionStepRequest) Reset() {
+	*x = CompleteDeletionStepRequest{}
+	mi := &file_user_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUsersByIdsRequest) String() string {
+func (x *CompleteDeletionStepRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUsersByIdsRequest) ProtoMessage() {}
+func (*CompleteDeletionStepRequest) ProtoMessage() {}
 
-func (x *GetUsersByIdsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[15]
+func (x *CompleteDeletionStepRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -913,41 +4986,47 @@ func (x *GetUsersByIdsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUsersByIdsRequest.ProtoReflect.Descriptor instead.
-func (*GetUsersByIdsRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use CompleteDeletionStepRequest.ProtoReflect.Descriptor instead.
+func (*CompleteDeletionStepRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *GetUsersByIdsRequest) GetUserIds() []int64 {
+func (x *CompleteDeletionStepRequest) GetUserId() int64 {
 	if x != nil {
-		return x.UserIds
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-type GetUsersByIdsResponse struct {
+func (x *CompleteDeletionStepRequest) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+type CompleteDeletionStepResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Users         []*User                `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUsersByIdsResponse) Reset() {
-	*x = GetUsersByIdsResponse{}
-	mi := &file_user_proto_msgTypes[16]
+func (x *CompleteDeletionStepResponse) Reset() {
+	*x = CompleteDeletionStepResponse{}
+	mi := &file_user_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUsersByIdsResponse) String() string {
+func (x *CompleteDeletionStepResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUsersByIdsResponse) ProtoMessage() {}
+func (*CompleteDeletionStepResponse) ProtoMessage() {}
 
-func (x *GetUsersByIdsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[16]
+func (x *CompleteDeletionStepResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -958,37 +5037,61 @@ func (x *GetUsersByIdsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUsersByIdsResponse.ProtoReflect.Descriptor instead.
-func (*GetUsersByIdsResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use CompleteDeletionStepResponse.ProtoReflect.Descriptor instead.
+func (*CompleteDeletionStepResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *GetUsersByIdsResponse) GetBase() *BaseResponse {
+func (x *CompleteDeletionStepResponse) GetBase() *BaseResponse {
 	if x != nil {
 		return x.Base
 	}
 	return nil
 }
 
-func (x *GetUsersByIdsResponse) GetUsers() []*User {
-	if x != nil {
-		return x.Users
-	}
-	return nil
-}
-
 var File_user_proto protoreflect.FileDescriptor
 
 const file_user_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
 	"user.proto\x12\n" +
-	"gochat.rpc\x1a\fcommon.proto\"V\n" +
+	"gochat.rpc\x1a\fcommon.proto\"S\n" +
 	"\x15ForgotPasswordRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\x12!\n" +
-	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"F\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannelJ\x04\b\x02\x10\x03\"F\n" +
 	"\x16ForgotPasswordResponse\x12,\n" +
-	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\"\xa3\x02\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\"i\n" +
+	"\x14ResetPasswordRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12!\n" +
+	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\"E\n" +
+	"\x15ResetPasswordResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\"7\n" +
+	"\x1bSendVerificationCodeRequest\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\"L\n" +
+	"\x1cSendVerificationCodeResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\"\xb7\x01\n" +
+	"\vLoginRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\x12\x1a\n" +
+	"\bplatform\x18\x03 \x01(\tR\bplatform\x12\x0e\n" +
+	"\x02ip\x18\x04 \x01(\tR\x02ip\x12\x18\n" +
+	"\asuccess\x18\x05 \x01(\bR\asuccess\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\"L\n" +
+	"\x17ListLoginHistoryRequest\x12\x1b\n" +
+	"\tbefore_id\x18\x01 \x01(\x03R\bbeforeId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"{\n" +
+	"\x18ListLoginHistoryResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x121\n" +
+	"\arecords\x18\x02 \x03(\v2\x17.gochat.rpc.LoginRecordR\arecords\"D\n" +
+	"\x14VerifyContactRequest\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"k\n" +
+	"\x15VerifyContactResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x12$\n" +
+	"\x04user\x18\x02 \x01(\v2\x10.gochat.rpc.UserR\x04user\"\x83\x04\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
@@ -1003,21 +5106,92 @@ const file_user_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\n" +
 	" \x01(\x03R\tupdatedAt\x12!\n" +
-	"\finfo_version\x18\v \x01(\x03R\vinfoVersion\"e\n" +
+	"\finfo_version\x18\v \x01(\x03R\vinfoVersion\x12%\n" +
+	"\x0eemail_verified\x18\f \x01(\bR\remailVerified\x12%\n" +
+	"\x0ephone_verified\x18\r \x01(\bR\rphoneVerified\x12\x1f\n" +
+	"\vmfa_enabled\x18\x0e \x01(\bR\n" +
+	"mfaEnabled\x12F\n" +
+	"\x0efriend_request\x18\x0f \x01(\x0e2\x1f.gochat.rpc.FriendRequestPolicyR\rfriendRequest\x12'\n" +
+	"\x0ffriend_question\x18\x10 \x01(\tR\x0efriendQuestion\"e\n" +
 	"\x0fRegisterRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1a\n" +
 	"\bnickname\x18\x03 \x01(\tR\bnickname\"f\n" +
 	"\x10RegisterResponse\x12,\n" +
 	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x12$\n" +
-	"\x04user\x18\x02 \x01(\v2\x10.gochat.rpc.UserR\x04user\"F\n" +
+	"\x04user\x18\x02 \x01(\v2\x10.gochat.rpc.UserR\x04user\"\xab\x01\n" +
 	"\fLoginRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"y\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1b\n" +
+	"\tdevice_id\x18\x03 \x01(\tR\bdeviceId\x126\n" +
+	"\bplatform\x18\x04 \x01(\x0e2\x1a.gochat.rpc.DevicePlatformR\bplatform\x12\x0e\n" +
+	"\x02ip\x18\x05 \x01(\tR\x02ip\"\xd2\x02\n" +
 	"\rLoginResponse\x12,\n" +
 	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x12\x14\n" +
 	"\x05token\x18\x02 \x01(\tR\x05token\x12$\n" +
-	"\x04user\x18\x03 \x01(\v2\x10.gochat.rpc.UserR\x04user\")\n" +
+	"\x04user\x18\x03 \x01(\v2\x10.gochat.rpc.UserR\x04user\x12\x1b\n" +
+	"\tdevice_id\x18\x04 \x01(\tR\bdeviceId\x12#\n" +
+	"\rrefresh_token\x18\x05 \x01(\tR\frefreshToken\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x06 \x01(\x03R\texpiresIn\x12!\n" +
+	"\fmfa_required\x18\a \x01(\bR\vmfaRequired\x12\x1b\n" +
+	"\tmfa_token\x18\b \x01(\tR\bmfaToken\x126\n" +
+	"\x17mfa_enrollment_required\x18\t \x01(\bR\x15mfaEnrollmentRequired\"h\n" +
+	"\x10VerifyMfaRequest\x12\x1b\n" +
+	"\tmfa_token\x18\x01 \x01(\tR\bmfaToken\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12#\n" +
+	"\rrecovery_code\x18\x03 \x01(\tR\frecoveryCode\"0\n" +
+	"\x11EnrollTotpRequest\x12\x1b\n" +
+	"\tmfa_token\x18\x01 \x01(\tR\bmfaToken\"{\n" +
+	"\x12EnrollTotpResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x1f\n" +
+	"\votpauth_uri\x18\x03 \x01(\tR\n" +
+	"otpauthUri\"E\n" +
+	"\x12ConfirmTotpRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1b\n" +
+	"\tmfa_token\x18\x02 \x01(\tR\bmfaToken\"\x9b\x01\n" +
+	"\x13ConfirmTotpResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x12%\n" +
+	"\x0erecovery_codes\x18\x02 \x03(\tR\rrecoveryCodes\x12/\n" +
+	"\x05login\x18\x03 \x01(\v2\x19.gochat.rpc.LoginResponseR\x05login\"i\n" +
+	"\x12DisableTotpRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12#\n" +
+	"\rrecovery_code\x18\x03 \x01(\tR\frecoveryCode\"C\n" +
+	"\x13DisableTotpResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\"E\n" +
+	"\fOidcProvider\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\"\x1a\n" +
+	"\x18ListOidcProvidersRequest\"\x81\x01\n" +
+	"\x19ListOidcProvidersResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x126\n" +
+	"\tproviders\x18\x02 \x03(\v2\x18.gochat.rpc.OidcProviderR\tproviders\"\x88\x01\n" +
+	"\x15StartOidcLoginRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\x126\n" +
+	"\bplatform\x18\x03 \x01(\x0e2\x1a.gochat.rpc.DevicePlatformR\bplatform\"s\n" +
+	"\x16StartOidcLoginResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x12+\n" +
+	"\x11authorization_url\x18\x02 \x01(\tR\x10authorizationUrl\"L\n" +
+	"\x10OidcLoginRequest\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\":\n" +
+	"\x13RefreshTokenRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"\x9e\x01\n" +
+	"\x14RefreshTokenResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x04 \x01(\x03R\texpiresIn\"Q\n" +
+	"\rLogoutRequest\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\">\n" +
+	"\x0eLogoutResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\")\n" +
 	"\x0eGetUserRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\"e\n" +
 	"\x0fGetUserResponse\x12,\n" +
@@ -1046,17 +5220,214 @@ const file_user_proto_rawDesc = "" +
 	"\buser_ids\x18\x01 \x03(\x03R\auserIds\"m\n" +
 	"\x15GetUsersByIdsResponse\x12,\n" +
 	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x12&\n" +
-	"\x05users\x18\x02 \x03(\v2\x10.gochat.rpc.UserR\x05users2\xfb\x04\n" +
+	"\x05users\x18\x02 \x03(\v2\x10.gochat.rpc.UserR\x05users\"\x82\x01\n" +
+	"\rNotifySetting\x12\x1f\n" +
+	"\vdnd_enabled\x18\x01 \x01(\bR\n" +
+	"dndEnabled\x12\x1b\n" +
+	"\tdnd_start\x18\x02 \x01(\x05R\bdndStart\x12\x17\n" +
+	"\adnd_end\x18\x03 \x01(\x05R\x06dndEnd\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\"\x19\n" +
+	"\x17GetNotifySettingRequest\"}\n" +
+	"\x18GetNotifySettingResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x123\n" +
+	"\asetting\x18\x02 \x01(\v2\x19.gochat.rpc.NotifySettingR\asetting\"Q\n" +
+	"\x1aUpdateNotifySettingRequest\x123\n" +
+	"\asetting\x18\x01 \x01(\v2\x19.gochat.rpc.NotifySettingR\asetting\"\x80\x01\n" +
+	"\x1bUpdateNotifySettingResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x123\n" +
+	"\asetting\x18\x02 \x01(\v2\x19.gochat.rpc.NotifySettingR\asetting\"d\n" +
+	"\n" +
+	"PushDevice\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\x12\x1a\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\x12\x1d\n" +
+	"\n" +
+	"push_token\x18\x03 \x01(\tR\tpushToken\"\x8c\x01\n" +
+	"\n" +
+	"PushTarget\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x120\n" +
+	"\adevices\x18\x02 \x03(\v2\x16.gochat.rpc.PushDeviceR\adevices\x123\n" +
+	"\asetting\x18\x03 \x01(\v2\x19.gochat.rpc.NotifySettingR\asetting\"2\n" +
+	"\x15GetPushTargetsRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x03R\auserIds\"x\n" +
+	"\x16GetPushTargetsResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x120\n" +
+	"\atargets\x18\x02 \x03(\v2\x16.gochat.rpc.PushTargetR\atargets\"\xe2\x01\n" +
+	"\x06Device\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\x126\n" +
+	"\bplatform\x18\x02 \x01(\x0e2\x1a.gochat.rpc.DevicePlatformR\bplatform\x12$\n" +
+	"\x0ehas_push_token\x18\x03 \x01(\bR\fhasPushToken\x12$\n" +
+	"\x0elast_active_at\x18\x04 \x01(\x03R\flastActiveAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x18\n" +
+	"\acurrent\x18\x06 \x01(\bR\acurrent\"n\n" +
+	"\x15RegisterDeviceRequest\x126\n" +
+	"\bplatform\x18\x01 \x01(\x0e2\x1a.gochat.rpc.DevicePlatformR\bplatform\x12\x1d\n" +
+	"\n" +
+	"push_token\x18\x02 \x01(\tR\tpushToken\"r\n" +
+	"\x16RegisterDeviceResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x12*\n" +
+	"\x06device\x18\x02 \x01(\v2\x12.gochat.rpc.DeviceR\x06device\"\x14\n" +
+	"\x12ListDevicesRequest\"q\n" +
+	"\x13ListDevicesResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x12,\n" +
+	"\adevices\x18\x02 \x03(\v2\x12.gochat.rpc.DeviceR\adevices\"2\n" +
+	"\x13RemoveDeviceRequest\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\"D\n" +
+	"\x14RemoveDeviceResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\"\x8f\x01\n" +
+	"\x0fKickUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\x12.\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x16.gochat.rpc.KickReasonR\x06reason\x12\x16\n" +
+	"\x06revoke\x18\x04 \x01(\bR\x06revoke\"@\n" +
+	"\x10KickUserResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\"\x9f\x01\n" +
+	"\x14SetUserStatusRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\x03R\texpiresAt\x12\x1f\n" +
+	"\voperator_id\x18\x05 \x01(\x03R\n" +
+	"operatorId\"E\n" +
+	"\x15SetUserStatusResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\"}\n" +
+	"\x0eDevicePresence\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\x12/\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x19.gochat.rpc.PresenceStateR\x05state\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\x03R\tupdatedAt\"\xa7\x01\n" +
+	"\bPresence\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12/\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x19.gochat.rpc.PresenceStateR\x05state\x12\x1b\n" +
+	"\tlast_seen\x18\x03 \x01(\x03R\blastSeen\x124\n" +
+	"\adevices\x18\x04 \x03(\v2\x1a.gochat.rpc.DevicePresenceR\adevices\"-\n" +
+	"\x12GetPresenceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"u\n" +
+	"\x13GetPresenceResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x120\n" +
+	"\bpresence\x18\x02 \x01(\v2\x14.gochat.rpc.PresenceR\bpresence\"4\n" +
+	"\x17BatchGetPresenceRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x03R\auserIds\"|\n" +
+	"\x18BatchGetPresenceResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x122\n" +
+	"\tpresences\x18\x02 \x03(\v2\x14.gochat.rpc.PresenceR\tpresences\"\xb4\x04\n" +
+	"\x0ePrivacySetting\x124\n" +
+	"\x16searchable_by_username\x18\x02 \x01(\bR\x14searchableByUsername\x12.\n" +
+	"\x13searchable_by_phone\x18\x03 \x01(\bR\x11searchableByPhone\x12.\n" +
+	"\x13searchable_by_email\x18\x04 \x01(\bR\x11searchableByEmail\x12F\n" +
+	"\x0efriend_request\x18\x05 \x01(\x0e2\x1f.gochat.rpc.FriendRequestPolicyR\rfriendRequest\x12'\n" +
+	"\x0ffriend_question\x18\x06 \x01(\tR\x0efriendQuestion\x12#\n" +
+	"\rfriend_answer\x18\a \x01(\tR\ffriendAnswer\x127\n" +
+	"\fgroup_invite\x18\b \x01(\x0e2\x14.gochat.rpc.AudienceR\vgroupInvite\x12*\n" +
+	"\x05phone\x18\t \x01(\x0e2\x14.gochat.rpc.AudienceR\x05phone\x12*\n" +
+	"\x05email\x18\n" +
+	" \x01(\x0e2\x14.gochat.rpc.AudienceR\x05email\x12,\n" +
+	"\x06gender\x18\v \x01(\x0e2\x14.gochat.rpc.AudienceR\x06gender\x121\n" +
+	"\tlast_seen\x18\f \x01(\x0e2\x14.gochat.rpc.AudienceR\blastSeenJ\x04\b\x01\x10\x02\"\x1a\n" +
+	"\x18GetPrivacySettingRequest\"\x7f\n" +
+	"\x19GetPrivacySettingResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x124\n" +
+	"\asetting\x18\x02 \x01(\v2\x1a.gochat.rpc.PrivacySettingR\asetting\"S\n" +
+	"\x1bUpdatePrivacySettingRequest\x124\n" +
+	"\asetting\x18\x01 \x01(\v2\x1a.gochat.rpc.PrivacySettingR\asetting\"\x82\x01\n" +
+	"\x1cUpdatePrivacySettingResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x124\n" +
+	"\asetting\x18\x02 \x01(\v2\x1a.gochat.rpc.PrivacySettingR\asetting\"\xd2\x01\n" +
+	"\rContactPolicy\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12F\n" +
+	"\x0efriend_request\x18\x02 \x01(\x0e2\x1f.gochat.rpc.FriendRequestPolicyR\rfriendRequest\x12'\n" +
+	"\x0ffriend_question\x18\x03 \x01(\tR\x0efriendQuestion\x127\n" +
+	"\fgroup_invite\x18\x04 \x01(\x0e2\x14.gochat.rpc.AudienceR\vgroupInvite\"6\n" +
+	"\x19GetContactPoliciesRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x03R\auserIds\"\x81\x01\n" +
+	"\x1aGetContactPoliciesResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x125\n" +
+	"\bpolicies\x18\x02 \x03(\v2\x19.gochat.rpc.ContactPolicyR\bpolicies\"K\n" +
+	"\x18CheckFriendAnswerRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06answer\x18\x02 \x01(\tR\x06answer\"c\n" +
+	"\x19CheckFriendAnswerResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x12\x18\n" +
+	"\acorrect\x18\x02 \x01(\bR\acorrect\"6\n" +
+	"\x18DeactivateAccountRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\"I\n" +
+	"\x19DeactivateAccountResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\"2\n" +
+	"\x14DeleteAccountRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\"h\n" +
+	"\x15DeleteAccountResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x12!\n" +
+	"\fdelete_after\x18\x02 \x01(\x03R\vdeleteAfter\"\x9b\x01\n" +
+	"\x18ReactivateAccountRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12#\n" +
+	"\rrecovery_code\x18\x04 \x01(\tR\frecoveryCode\x12\x0e\n" +
+	"\x02ip\x18\x05 \x01(\tR\x02ip\"I\n" +
+	"\x19ReactivateAccountResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\"\xf5\x01\n" +
+	"\x0fAccountDeletion\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12!\n" +
+	"\fdelete_after\x18\x03 \x01(\x03R\vdeleteAfter\x12'\n" +
+	"\x0fcompleted_steps\x18\x04 \x03(\tR\x0ecompletedSteps\x12#\n" +
+	"\rpending_steps\x18\x05 \x03(\tR\fpendingSteps\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x03R\battempts\x12&\n" +
+	"\x0fnext_attempt_at\x18\a \x01(\x03R\rnextAttemptAt\"4\n" +
+	"\x19GetAccountDeletionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\x83\x01\n" +
+	"\x1aGetAccountDeletionResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base\x127\n" +
+	"\bdeletion\x18\x02 \x01(\v2\x1b.gochat.rpc.AccountDeletionR\bdeletion\"J\n" +
+	"\x1bCompleteDeletionStepRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04step\x18\x02 \x01(\tR\x04step\"L\n" +
+	"\x1cCompleteDeletionStepResponse\x12,\n" +
+	"\x04base\x18\x01 \x01(\v2\x18.gochat.rpc.BaseResponseR\x04base2\x9f\x1b\n" +
 	"\vUserService\x12E\n" +
 	"\bRegister\x12\x1b.gochat.rpc.RegisterRequest\x1a\x1c.gochat.rpc.RegisterResponse\x12<\n" +
-	"\x05Login\x12\x18.gochat.rpc.LoginRequest\x1a\x19.gochat.rpc.LoginResponse\x12B\n" +
+	"\x05Login\x12\x18.gochat.rpc.LoginRequest\x1a\x19.gochat.rpc.LoginResponse\x12D\n" +
+	"\tVerifyMfa\x12\x1c.gochat.rpc.VerifyMfaRequest\x1a\x19.gochat.rpc.LoginResponse\x12`\n" +
+	"\x11ListOidcProviders\x12$.gochat.rpc.ListOidcProvidersRequest\x1a%.gochat.rpc.ListOidcProvidersResponse\x12W\n" +
+	"\x0eStartOidcLogin\x12!.gochat.rpc.StartOidcLoginRequest\x1a\".gochat.rpc.StartOidcLoginResponse\x12D\n" +
+	"\tOidcLogin\x12\x1c.gochat.rpc.OidcLoginRequest\x1a\x19.gochat.rpc.LoginResponse\x12Q\n" +
+	"\fRefreshToken\x12\x1f.gochat.rpc.RefreshTokenRequest\x1a .gochat.rpc.RefreshTokenResponse\x12?\n" +
+	"\x06Logout\x12\x19.gochat.rpc.LogoutRequest\x1a\x1a.gochat.rpc.LogoutResponse\x12B\n" +
 	"\aGetUser\x12\x1a.gochat.rpc.GetUserRequest\x1a\x1b.gochat.rpc.GetUserResponse\x12W\n" +
 	"\x0eGetCurrentUser\x12!.gochat.rpc.GetCurrentUserRequest\x1a\".gochat.rpc.GetCurrentUserResponse\x12K\n" +
 	"\n" +
 	"UpdateUser\x12\x1d.gochat.rpc.UpdateUserRequest\x1a\x1e.gochat.rpc.UpdateUserResponse\x12N\n" +
 	"\vSearchUsers\x12\x1e.gochat.rpc.SearchUsersRequest\x1a\x1f.gochat.rpc.SearchUsersResponse\x12T\n" +
 	"\rGetUsersByIds\x12 .gochat.rpc.GetUsersByIdsRequest\x1a!.gochat.rpc.GetUsersByIdsResponse\x12W\n" +
-	"\x0eForgotPassword\x12!.gochat.rpc.ForgotPasswordRequest\x1a\".gochat.rpc.ForgotPasswordResponseB\x06Z\x04./pbb\x06proto3"
+	"\x0eForgotPassword\x12!.gochat.rpc.ForgotPasswordRequest\x1a\".gochat.rpc.ForgotPasswordResponse\x12T\n" +
+	"\rResetPassword\x12 .gochat.rpc.ResetPasswordRequest\x1a!.gochat.rpc.ResetPasswordResponse\x12i\n" +
+	"\x14SendVerificationCode\x12'.gochat.rpc.SendVerificationCodeRequest\x1a(.gochat.rpc.SendVerificationCodeResponse\x12T\n" +
+	"\rVerifyContact\x12 .gochat.rpc.VerifyContactRequest\x1a!.gochat.rpc.VerifyContactResponse\x12]\n" +
+	"\x10ListLoginHistory\x12#.gochat.rpc.ListLoginHistoryRequest\x1a$.gochat.rpc.ListLoginHistoryResponse\x12K\n" +
+	"\n" +
+	"EnrollTotp\x12\x1d.gochat.rpc.EnrollTotpRequest\x1a\x1e.gochat.rpc.EnrollTotpResponse\x12N\n" +
+	"\vConfirmTotp\x12\x1e.gochat.rpc.ConfirmTotpRequest\x1a\x1f.gochat.rpc.ConfirmTotpResponse\x12N\n" +
+	"\vDisableTotp\x12\x1e.gochat.rpc.DisableTotpRequest\x1a\x1f.gochat.rpc.DisableTotpResponse\x12]\n" +
+	"\x10GetNotifySetting\x12#.gochat.rpc.GetNotifySettingRequest\x1a$.gochat.rpc.GetNotifySettingResponse\x12f\n" +
+	"\x13UpdateNotifySetting\x12&.gochat.rpc.UpdateNotifySettingRequest\x1a'.gochat.rpc.UpdateNotifySettingResponse\x12W\n" +
+	"\x0eGetPushTargets\x12!.gochat.rpc.GetPushTargetsRequest\x1a\".gochat.rpc.GetPushTargetsResponse\x12W\n" +
+	"\x0eRegisterDevice\x12!.gochat.rpc.RegisterDeviceRequest\x1a\".gochat.rpc.RegisterDeviceResponse\x12N\n" +
+	"\vListDevices\x12\x1e.gochat.rpc.ListDevicesRequest\x1a\x1f.gochat.rpc.ListDevicesResponse\x12Q\n" +
+	"\fRemoveDevice\x12\x1f.gochat.rpc.RemoveDeviceRequest\x1a .gochat.rpc.RemoveDeviceResponse\x12E\n" +
+	"\bKickUser\x12\x1b.gochat.rpc.KickUserRequest\x1a\x1c.gochat.rpc.KickUserResponse\x12T\n" +
+	"\rSetUserStatus\x12 .gochat.rpc.SetUserStatusRequest\x1a!.gochat.rpc.SetUserStatusResponse\x12N\n" +
+	"\vGetPresence\x12\x1e.gochat.rpc.GetPresenceRequest\x1a\x1f.gochat.rpc.GetPresenceResponse\x12]\n" +
+	"\x10BatchGetPresence\x12#.gochat.rpc.BatchGetPresenceRequest\x1a$.gochat.rpc.BatchGetPresenceResponse\x12`\n" +
+	"\x11GetPrivacySetting\x12$.gochat.rpc.GetPrivacySettingRequest\x1a%.gochat.rpc.GetPrivacySettingResponse\x12i\n" +
+	"\x14UpdatePrivacySetting\x12'.gochat.rpc.UpdatePrivacySettingRequest\x1a(.gochat.rpc.UpdatePrivacySettingResponse\x12c\n" +
+	"\x12GetContactPolicies\x12%.gochat.rpc.GetContactPoliciesRequest\x1a&.gochat.rpc.GetContactPoliciesResponse\x12`\n" +
+	"\x11CheckFriendAnswer\x12$.gochat.rpc.CheckFriendAnswerRequest\x1a%.gochat.rpc.CheckFriendAnswerResponse\x12`\n" +
+	"\x11DeactivateAccount\x12$.gochat.rpc.DeactivateAccountRequest\x1a%.gochat.rpc.DeactivateAccountResponse\x12T\n" +
+	"\rDeleteAccount\x12 .gochat.rpc.DeleteAccountRequest\x1a!.gochat.rpc.DeleteAccountResponse\x12`\n" +
+	"\x11ReactivateAccount\x12$.gochat.rpc.ReactivateAccountRequest\x1a%.gochat.rpc.ReactivateAccountResponse\x12c\n" +
+	"\x12GetAccountDeletion\x12%.gochat.rpc.GetAccountDeletionRequest\x1a&.gochat.rpc.GetAccountDeletionResponse\x12i\n" +
+	"\x14CompleteDeletionStep\x12'.gochat.rpc.CompleteDeletionStepRequest\x1a(.gochat.rpc.CompleteDeletionStepResponseB\x06Z\x04./pbb\x06proto3"
 
 var (
 	file_user_proto_rawDescOnce sync.Once
@@ -1070,64 +5441,272 @@ func file_user_proto_rawDescGZIP() []byte {
 	return file_user_proto_rawDescData
 }
 
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
 var file_user_proto_goTypes = []any{
-	(*ForgotPasswordRequest)(nil),  // 0: gochat.rpc.ForgotPasswordRequest
-	(*ForgotPasswordResponse)(nil), // 1: gochat.rpc.ForgotPasswordResponse
-	(*User)(nil),                   // 2: gochat.rpc.User
-	(*RegisterRequest)(nil),        // 3: gochat.rpc.RegisterRequest
-	(*RegisterResponse)(nil),       // 4: gochat.rpc.RegisterResponse
-	(*LoginRequest)(nil),           // 5: gochat.rpc.LoginRequest
-	(*LoginResponse)(nil),          // 6: gochat.rpc.LoginResponse
-	(*GetUserRequest)(nil),         // 7: gochat.rpc.GetUserRequest
-	(*GetUserResponse)(nil),        // 8: gochat.rpc.GetUserResponse
-	(*GetCurrentUserRequest)(nil),  // 9: gochat.rpc.GetCurrentUserRequest
-	(*GetCurrentUserResponse)(nil), // 10: gochat.rpc.GetCurrentUserResponse
-	(*UpdateUserRequest)(nil),      // 11: gochat.rpc.UpdateUserRequest
-	(*UpdateUserResponse)(nil),     // 12: gochat.rpc.UpdateUserResponse
-	(*SearchUsersRequest)(nil),     // 13: gochat.rpc.SearchUsersRequest
-	(*SearchUsersResponse)(nil),    // 14: gochat.rpc.SearchUsersResponse
-	(*GetUsersByIdsRequest)(nil),   // 15: gochat.rpc.GetUsersByIdsRequest
-	(*GetUsersByIdsResponse)(nil),  // 16: gochat.rpc.GetUsersByIdsResponse
-	(*BaseResponse)(nil),           // 17: gochat.rpc.BaseResponse
+	(*ForgotPasswordRequest)(nil),        // 0: gochat.rpc.ForgotPasswordRequest
+	(*ForgotPasswordResponse)(nil),       // 1: gochat.rpc.ForgotPasswordResponse
+	(*ResetPasswordRequest)(nil),         // 2: gochat.rpc.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),        // 3: gochat.rpc.ResetPasswordResponse
+	(*SendVerificationCodeRequest)(nil),  // 4: gochat.rpc.SendVerificationCodeRequest
+	(*SendVerificationCodeResponse)(nil), // 5: gochat.rpc.SendVerificationCodeResponse
+	(*LoginRecord)(nil),                  // 6: gochat.rpc.LoginRecord
+	(*ListLoginHistoryRequest)(nil),      // 7: gochat.rpc.ListLoginHistoryRequest
+	(*ListLoginHistoryResponse)(nil),     // 8: gochat.rpc.ListLoginHistoryResponse
+	(*VerifyContactRequest)(nil),         // 9: gochat.rpc.VerifyContactRequest
+	(*VerifyContactResponse)(nil),        // 10: gochat.rpc.VerifyContactResponse
+	(*User)(nil),                         // 11: gochat.rpc.User
+	(*RegisterRequest)(nil),              // 12: gochat.rpc.RegisterRequest
+	(*RegisterResponse)(nil),             // 13: gochat.rpc.RegisterResponse
+	(*LoginRequest)(nil),                 // 14: gochat.rpc.LoginRequest
+	(*LoginResponse)(nil),                // 15: gochat.rpc.LoginResponse
+	(*VerifyMfaRequest)(nil),             // 16: gochat.rpc.VerifyMfaRequest
+	(*EnrollTotpRequest)(nil),            // 17: gochat.rpc.EnrollTotpRequest
+	(*EnrollTotpResponse)(nil),           // 18: gochat.rpc.EnrollTotpResponse
+	(*ConfirmTotpRequest)(nil),           // 19: gochat.rpc.ConfirmTotpRequest
+	(*ConfirmTotpResponse)(nil),          // 20: gochat.rpc.ConfirmTotpResponse
+	(*DisableTotpRequest)(nil),           // 21: gochat.rpc.DisableTotpRequest
+	(*DisableTotpResponse)(nil),          // 22: gochat.rpc.DisableTotpResponse
+	(*OidcProvider)(nil),                 // 23: gochat.rpc.OidcProvider
+	(*ListOidcProvidersRequest)(nil),     // 24: gochat.rpc.ListOidcProvidersRequest
+	(*ListOidcProvidersResponse)(nil),    // 25: gochat.rpc.ListOidcProvidersResponse
+	(*StartOidcLoginRequest)(nil),        // 26: gochat.rpc.StartOidcLoginRequest
+	(*StartOidcLoginResponse)(nil),       // 27: gochat.rpc.StartOidcLoginResponse
+	(*OidcLoginRequest)(nil),             // 28: gochat.rpc.OidcLoginRequest
+	(*RefreshTokenRequest)(nil),          // 29: gochat.rpc.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),         // 30: gochat.rpc.RefreshTokenResponse
+	(*LogoutRequest)(nil),                // 31: gochat.rpc.LogoutRequest
+	(*LogoutResponse)(nil),               // 32: gochat.rpc.LogoutResponse
+	(*GetUserRequest)(nil),               // 33: gochat.rpc.GetUserRequest
+	(*GetUserResponse)(nil),              // 34: gochat.rpc.GetUserResponse
+	(*GetCurrentUserRequest)(nil),        // 35: gochat.rpc.GetCurrentUserRequest
+	(*GetCurrentUserResponse)(nil),       // 36: gochat.rpc.GetCurrentUserResponse
+	(*UpdateUserRequest)(nil),            // 37: gochat.rpc.UpdateUserRequest
+	(*UpdateUserResponse)(nil),           // 38: gochat.rpc.UpdateUserResponse
+	(*SearchUsersRequest)(nil),           // 39: gochat.rpc.SearchUsersRequest
+	(*SearchUsersResponse)(nil),          // 40: gochat.rpc.SearchUsersResponse
+	(*GetUsersByIdsRequest)(nil),         // 41: gochat.rpc.GetUsersByIdsRequest
+	(*GetUsersByIdsResponse)(nil),        // 42: gochat.rpc.GetUsersByIdsResponse
+	(*NotifySetting)(nil),                // 43: gochat.rpc.NotifySetting
+	(*GetNotifySettingRequest)(nil),      // 44: gochat.rpc.GetNotifySettingRequest
+	(*GetNotifySettingResponse)(nil),     // 45: gochat.rpc.GetNotifySettingResponse
+	(*UpdateNotifySettingRequest)(nil),   // 46: gochat.rpc.UpdateNotifySettingRequest
+	(*UpdateNotifySettingResponse)(nil),  // 47: gochat.rpc.UpdateNotifySettingResponse
+	(*PushDevice)(nil),                   // 48: gochat.rpc.PushDevice
+	(*PushTarget)(nil),                   // 49: gochat.rpc.PushTarget
+	(*GetPushTargetsRequest)(nil),        // 50: gochat.rpc.GetPushTargetsRequest
+	(*GetPushTargetsResponse)(nil),       // 51: gochat.rpc.GetPushTargetsResponse
+	(*Device)(nil),                       // 52: gochat.rpc.Device
+	(*RegisterDeviceRequest)(nil),        // 53: gochat.rpc.RegisterDeviceRequest
+	(*RegisterDeviceResponse)(nil),       // 54: gochat.rpc.RegisterDeviceResponse
+	(*ListDevicesRequest)(nil),           // 55: gochat.rpc.ListDevicesRequest
+	(*ListDevicesResponse)(nil),          // 56: gochat.rpc.ListDevicesResponse
+	(*RemoveDeviceRequest)(nil),          // 57: gochat.rpc.RemoveDeviceRequest
+	(*RemoveDeviceResponse)(nil),         // 58: gochat.rpc.RemoveDeviceResponse
+	(*KickUserRequest)(nil),              // 59: gochat.rpc.KickUserRequest
+	(*KickUserResponse)(nil),             // 60: gochat.rpc.KickUserResponse
+	(*SetUserStatusRequest)(nil),         // 61: gochat.rpc.SetUserStatusRequest
+	(*SetUserStatusResponse)(nil),        // 62: gochat.rpc.SetUserStatusResponse
+	(*DevicePresence)(nil),               // 63: gochat.rpc.DevicePresence
+	(*Presence)(nil),                     // 64: gochat.rpc.Presence
+	(*GetPresenceRequest)(nil),           // 65: gochat.rpc.GetPresenceRequest
+	(*GetPresenceResponse)(nil),          // 66: gochat.rpc.GetPresenceResponse
+	(*BatchGetPresenceRequest)(nil),      // 67: gochat.rpc.BatchGetPresenceRequest
+	(*BatchGetPresenceResponse)(nil),     // 68: gochat.rpc.BatchGetPresenceResponse
+	(*PrivacySetting)(nil),               // 69: gochat.rpc.PrivacySetting
+	(*GetPrivacySettingRequest)(nil),     // 70: gochat.rpc.GetPrivacySettingRequest
+	(*GetPrivacySettingResponse)(nil),    // 71: gochat.rpc.GetPrivacySettingResponse
+	(*UpdatePrivacySettingRequest)(nil),  // 72: gochat.rpc.UpdatePrivacySettingRequest
+	(*UpdatePrivacySettingResponse)(nil), // 73: gochat.rpc.UpdatePrivacySettingResponse
+	(*ContactPolicy)(nil),                // 74: gochat.rpc.ContactPolicy
+	(*GetContactPoliciesRequest)(nil),    // 75: gochat.rpc.GetContactPoliciesRequest
+	(*GetContactPoliciesResponse)(nil),   // 76: gochat.rpc.GetContactPoliciesResponse
+	(*CheckFriendAnswerRequest)(nil),     // 77: gochat.rpc.CheckFriendAnswerRequest
+	(*CheckFriendAnswerResponse)(nil),    // 78: gochat.rpc.CheckFriendAnswerResponse
+	(*DeactivateAccountRequest)(nil),     // 79: gochat.rpc.DeactivateAccountRequest
+	(*DeactivateAccountResponse)(nil),    // 80: gochat.rpc.DeactivateAccountResponse
+	(*DeleteAccountRequest)(nil),         // 81: gochat.rpc.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),        // 82: gochat.rpc.DeleteAccountResponse
+	(*ReactivateAccountRequest)(nil),     // 83: gochat.rpc.ReactivateAccountRequest
+	(*ReactivateAccountResponse)(nil),    // 84: gochat.rpc.ReactivateAccountResponse
+	(*AccountDeletion)(nil),              // 85: gochat.rpc.AccountDeletion
+	(*GetAccountDeletionRequest)(nil),    // 86: gochat.rpc.GetAccountDeletionRequest
+	(*GetAccountDeletionResponse)(nil),   // 87: gochat.rpc.GetAccountDeletionResponse
+	(*CompleteDeletionStepRequest)(nil),  // 88: gochat.rpc.CompleteDeletionStepRequest
+	(*CompleteDeletionStepResponse)(nil), // 89: gochat.rpc.CompleteDeletionStepResponse
+	(*BaseResponse)(nil),                 // 90: gochat.rpc.BaseResponse
+	(FriendRequestPolicy)(0),             // 91: gochat.rpc.FriendRequestPolicy
+	(DevicePlatform)(0),                  // 92: gochat.rpc.DevicePlatform
+	(KickReason)(0),                      // 93: gochat.rpc.KickReason
+	(PresenceState)(0),                   // 94: gochat.rpc.PresenceState
+	(Audience)(0),                        // 95: gochat.rpc.Audience
 }
 var file_user_proto_depIdxs = []int32{
-	17, // 0: gochat.rpc.ForgotPasswordResponse.base:type_name -> gochat.rpc.BaseResponse
-	17, // 1: gochat.rpc.RegisterResponse.base:type_name -> gochat.rpc.BaseResponse
-	2,  // 2: gochat.rpc.RegisterResponse.user:type_name -> gochat.rpc.User
-	17, // 3: gochat.rpc.LoginResponse.base:type_name -> gochat.rpc.BaseResponse
-	2,  // 4: gochat.rpc.LoginResponse.user:type_name -> gochat.rpc.User
-	17, // 5: gochat.rpc.GetUserResponse.base:type_name -> gochat.rpc.BaseResponse
-	2,  // 6: gochat.rpc.GetUserResponse.user:type_name -> gochat.rpc.User
-	17, // 7: gochat.rpc.GetCurrentUserResponse.base:type_name -> gochat.rpc.BaseResponse
-	2,  // 8: gochat.rpc.GetCurrentUserResponse.user:type_name -> gochat.rpc.User
-	17, // 9: gochat.rpc.UpdateUserResponse.base:type_name -> gochat.rpc.BaseResponse
-	2,  // 10: gochat.rpc.UpdateUserResponse.user:type_name -> gochat.rpc.User
-	17, // 11: gochat.rpc.SearchUsersResponse.base:type_name -> gochat.rpc.BaseResponse
-	2,  // 12: gochat.rpc.SearchUsersResponse.users:type_name -> gochat.rpc.User
-	17, // 13: gochat.rpc.GetUsersByIdsResponse.base:type_name -> gochat.rpc.BaseResponse
-	2,  // 14: gochat.rpc.GetUsersByIdsResponse.users:type_name -> gochat.rpc.User
-	3,  // 15: gochat.rpc.UserService.Register:input_type -> gochat.rpc.RegisterRequest
-	5,  // 16: gochat.rpc.UserService.Login:input_type -> gochat.rpc.LoginRequest
-	7,  // 17: gochat.rpc.UserService.GetUser:input_type -> gochat.rpc.GetUserRequest
-	9,  // 18: gochat.rpc.UserService.GetCurrentUser:input_type -> gochat.rpc.GetCurrentUserRequest
-	11, // 19: gochat.rpc.UserService.UpdateUser:input_type -> gochat.rpc.UpdateUserRequest
-	13, // 20: gochat.rpc.UserService.SearchUsers:input_type -> gochat.rpc.SearchUsersRequest
-	15, // 21: gochat.rpc.UserService.GetUsersByIds:input_type -> gochat.rpc.GetUsersByIdsRequest
-	0,  // 22: gochat.rpc.UserService.ForgotPassword:input_type -> gochat.rpc.ForgotPasswordRequest
-	4,  // 23: gochat.rpc.UserService.Register:output_type -> gochat.rpc.RegisterResponse
-	6,  // 24: gochat.rpc.UserService.Login:output_type -> gochat.rpc.LoginResponse
-	8,  // 25: gochat.rpc.UserService.GetUser:output_type -> gochat.rpc.GetUserResponse
-	10, // 26: gochat.rpc.UserService.GetCurrentUser:output_type -> gochat.rpc.GetCurrentUserResponse
-	12, // 27: gochat.rpc.UserService.UpdateUser:output_type -> gochat.rpc.UpdateUserResponse
-	14, // 28: gochat.rpc.UserService.SearchUsers:output_type -> gochat.rpc.SearchUsersResponse
-	16, // 29: gochat.rpc.UserService.GetUsersByIds:output_type -> gochat.rpc.GetUsersByIdsResponse
-	1,  // 30: gochat.rpc.UserService.ForgotPassword:output_type -> gochat.rpc.ForgotPasswordResponse
-	23, // [23:31] is the sub-list for method output_type
-	15, // [15:23] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	90,  // 0: gochat.rpc.ForgotPasswordResponse.base:type_name -> gochat.rpc.BaseResponse
+	90,  // 1: gochat.rpc.ResetPasswordResponse.base:type_name -> gochat.rpc.BaseResponse
+	90,  // 2: gochat.rpc.SendVerificationCodeResponse.base:type_name -> gochat.rpc.BaseResponse
+	90,  // 3: gochat.rpc.ListLoginHistoryResponse.base:type_name -> gochat.rpc.BaseResponse
+	6,   // 4: gochat.rpc.ListLoginHistoryResponse.records:type_name -> gochat.rpc.LoginRecord
+	90,  // 5: gochat.rpc.VerifyContactResponse.base:type_name -> gochat.rpc.BaseResponse
+	11,  // 6: gochat.rpc.VerifyContactResponse.user:type_name -> gochat.rpc.User
+	91,  // 7: gochat.rpc.User.friend_request:type_name -> gochat.rpc.FriendRequestPolicy
+	90,  // 8: gochat.rpc.RegisterResponse.base:type_name -> gochat.rpc.BaseResponse
+	11,  // 9: gochat.rpc.RegisterResponse.user:type_name -> gochat.rpc.User
+	92,  // 10: gochat.rpc.LoginRequest.platform:type_name -> gochat.rpc.DevicePlatform
+	90,  // 11: gochat.rpc.LoginResponse.base:type_name -> gochat.rpc.BaseResponse
+	11,  // 12: gochat.rpc.LoginResponse.user:type_name -> gochat.rpc.User
+	90,  // 13: gochat.rpc.EnrollTotpResponse.base:type_name -> gochat.rpc.BaseResponse
+	90,  // 14: gochat.rpc.ConfirmTotpResponse.base:type_name -> gochat.rpc.BaseResponse
+	15,  // 15: gochat.rpc.ConfirmTotpResponse.login:type_name -> gochat.rpc.LoginResponse
+	90,  // 16: gochat.rpc.DisableTotpResponse.base:type_name -> gochat.rpc.BaseResponse
+	90,  // 17: gochat.rpc.ListOidcProvidersResponse.base:type_name -> gochat.rpc.BaseResponse
+	23,  // 18: gochat.rpc.ListOidcProvidersResponse.providers:type_name -> gochat.rpc.OidcProvider
+	92,  // 19: gochat.rpc.StartOidcLoginRequest.platform:type_name -> gochat.rpc.DevicePlatform
+	90,  // 20: gochat.rpc.StartOidcLoginResponse.base:type_name -> gochat.rpc.BaseResponse
+	90,  // 21: gochat.rpc.RefreshTokenResponse.base:type_name -> gochat.rpc.BaseResponse
+	90,  // 22: gochat.rpc.LogoutResponse.base:type_name -> gochat.rpc.BaseResponse
+	90,  // 23: gochat.rpc.GetUserResponse.base:type_name -> gochat.rpc.BaseResponse
+	11,  // 24: gochat.rpc.GetUserResponse.user:type_name -> gochat.rpc.User
+	90,  // 25: gochat.rpc.GetCurrentUserResponse.base:type_name -> gochat.rpc.BaseResponse
+	11,  // 26: gochat.rpc.GetCurrentUserResponse.user:type_name -> gochat.rpc.User
+	90,  // 27: gochat.rpc.UpdateUserResponse.base:type_name -> gochat.rpc.BaseResponse
+	11,  // 28: gochat.rpc.UpdateUserResponse.user:type_name -> gochat.rpc.User
+	90,  // 29: gochat.rpc.SearchUsersResponse.base:type_name -> gochat.rpc.BaseResponse
+	11,  // 30: gochat.rpc.SearchUsersResponse.users:type_name -> gochat.rpc.User
+	90,  // 31: gochat.rpc.GetUsersByIdsResponse.base:type_name -> gochat.rpc.BaseResponse
+	11,  // 32: gochat.rpc.GetUsersByIdsResponse.users:type_name -> gochat.rpc.User
+	90,  // 33: gochat.rpc.GetNotifySettingResponse.base:type_name -> gochat.rpc.BaseResponse
+	43,  // 34: gochat.rpc.GetNotifySettingResponse.setting:type_name -> gochat.rpc.NotifySetting
+	43,  // 35: gochat.rpc.UpdateNotifySettingRequest.setting:type_name -> gochat.rpc.NotifySetting
+	90,  // 36: gochat.rpc.UpdateNotifySettingResponse.base:type_name -> gochat.rpc.BaseResponse
+	43,  // 37: gochat.rpc.UpdateNotifySettingResponse.setting:type_name -> gochat.rpc.NotifySetting
+	48,  // 38: gochat.rpc.PushTarget.devices:type_name -> gochat.rpc.PushDevice
+	43,  // 39: gochat.rpc.PushTarget.setting:type_name -> gochat.rpc.NotifySetting
+	90,  // 40: gochat.rpc.GetPushTargetsResponse.base:type_name -> gochat.rpc.BaseResponse
+	49,  // 41: gochat.rpc.GetPushTargetsResponse.targets:type_name -> gochat.rpc.PushTarget
+	92,  // 42: gochat.rpc.Device.platform:type_name -> gochat.rpc.DevicePlatform
+	92,  // 43: gochat.rpc.RegisterDeviceRequest.platform:type_name -> gochat.rpc.DevicePlatform
+	90,  // 44: gochat.rpc.RegisterDeviceResponse.base:type_name -> gochat.rpc.BaseResponse
+	52,  // 45: gochat.rpc.RegisterDeviceResponse.device:type_name -> gochat.rpc.Device
+	90,  // 46: gochat.rpc.ListDevicesResponse.base:type_name -> gochat.rpc.BaseResponse
+	52,  // 47: gochat.rpc.ListDevicesResponse.devices:type_name -> gochat.rpc.Device
+	90,  // 48: gochat.rpc.RemoveDeviceResponse.base:type_name -> gochat.rpc.BaseResponse
+	93,  // 49: gochat.rpc.KickUserRequest.reason:type_name -> gochat.rpc.KickReason
+	90,  // 50: gochat.rpc.KickUserResponse.base:type_name -> gochat.rpc.BaseResponse
+	90,  // 51: gochat.rpc.SetUserStatusResponse.base:type_name -> gochat.rpc.BaseResponse
+	94,  // 52: gochat.rpc.DevicePresence.state:type_name -> gochat.rpc.PresenceState
+	94,  // 53: gochat.rpc.Presence.state:type_name -> gochat.rpc.PresenceState
+	63,  // 54: gochat.rpc.Presence.devices:type_name -> gochat.rpc.DevicePresence
+	90,  // 55: gochat.rpc.GetPresenceResponse.base:type_name -> gochat.rpc.BaseResponse
+	64,  // 56: gochat.rpc.GetPresenceResponse.presence:type_name -> gochat.rpc.Presence
+	90,  // 57: gochat.rpc.BatchGetPresenceResponse.base:type_name -> gochat.rpc.BaseResponse
+	64,  // 58: gochat.rpc.BatchGetPresenceResponse.presences:type_name -> gochat.rpc.Presence
+	91,  // 59: gochat.rpc.PrivacySetting.friend_request:type_name -> gochat.rpc.FriendRequestPolicy
+	95,  // 60: gochat.rpc.PrivacySetting.group_invite:type_name -> gochat.rpc.Audience
+	95,  // 61: gochat.rpc.PrivacySetting.phone:type_name -> gochat.rpc.Audience
+	95,  // 62: gochat.rpc.PrivacySetting.email:type_name -> gochat.rpc.Audience
+	95,  // 63: gochat.rpc.PrivacySetting.gender:type_name -> gochat.rpc.Audience
+	95,  // 64: gochat.rpc.PrivacySetting.last_seen:type_name -> gochat.rpc.Audience
+	90,  // 65: gochat.rpc.GetPrivacySettingResponse.base:type_name -> gochat.rpc.BaseResponse
+	69,  // 66: gochat.rpc.GetPrivacySettingResponse.setting:type_name -> gochat.rpc.PrivacySetting
+	69,  // 67: gochat.rpc.UpdatePrivacySettingRequest.setting:type_name -> gochat.rpc.PrivacySetting
+	90,  // 68: gochat.rpc.UpdatePrivacySettingResponse.base:type_name -> gochat.rpc.BaseResponse
+	69,  // 69: gochat.rpc.UpdatePrivacySettingResponse.setting:type_name -> gochat.rpc.PrivacySetting
+	91,  // 70: gochat.rpc.ContactPolicy.friend_request:type_name -> gochat.rpc.FriendRequestPolicy
+	95,  // 71: gochat.rpc.ContactPolicy.group_invite:type_name -> gochat.rpc.Audience
+	90,  // 72: gochat.rpc.GetContactPoliciesResponse.base:type_name -> gochat.rpc.BaseResponse
+	74,  // 73: gochat.rpc.GetContactPoliciesResponse.policies:type_name -> gochat.rpc.ContactPolicy
+	90,  // 74: gochat.rpc.CheckFriendAnswerResponse.base:type_name -> gochat.rpc.BaseResponse
+	90,  // 75: gochat.rpc.DeactivateAccountResponse.base:type_name -> gochat.rpc.BaseResponse
+	90,  // 76: gochat.rpc.DeleteAccountResponse.base:type_name -> gochat.rpc.BaseResponse
+	90,  // 77: gochat.rpc.ReactivateAccountResponse.base:type_name -> gochat.rpc.BaseResponse
+	90,  // 78: gochat.rpc.GetAccountDeletionResponse.base:type_name -> gochat.rpc.BaseResponse
+	85,  // 79: gochat.rpc.GetAccountDeletionResponse.deletion:type_name -> gochat.rpc.AccountDeletion
+	90,  // 80: gochat.rpc.CompleteDeletionStepResponse.base:type_name -> gochat.rpc.BaseResponse
+	12,  // 81: gochat.rpc.UserService.Register:input_type -> gochat.rpc.RegisterRequest
+	14,  // 82: gochat.rpc.UserService.Login:input_type -> gochat.rpc.LoginRequest
+	16,  // 83: gochat.rpc.UserService.VerifyMfa:input_type -> gochat.rpc.VerifyMfaRequest
+	24,  // 84: gochat.rpc.UserService.ListOidcProviders:input_type -> gochat.rpc.ListOidcProvidersRequest
+	26,  // 85: gochat.rpc.UserService.StartOidcLogin:input_type -> gochat.rpc.StartOidcLoginRequest
+	28,  // 86: gochat.rpc.UserService.OidcLogin:input_type -> gochat.rpc.OidcLoginRequest
+	29,  // 87: gochat.rpc.UserService.RefreshToken:input_type -> gochat.rpc.RefreshTokenRequest
+	31,  // 88: gochat.rpc.UserService.Logout:input_type -> gochat.rpc.LogoutRequest
+	33,  // 89: gochat.rpc.UserService.GetUser:input_type -> gochat.rpc.GetUserRequest
+	35,  // 90: gochat.rpc.UserService.GetCurrentUser:input_type -> gochat.rpc.GetCurrentUserRequest
+	37,  // 91: gochat.rpc.UserService.UpdateUser:input_type -> gochat.rpc.UpdateUserRequest
+	39,  // 92: gochat.rpc.UserService.SearchUsers:input_type -> gochat.rpc.SearchUsersRequest
+	41,  // 93: gochat.rpc.UserService.GetUsersByIds:input_type -> gochat.rpc.GetUsersByIdsRequest
+	0,   // 94: gochat.rpc.UserService.ForgotPassword:input_type -> gochat.rpc.ForgotPasswordRequest
+	2,   // 95: gochat.rpc.UserService.ResetPassword:input_type -> gochat.rpc.ResetPasswordRequest
+	4,   // 96: gochat.rpc.UserService.SendVerificationCode:input_type -> gochat.rpc.SendVerificationCodeRequest
+	9,   // 97: gochat.rpc.UserService.VerifyContact:input_type -> gochat.rpc.VerifyContactRequest
+	7,   // 98: gochat.rpc.UserService.ListLoginHistory:input_type -> gochat.rpc.ListLoginHistoryRequest
+	17,  // 99: gochat.rpc.UserService.EnrollTotp:input_type -> gochat.rpc.EnrollTotpRequest
+	19,  // 100: gochat.rpc.UserService.ConfirmTotp:input_type -> gochat.rpc.ConfirmTotpRequest
+	21,  // 101: gochat.rpc.UserService.DisableTotp:input_type -> gochat.rpc.DisableTotpRequest
+	44,  // 102: gochat.rpc.UserService.GetNotifySetting:input_type -> gochat.rpc.GetNotifySettingRequest
+	46,  // 103: gochat.rpc.UserService.UpdateNotifySetting:input_type -> gochat.rpc.UpdateNotifySettingRequest
+	50,  // 104: gochat.rpc.UserService.GetPushTargets:input_type -> gochat.rpc.GetPushTargetsRequest
+	53,  // 105: gochat.rpc.UserService.RegisterDevice:input_type -> gochat.rpc.RegisterDeviceRequest
+	55,  // 106: gochat.rpc.UserService.ListDevices:input_type -> gochat.rpc.ListDevicesRequest
+	57,  // 107: gochat.rpc.UserService.RemoveDevice:input_type -> gochat.rpc.RemoveDeviceRequest
+	59,  // 108: gochat.rpc.UserService.KickUser:input_type -> gochat.rpc.KickUserRequest
+	61,  // 109: gochat.rpc.UserService.SetUserStatus:input_type -> gochat.rpc.SetUserStatusRequest
+	65,  // 110: gochat.rpc.UserService.GetPresence:input_type -> gochat.rpc.GetPresenceRequest
+	67,  // 111: gochat.rpc.UserService.BatchGetPresence:input_type -> gochat.rpc.BatchGetPresenceRequest
+	70,  // 112: gochat.rpc.UserService.GetPrivacySetting:input_type -> gochat.rpc.GetPrivacySettingRequest
+	72,  // 113: gochat.rpc.UserService.UpdatePrivacySetting:input_type -> gochat.rpc.UpdatePrivacySettingRequest
+	75,  // 114: gochat.rpc.UserService.GetContactPolicies:input_type -> gochat.rpc.GetContactPoliciesRequest
+	77,  // 115: gochat.rpc.UserService.CheckFriendAnswer:input_type -> gochat.rpc.CheckFriendAnswerRequest
+	79,  // 116: gochat.rpc.UserService.DeactivateAccount:input_type -> gochat.rpc.DeactivateAccountRequest
+	81,  // 117: gochat.rpc.UserService.DeleteAccount:input_type -> gochat.rpc.DeleteAccountRequest
+	83,  // 118: gochat.rpc.UserService.ReactivateAccount:input_type -> gochat.rpc.ReactivateAccountRequest
+	86,  // 119: gochat.rpc.UserService.GetAccountDeletion:input_type -> gochat.rpc.GetAccountDeletionRequest
+	88,  // 120: gochat.rpc.UserService.CompleteDeletionStep:input_type -> gochat.rpc.CompleteDeletionStepRequest
+	13,  // 121: gochat.rpc.UserService.Register:output_type -> gochat.rpc.RegisterResponse
+	15,  // 122: gochat.rpc.UserService.Login:output_type -> gochat.rpc.LoginResponse
+	15,  // 123: gochat.rpc.UserService.VerifyMfa:output_type -> gochat.rpc.LoginResponse
+	25,  // 124: gochat.rpc.UserService.ListOidcProviders:output_type -> gochat.rpc.ListOidcProvidersResponse
+	27,  // 125: gochat.rpc.UserService.StartOidcLogin:output_type -> gochat.rpc.StartOidcLoginResponse
+	15,  // 126: gochat.rpc.UserService.OidcLogin:output_type -> gochat.rpc.LoginResponse
+	30,  // 127: gochat.rpc.UserService.RefreshToken:output_type -> gochat.rpc.RefreshTokenResponse
+	32,  // 128: gochat.rpc.UserService.Logout:output_type -> gochat.rpc.LogoutResponse
+	34,  // 129: gochat.rpc.UserService.GetUser:output_type -> gochat.rpc.GetUserResponse
+	36,  // 130: gochat.rpc.UserService.GetCurrentUser:output_type -> gochat.rpc.GetCurrentUserResponse
+	38,  // 131: gochat.rpc.UserService.UpdateUser:output_type -> gochat.rpc.UpdateUserResponse
+	40,  // 132: gochat.rpc.UserService.SearchUsers:output_type -> gochat.rpc.SearchUsersResponse
+	42,  // 133: gochat.rpc.UserService.GetUsersByIds:output_type -> gochat.rpc.GetUsersByIdsResponse
+	1,   // 134: gochat.rpc.UserService.ForgotPassword:output_type -> gochat.rpc.ForgotPasswordResponse
+	3,   // 135: gochat.rpc.UserService.ResetPassword:output_type -> gochat.rpc.ResetPasswordResponse
+	5,   // 136: gochat.rpc.UserService.SendVerificationCode:output_type -> gochat.rpc.SendVerificationCodeResponse
+	10,  // 137: gochat.rpc.UserService.VerifyContact:output_type -> gochat.rpc.VerifyContactResponse
+	8,   // 138: gochat.rpc.UserService.ListLoginHistory:output_type -> gochat.rpc.ListLoginHistoryResponse
+	18,  // 139: gochat.rpc.UserService.EnrollTotp:output_type -> gochat.rpc.EnrollTotpResponse
+	20,  // 140: gochat.rpc.UserService.ConfirmTotp:output_type -> gochat.rpc.ConfirmTotpResponse
+	22,  // 141: gochat.rpc.UserService.DisableTotp:output_type -> gochat.rpc.DisableTotpResponse
+	45,  // 142: gochat.rpc.UserService.GetNotifySetting:output_type -> gochat.rpc.GetNotifySettingResponse
+	47,  // 143: gochat.rpc.UserService.UpdateNotifySetting:output_type -> gochat.rpc.UpdateNotifySettingResponse
+	51,  // 144: gochat.rpc.UserService.GetPushTargets:output_type -> gochat.rpc.GetPushTargetsResponse
+	54,  // 145: gochat.rpc.UserService.RegisterDevice:output_type -> gochat.rpc.RegisterDeviceResponse
+	56,  // 146: gochat.rpc.UserService.ListDevices:output_type -> gochat.rpc.ListDevicesResponse
+	58,  // 147: gochat.rpc.UserService.RemoveDevice:output_type -> gochat.rpc.RemoveDeviceResponse
+	60,  // 148: gochat.rpc.UserService.KickUser:output_type -> gochat.rpc.KickUserResponse
+	62,  // 149: gochat.rpc.UserService.SetUserStatus:output_type -> gochat.rpc.SetUserStatusResponse
+	66,  // 150: gochat.rpc.UserService.GetPresence:output_type -> gochat.rpc.GetPresenceResponse
+	68,  // 151: gochat.rpc.UserService.BatchGetPresence:output_type -> gochat.rpc.BatchGetPresenceResponse
+	71,  // 152: gochat.rpc.UserService.GetPrivacySetting:output_type -> gochat.rpc.GetPrivacySettingResponse
+	73,  // 153: gochat.rpc.UserService.UpdatePrivacySetting:output_type -> gochat.rpc.UpdatePrivacySettingResponse
+	76,  // 154: gochat.rpc.UserService.GetContactPolicies:output_type -> gochat.rpc.GetContactPoliciesResponse
+	78,  // 155: gochat.rpc.UserService.CheckFriendAnswer:output_type -> gochat.rpc.CheckFriendAnswerResponse
+	80,  // 156: gochat.rpc.UserService.DeactivateAccount:output_type -> gochat.rpc.DeactivateAccountResponse
+	82,  // 157: gochat.rpc.UserService.DeleteAccount:output_type -> gochat.rpc.DeleteAccountResponse
+	84,  // 158: gochat.rpc.UserService.ReactivateAccount:output_type -> gochat.rpc.ReactivateAccountResponse
+	87,  // 159: gochat.rpc.UserService.GetAccountDeletion:output_type -> gochat.rpc.GetAccountDeletionResponse
+	89,  // 160: gochat.rpc.UserService.CompleteDeletionStep:output_type -> gochat.rpc.CompleteDeletionStepResponse
+	121, // [121:161] is the sub-list for method output_type
+	81,  // [81:121] is the sub-list for method input_type
+	81,  // [81:81] is the sub-list for extension type_name
+	81,  // [81:81] is the sub-list for extension extendee
+	0,   // [0:81] is the sub-list for field type_name
 }
 
 func init() { file_user_proto_init() }
@@ -1142,7 +5721,7 @@ func file_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   90,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
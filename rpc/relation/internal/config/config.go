@@ -14,6 +14,9 @@ type Config struct {
 	Kafka struct {
 		Brokers []string
 		Topic   string
+		// UserTopic carries the user service's events; deleted accounts are cleaned up from it
+		UserTopic string `json:",default=user-topic"`
+		GroupID   string `json:",default=relation-rpc-consumer-group"`
 	}
 	UserRpc zrpc.RpcClientConf
 }
@@ -44,6 +44,10 @@ func (l *ApplyLogic) Apply(in *pb.ApplyRequest) (*pb.ApplyResponse, error) {
 		return nil, status.Error(codes.Unauthenticated, "invalid user_id in metadata")
 	}
 
+	if err := l.checkPolicy(userId, in.ToUserId, in.Answer); err != nil {
+		return nil, err
+	}
+
 	apply, err := l.svcCtx.FriendApplyModel.FindPendingApplyByFromAndTo(l.ctx, userId, in.ToUserId)
 	if err != nil && err != model.ErrNotFound {
 		return nil, status.Error(codes.Internal, "failed to check existing apply")
@@ -78,3 +82,38 @@ func (l *ApplyLogic) Apply(in *pb.ApplyRequest) (*pb.ApplyResponse, error) {
 
 	return &pb.ApplyResponse{Base: &pb.BaseResponse{Code: 200, Message: "Success"}}, nil
 }
+
+// checkPolicy enforces who the recipient accepts friend requests from
+func (l *ApplyLogic) checkPolicy(userId, toUserId int64, answer string) error {
+	resp, err := l.svcCtx.UserRpc.GetContactPolicies(l.ctx, &pb.GetContactPoliciesRequest{UserIds: []int64{toUserId}})
+	if err != nil || len(resp.Policies) == 0 {
+		l.Errorf("failed to get contact policy of user %d: %v", toUserId, err)
+		return status.Error(codes.Internal, "failed to check privacy setting")
+	}
+	policy := resp.Policies[0]
+
+	switch policy.FriendRequest {
+	case pb.FriendRequestPolicy_FRIEND_REQUEST_NOBODY:
+		return status.Error(codes.PermissionDenied, "This user does not accept friend requests")
+	case pb.FriendRequestPolicy_FRIEND_REQUEST_FRIENDS_OF_FRIENDS:
+		mutual, err := l.svcCtx.FriendshipModel.HasMutualFriend(l.ctx, userId, toUserId)
+		if err != nil {
+			return status.Error(codes.Internal, "failed to check mutual friends")
+		}
+		if !mutual {
+			return status.Error(codes.PermissionDenied, "This user only accepts friend requests from friends of friends")
+		}
+	case pb.FriendRequestPolicy_FRIEND_REQUEST_QUESTION:
+		if answer == "" {
+			return status.Error(codes.FailedPrecondition, "Answer the question of this user: "+policy.FriendQuestion)
+		}
+		check, err := l.svcCtx.UserRpc.CheckFriendAnswer(l.ctx, &pb.CheckFriendAnswerRequest{UserId: toUserId, Answer: answer})
+		if err != nil {
+			return err
+		}
+		if !check.Correct {
+			return status.Error(codes.PermissionDenied, "Wrong answer")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/relation/internal/svc"
+	"github.com/archyhsh/gochat/rpc/relation/model"
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/IBM/sarama"
+)
+
+// UserEventConsumerHandler removes the friendships and friend requests of deleted accounts and
+// confirms it to the user service, which announces the deletion again until it got the
+// confirmation. Removing them twice does no harm.
+type UserEventConsumerHandler struct {
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUserEventConsumerHandler(svcCtx *svc.ServiceContext) *UserEventConsumerHandler {
+	return &UserEventConsumerHandler{
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(context.Background()),
+	}
+}
+
+func (h *UserEventConsumerHandler) Handle(ctx context.Context, message *sarama.ConsumerMessage) error {
+	var event struct {
+		Type   string `json:"type"`
+		Action string `json:"action"`
+		UserId int64  `json:"user_id"`
+	}
+	if err := json.Unmarshal(message.Value, &event); err != nil {
+		h.Errorf("[UserEvent] drop undecodable event at offset %d: %v", message.Offset, err)
+		return nil
+	}
+	if event.Type != "user_event" || event.Action != "account_deleted" || event.UserId <= 0 {
+		return nil
+	}
+
+	if err := h.removeUser(ctx, event.UserId); err != nil {
+		h.Errorf("[UserEvent] failed to remove relations of deleted user %d: %v", event.UserId, err)
+		return err
+	}
+	if _, err := h.svcCtx.UserRpc.CompleteDeletionStep(ctx, &pb.CompleteDeletionStepRequest{
+		UserId: event.UserId,
+		Step:   "relation",
+	}); err != nil {
+		h.Errorf("[UserEvent] failed to confirm deletion of user %d: %v", event.UserId, err)
+		return err
+	}
+	h.Infof("[UserEvent] removed relations of deleted user %d", event.UserId)
+	return nil
+}
+
+// removeUser deletes row by row through the models, so their caches are cleared as well
+func (h *UserEventConsumerHandler) removeUser(ctx context.Context, userId int64) error {
+	friendships, err := h.svcCtx.FriendshipModel.FindAllByUserId(ctx, userId)
+	if err != nil {
+		return err
+	}
+	for _, f := range friendships {
+		if err := h.svcCtx.FriendshipModel.Delete(ctx, f.Id); err != nil && !errors.Is(err, model.ErrNotFound) {
+			return err
+		}
+	}
+	applies, err := h.svcCtx.FriendApplyModel.FindAllByUserId(ctx, userId)
+	if err != nil {
+		return err
+	}
+	for _, a := range applies {
+		if err := h.svcCtx.FriendApplyModel.Delete(ctx, a.Id); err != nil && !errors.Is(err, model.ErrNotFound) {
+			return err
+		}
+	}
+	return nil
+}
@@ -17,6 +17,7 @@ type (
 		friendApplyModel
 		FindPendingApplyByFromAndTo(ctx context.Context, fromId, toId int64) (*FriendApply, error)
 		FindApplyListByToUserId(ctx context.Context, toUserId int64) ([]*FriendApply, error)
+		FindAllByUserId(ctx context.Context, userId int64) ([]*FriendApply, error)
 	}
 
 	customFriendApplyModel struct {
@@ -51,3 +52,11 @@ func (m *customFriendApplyModel) FindApplyListByToUserId(ctx context.Context, to
 	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, toUserId)
 	return resp, err
 }
+
+// FindAllByUserId returns the requests the user sent or received, whatever their status
+func (m *customFriendApplyModel) FindAllByUserId(ctx context.Context, userId int64) ([]*FriendApply, error) {
+	query := fmt.Sprintf("select %s from %s where from_user_id = ? union select %s from %s where to_user_id = ?", friendApplyRows, m.table, friendApplyRows, m.table)
+	var resp []*FriendApply
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userId, userId)
+	return resp, err
+}
@@ -21,6 +21,8 @@ type (
 		InsertFriendshipByUserIdFriendId(ctx context.Context, userId, friendId int64) error
 		DeleteFriendshipByUserIdFriendId(ctx context.Context, userId, friendId int64) error
 		UpdateRemarkWithVersion(ctx context.Context, userId, friendId int64, remark string, version int64) error
+		FindAllByUserId(ctx context.Context, userId int64) ([]*Friendship, error)
+		HasMutualFriend(ctx context.Context, userId, otherId int64) (bool, error)
 	}
 
 	customFriendshipModel struct {
@@ -70,3 +72,26 @@ func (m *customFriendshipModel) UpdateRemarkWithVersion(ctx context.Context, use
 	_, err := m.ExecNoCacheCtx(ctx, query, remark, version, userId, friendId)
 	return err
 }
+
+// FindAllByUserId returns both sides of every friendship of the user, blacklisted ones included
+func (m *customFriendshipModel) FindAllByUserId(ctx context.Context, userId int64) ([]*Friendship, error) {
+	query := fmt.Sprintf("select %s from %s where user_id = ? union select %s from %s where friend_id = ?", friendshipRows, m.table, friendshipRows, m.table)
+	var resp []*Friendship
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userId, userId)
+	return resp, err
+}
+
+// HasMutualFriend reports whether the two users have a friend in common that neither blocked.
+func (m *customFriendshipModel) HasMutualFriend(ctx context.Context, userId, otherId int64) (bool, error) {
+	query := fmt.Sprintf(`
+		SELECT COUNT(*) FROM %s a
+		INNER JOIN %s b ON b.friend_id = a.friend_id
+		WHERE a.user_id = ? AND b.user_id = ? AND a.status = 0 AND b.status = 0
+		LIMIT 1
+	`, m.table, m.table)
+	var count int64
+	if err := m.QueryRowNoCacheCtx(ctx, &count, query, userId, otherId); err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
@@ -1,17 +1,21 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	_ "time/tzdata"
 
+	"github.com/archyhsh/gochat/pkg/kafka"
 	"github.com/archyhsh/gochat/rpc/pb"
 	"github.com/archyhsh/gochat/rpc/relation/internal/config"
+	"github.com/archyhsh/gochat/rpc/relation/internal/logic"
 	"github.com/archyhsh/gochat/rpc/relation/internal/server"
 	"github.com/archyhsh/gochat/rpc/relation/internal/svc"
 	"github.com/joho/godotenv"
 
 	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/service"
 	"github.com/zeromicro/go-zero/zrpc"
 	"google.golang.org/grpc"
@@ -30,6 +34,24 @@ func main() {
 	conf.MustLoad(*configFile, &c, conf.UseEnv())
 	ctx := svc.NewServiceContext(c)
 
+	// Cleans up after deleted accounts (shared GroupID, so each deletion is handled once)
+	userConsumer, err := kafka.NewConsumer(
+		c.Kafka.Brokers,
+		c.Kafka.GroupID,
+		[]string{c.Kafka.UserTopic},
+		logic.NewUserEventConsumerHandler(ctx),
+	)
+	if err == nil {
+		go func() {
+			logx.Infof("Starting user event consumer for topic: %s", c.Kafka.UserTopic)
+			if err := userConsumer.Start(context.Background()); err != nil {
+				logx.Errorf("User event consumer error: %v", err)
+			}
+		}()
+	} else {
+		logx.Errorf("User event consumer disabled: %v", err)
+	}
+
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
 		pb.RegisterRelationServiceServer(grpcServer, server.NewRelationServiceServer(ctx))
 
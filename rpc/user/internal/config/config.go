@@ -1,6 +1,12 @@
 package config
 
 import (
+	"time"
+
+	"github.com/archyhsh/gochat/pkg/auth"
+	"github.com/archyhsh/gochat/pkg/oidc"
+	"github.com/archyhsh/gochat/pkg/oidc/mockidp"
+	"github.com/archyhsh/gochat/pkg/verify"
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/zrpc"
 )
@@ -11,12 +17,62 @@ type Config struct {
 		DataSource string
 	}
 	Cache cache.CacheConf
-	JWT   struct {
-		AccessSecret string
-		AccessExpire int64
+	// JWT signs access tokens, valid for AccessExpire seconds, with ActiveKid (AccessSecret when
+	// unset); refresh tokens are kept in Redis and rotate on every use
+	JWT struct {
+		AccessSecret  string
+		AccessExpire  int64 `json:",default=900"`
+		RefreshExpire int64 `json:",default=2592000"`
+		auth.KeyConf
 	}
 	Kafka struct {
 		Brokers []string
 		Topic   string
 	}
+	// LoginGuard slows down and locks out repeated failed sign-ins per account and per IP
+	LoginGuard auth.LoginGuardConf
+	// MFA configures TOTP two-factor sign-in. Accounts listed in Admins must enrol before they
+	// can sign in and cannot turn 2FA off.
+	MFA struct {
+		Issuer      string        `json:",default=GoChat"` // shown by authenticator apps
+		Skew        int           `json:",default=1"`      // steps of clock drift accepted either way
+		PendingTTL  time.Duration `json:",default=5m"`     // lifetime of the token between the two sign-in steps
+		MaxAttempts int           `json:",default=5"`      // codes tried per token
+		Admins      []string      `json:",optional"`       // usernames of admin accounts
+	}
+	// OIDC signs users in through external identity providers, with the authorization code flow
+	// and PKCE. MockIdP runs a test provider inside this service when its Listen is set.
+	OIDC struct {
+		Providers []oidc.ProviderConf `json:",optional"`
+		StateTTL  time.Duration       `json:",default=10m"` // time allowed for signing in at the provider
+		Timeout   time.Duration       `json:",default=10s"`
+		MockIdP   mockidp.Conf        `json:",optional"`
+	}
+	// Gateway is called to disconnect users through ChatService.KickUser, or POST /internal/kick
+	// on gateways without a push rpc
+	Gateway struct {
+		Secret  string        // signs the calls, shared with the gateways' Internal.Secret
+		Timeout time.Duration `json:",default=2s"`
+	}
+	// Verification delivers one-time codes for password resets and contact verification
+	Verification struct {
+		Sender   verify.Conf
+		Code     verify.CodeConf
+		ResetUrl string `json:",optional"` // reset page linked in reset mails, with ?username=&code= appended
+	}
+	// Deletion erases deleted accounts once Grace is over. The relation, group and message
+	// services are told to remove their data, again every Retry until all of them confirmed.
+	Deletion struct {
+		Grace    time.Duration `json:",default=720h"`
+		Interval time.Duration `json:",default=1m"` // how often due deletions are looked for
+		Retry    time.Duration `json:",default=10m"`
+		Batch    int           `json:",default=100"` // deletions advanced per round
+	}
+	// Presence reads what the gateways record; StaleAfter must match theirs
+	Presence struct {
+		StaleAfter time.Duration `json:",default=2m"`
+	}
+
+	// RelationRpc tells whether users are friends, for settings shown to friends only
+	RelationRpc zrpc.RpcClientConf
 }
@@ -0,0 +1,175 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// deletionSteps are the services that remove a deleted user's data from their own databases and
+// confirm it through CompleteDeletionStep before the account itself is erased
+var deletionSteps = []string{"relation", "group", "message"}
+
+// AccountDeletionWorker carries out the deletions whose grace period is over. Each one is a saga
+// tracked in user_deletion: the deletion is announced with an account_deleted user_event, and
+// announced again every Deletion.Retry until every service confirmed its step; then the account
+// is erased. Every step is idempotent, so a deletion interrupted anywhere is simply resumed.
+type AccountDeletionWorker struct {
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAccountDeletionWorker(svcCtx *svc.ServiceContext) *AccountDeletionWorker {
+	return &AccountDeletionWorker{
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(context.Background()),
+	}
+}
+
+func (w *AccountDeletionWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.svcCtx.Config.Deletion.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.runOnce(ctx)
+		}
+	}
+}
+
+func (w *AccountDeletionWorker) runOnce(ctx context.Context) {
+	deletions, err := w.svcCtx.DeletionModel.FindDue(ctx, time.Now(), w.svcCtx.Config.Deletion.Batch)
+	if err != nil {
+		w.Errorf("[AccountDeletion] failed to find due deletions: %v", err)
+		return
+	}
+	for _, deletion := range deletions {
+		w.advance(ctx, deletion)
+	}
+}
+
+// advance takes one deletion a step further. Instances race for it through Claim, which also
+// postpones the next attempt in case this one does not get through.
+func (w *AccountDeletionWorker) advance(ctx context.Context, deletion *model.UserDeletion) {
+	ok, err := w.svcCtx.DeletionModel.Claim(ctx, deletion, time.Now().Add(w.svcCtx.Config.Deletion.Retry))
+	if err != nil {
+		w.Errorf("[AccountDeletion] failed to claim deletion of user %d: %v", deletion.UserId, err)
+		return
+	}
+	if !ok {
+		return
+	}
+	deletion.Status = model.DeletionCleaning
+	deletion.Attempts++
+
+	user, err := w.svcCtx.UserModel.FindOne(ctx, deletion.UserId)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		w.Errorf("[AccountDeletion] failed to find user %d: %v", deletion.UserId, err)
+		return
+	}
+	if user != nil && user.Status != model.UserStatusDeleting {
+		// The account was never closed, or reopened by an admin: nothing may be erased
+		w.Infof("[AccountDeletion] user %d is not closed for deletion, dropping it", deletion.UserId)
+		deletion.Status = model.DeletionCancelled
+		if err := w.svcCtx.DeletionModel.Update(ctx, deletion); err != nil {
+			w.Errorf("[AccountDeletion] failed to cancel deletion of user %d: %v", deletion.UserId, err)
+		}
+		return
+	}
+
+	if pending := pendingDeletionSteps(deletion); len(pending) > 0 {
+		if err := publishUserEvent(ctx, w.svcCtx, deletion.UserId, "account_deleted", time.Now().UnixNano()); err != nil {
+			w.Errorf("[AccountDeletion] failed to announce deletion of user %d: %v", deletion.UserId, err)
+			return
+		}
+		w.Infof("[AccountDeletion] announced deletion of user %d (attempt %d), waiting for %s",
+			deletion.UserId, deletion.Attempts, strings.Join(pending, ", "))
+		return
+	}
+	if err := purgeAccount(ctx, w.svcCtx, deletion); err != nil {
+		w.Errorf("[AccountDeletion] failed to erase user %d: %v", deletion.UserId, err)
+	}
+}
+
+// pendingDeletionSteps lists the services that have not confirmed their cleanup yet
+func pendingDeletionSteps(deletion *model.UserDeletion) []string {
+	done := strings.Split(deletion.Steps, ",")
+	var pending []string
+	for _, step := range deletionSteps {
+		if !slices.Contains(done, step) {
+			pending = append(pending, step)
+		}
+	}
+	return pending
+}
+
+// purgeAccount erases the account and everything the user service keeps about it, then marks the
+// deletion done. Rows already gone are skipped, so it can be run again after a failure.
+func purgeAccount(ctx context.Context, svcCtx *svc.ServiceContext, deletion *model.UserDeletion) error {
+	userId := deletion.UserId
+	if err := svcCtx.Sessions.RevokeAll(ctx, userId); err != nil {
+		return err
+	}
+	devices, err := svcCtx.UserDeviceModel.FindByUserId(ctx, userId)
+	if err != nil {
+		return err
+	}
+	for _, device := range devices {
+		if err := svcCtx.UserDeviceModel.Delete(ctx, device.Id); err != nil && !errors.Is(err, model.ErrNotFound) {
+			return err
+		}
+	}
+	identities, err := svcCtx.IdentityModel.FindByUserId(ctx, userId)
+	if err != nil {
+		return err
+	}
+	for _, identity := range identities {
+		if err := svcCtx.IdentityModel.Delete(ctx, identity.Id); err != nil && !errors.Is(err, model.ErrNotFound) {
+			return err
+		}
+	}
+	if mfa, err := svcCtx.MfaModel.FindOneByUserId(ctx, userId); err == nil {
+		if err := svcCtx.MfaModel.Delete(ctx, mfa.Id); err != nil && !errors.Is(err, model.ErrNotFound) {
+			return err
+		}
+	} else if !errors.Is(err, model.ErrNotFound) {
+		return err
+	}
+	if setting, err := svcCtx.NotifySettingModel.FindOneByUserId(ctx, userId); err == nil {
+		if err := svcCtx.NotifySettingModel.Delete(ctx, setting.Id); err != nil && !errors.Is(err, model.ErrNotFound) {
+			return err
+		}
+	} else if !errors.Is(err, model.ErrNotFound) {
+		return err
+	}
+	if privacy, err := svcCtx.PrivacyModel.FindOneByUserId(ctx, userId); err == nil {
+		if err := svcCtx.PrivacyModel.Delete(ctx, privacy.Id); err != nil && !errors.Is(err, model.ErrNotFound) {
+			return err
+		}
+	} else if !errors.Is(err, model.ErrNotFound) {
+		return err
+	}
+	if err := svcCtx.LoginHistoryModel.DeleteByUserId(ctx, userId); err != nil {
+		return err
+	}
+	// The account goes last: until then a rerun finds the deletion still unfinished
+	if err := svcCtx.UserModel.Delete(ctx, userId); err != nil && !errors.Is(err, model.ErrNotFound) {
+		return err
+	}
+
+	deletion.Status = model.DeletionDone
+	if err := svcCtx.DeletionModel.Update(ctx, deletion); err != nil {
+		return err
+	}
+	logx.WithContext(ctx).Infof("[AccountDeletion] user %d erased", userId)
+	return nil
+}
@@ -0,0 +1,67 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const maxBatchPresence = 200
+
+type BatchGetPresenceLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBatchGetPresenceLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchGetPresenceLogic {
+	return &BatchGetPresenceLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *BatchGetPresenceLogic) BatchGetPresence(in *pb.BatchGetPresenceRequest) (*pb.BatchGetPresenceResponse, error) {
+	md, ok := metadata.FromIncomingContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	userIdStrs := md.Get("user_id")
+	if len(userIdStrs) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "user_id not found in metadata")
+	}
+	userId, err := strconv.ParseInt(userIdStrs[0], 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid user_id in metadata")
+	}
+	if len(in.UserIds) > maxBatchPresence {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d users per request", maxBatchPresence)
+	}
+
+	seen := make(map[int64]bool, len(in.UserIds))
+	userIds := make([]int64, 0, len(in.UserIds))
+	for _, uid := range in.UserIds {
+		if uid > 0 && !seen[uid] {
+			seen[uid] = true
+			userIds = append(userIds, uid)
+		}
+	}
+
+	presences, err := loadPresences(l.ctx, l.svcCtx, userId, userIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.BatchGetPresenceResponse{
+		Base:      &pb.BaseResponse{Code: 200, Message: "Success"},
+		Presences: presences,
+	}, nil
+}
@@ -0,0 +1,48 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CheckFriendAnswerLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCheckFriendAnswerLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckFriendAnswerLogic {
+	return &CheckFriendAnswerLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CheckFriendAnswer compares an answer to the user's friend question; the stored answer never
+// leaves the user service.
+func (l *CheckFriendAnswerLogic) CheckFriendAnswer(in *pb.CheckFriendAnswerRequest) (*pb.CheckFriendAnswerResponse, error) {
+	privacy, err := l.svcCtx.PrivacyModel.FindOneByUserId(l.ctx, in.UserId)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return nil, status.Error(codes.Internal, "failed to find privacy setting: "+err.Error())
+	}
+	if privacy == nil || privacy.FriendRequest != model.FriendRequestQuestion || privacy.FriendAnswer == "" {
+		return nil, status.Error(codes.FailedPrecondition, "user asks no friend question")
+	}
+
+	answer := normalizeFriendAnswer(in.Answer)
+	correct := answer != "" && bcrypt.CompareHashAndPassword([]byte(privacy.FriendAnswer), []byte(answer)) == nil
+	return &pb.CheckFriendAnswerResponse{
+		Base:    &pb.BaseResponse{Code: 200, Message: "Success"},
+		Correct: correct,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CompleteDeletionStepLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCompleteDeletionStepLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CompleteDeletionStepLogic {
+	return &CompleteDeletionStepLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CompleteDeletionStep records that a service removed the deleted user's data. The last one to
+// confirm has the account erased right away instead of at the worker's next attempt.
+func (l *CompleteDeletionStepLogic) CompleteDeletionStep(in *pb.CompleteDeletionStepRequest) (*pb.CompleteDeletionStepResponse, error) {
+	if !slices.Contains(deletionSteps, in.Step) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown deletion step %q", in.Step)
+	}
+	deletion, err := l.svcCtx.DeletionModel.FindOneByUserId(l.ctx, in.UserId)
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "no deletion of this user")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "system error")
+	}
+	switch deletion.Status {
+	case model.DeletionDone:
+		return &pb.CompleteDeletionStepResponse{
+			Base: &pb.BaseResponse{Code: 200, Message: "Success"},
+		}, nil
+	case model.DeletionCleaning:
+	default:
+		return nil, status.Error(codes.FailedPrecondition, "deletion is not under way")
+	}
+
+	added, err := l.svcCtx.DeletionModel.AddStep(l.ctx, deletion, in.Step)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to record step: "+err.Error())
+	}
+	if added {
+		l.Infof("deletion of user %d: %s done", in.UserId, in.Step)
+		if deletion, err = l.svcCtx.DeletionModel.FindOne(l.ctx, deletion.Id); err != nil {
+			return nil, status.Error(codes.Internal, "system error")
+		}
+		if len(pendingDeletionSteps(deletion)) == 0 {
+			// A failure is left to the worker, which finds the deletion due again
+			if err := purgeAccount(l.ctx, l.svcCtx, deletion); err != nil {
+				l.Errorf("failed to erase user %d: %v", in.UserId, err)
+			}
+		}
+	}
+	return &pb.CompleteDeletionStepResponse{
+		Base: &pb.BaseResponse{Code: 200, Message: "Success"},
+	}, nil
+}
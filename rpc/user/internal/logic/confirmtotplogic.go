@@ -0,0 +1,88 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/archyhsh/gochat/pkg/auth"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ConfirmTotpLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewConfirmTotpLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ConfirmTotpLogic {
+	return &ConfirmTotpLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ConfirmTotp turns 2FA on once the code shows the authenticator app holds the enrolled secret,
+// and returns fresh recovery codes. During a sign-in that required enrolment it also finishes it.
+func (l *ConfirmTotpLogic) ConfirmTotp(in *pb.ConfirmTotpRequest) (*pb.ConfirmTotpResponse, error) {
+	if in.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+	user, challenge, err := mfaEnrollee(l.ctx, l.svcCtx, in.MfaToken, true)
+	if err != nil {
+		return nil, err
+	}
+	mfa, err := l.svcCtx.MfaModel.FindOneByUserId(l.ctx, user.Id)
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, status.Error(codes.FailedPrecondition, "Start enrolment first")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "system error")
+	}
+	if mfa.Enabled == 1 {
+		return nil, status.Error(codes.FailedPrecondition, "Two-factor authentication is already enabled")
+	}
+	step, ok := auth.ValidateTOTP(mfa.Secret, in.Code, time.Now(), l.svcCtx.Config.MFA.Skew)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "Invalid verification code")
+	}
+
+	recoveryCodes, err := auth.NewRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate recovery codes")
+	}
+	hashes := make([]string, len(recoveryCodes))
+	for i, code := range recoveryCodes {
+		hashes[i] = auth.HashRecoveryCode(code)
+	}
+	data, _ := json.Marshal(hashes)
+	mfa.Enabled = 1
+	mfa.RecoveryCodes = string(data)
+	mfa.LastUsedStep = step
+	if err := l.svcCtx.MfaModel.Update(l.ctx, mfa); err != nil {
+		return nil, status.Error(codes.Internal, "failed to enable 2FA: "+err.Error())
+	}
+
+	resp := &pb.ConfirmTotpResponse{
+		Base:          &pb.BaseResponse{Code: 200, Message: "Two-factor authentication enabled"},
+		RecoveryCodes: recoveryCodes,
+	}
+	if challenge != nil {
+		if err := l.svcCtx.Sessions.CompleteMFAChallenge(l.ctx, in.MfaToken); err != nil {
+			l.Errorf("failed to consume mfa token of user %d: %v", user.Id, err)
+		}
+		resp.Login, err = completeLogin(l.ctx, l.svcCtx, user, challenge.DeviceID, pb.DevicePlatform(challenge.Platform), challenge.IP)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
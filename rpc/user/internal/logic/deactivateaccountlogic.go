@@ -0,0 +1,133 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeactivateAccountLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeactivateAccountLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeactivateAccountLogic {
+	return &DeactivateAccountLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeactivateAccount closes the caller's account until they reactivate it: the profile is hidden
+// from other users and every device is signed out.
+func (l *DeactivateAccountLogic) DeactivateAccount(in *pb.DeactivateAccountRequest) (*pb.DeactivateAccountResponse, error) {
+	md, ok := metadata.FromIncomingContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	userIdStrs := md.Get("user_id")
+	if len(userIdStrs) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "user_id not found in metadata")
+	}
+	userId, err := strconv.ParseInt(userIdStrs[0], 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid user_id in metadata")
+	}
+	user, err := l.svcCtx.UserModel.FindOne(l.ctx, userId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "invalid user!")
+	}
+	if user.Status != model.UserStatusNormal {
+		return nil, status.Error(codes.FailedPrecondition, "Account is not active")
+	}
+	if err := confirmPassword(l.ctx, l.svcCtx, user, in.Password); err != nil {
+		return nil, err
+	}
+
+	if err := closeAccount(l.ctx, l.svcCtx, user, model.UserStatusDeactivated); err != nil {
+		return nil, err
+	}
+	return &pb.DeactivateAccountResponse{
+		Base: &pb.BaseResponse{Code: 200, Message: "Account deactivated"},
+	}, nil
+}
+
+// confirmPassword checks the password of a signed-in user before closing their account, so a
+// stolen session alone cannot do it. Wrong guesses count against the account like failed sign-ins.
+func confirmPassword(ctx context.Context, svcCtx *svc.ServiceContext, user *model.User, password string) error {
+	if wait, err := svcCtx.LoginGuard.Check(ctx, user.Username, ""); err == nil && wait > 0 {
+		return lockedOut(wait)
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
+		wait, err := svcCtx.LoginGuard.Fail(ctx, user.Username, "")
+		if err != nil {
+			logx.WithContext(ctx).Errorf("failed to count attempt of %q: %v", user.Username, err)
+		}
+		if wait > 0 {
+			return lockedOut(wait)
+		}
+		return status.Error(codes.Unauthenticated, "Invalid password")
+	}
+	return nil
+}
+
+// closeAccount moves the user to a closed status, signs out and forgets all of their devices so
+// nothing is pushed to them anymore, and tells the other services the profile is gone.
+func closeAccount(ctx context.Context, svcCtx *svc.ServiceContext, user *model.User, userStatus int64) error {
+	user.Status = userStatus
+	user.InfoVersion = time.Now().UnixNano()
+	if err := svcCtx.UserModel.Update(ctx, user); err != nil {
+		return status.Error(codes.Internal, "failed to update user status")
+	}
+	if err := svcCtx.Sessions.RevokeAll(ctx, user.Id); err != nil {
+		return status.Error(codes.Internal, "failed to revoke sessions: "+err.Error())
+	}
+	if err := kickUser(ctx, svcCtx, user.Id, "", pb.KickReason_KICK_REASON_ACCOUNT_CLOSED); err != nil {
+		logx.WithContext(ctx).Errorf("failed to disconnect closed account %d: %v", user.Id, err)
+	}
+	devices, err := svcCtx.UserDeviceModel.FindByUserId(ctx, user.Id)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("failed to find devices of closed account %d: %v", user.Id, err)
+	}
+	for _, device := range devices {
+		if err := svcCtx.UserDeviceModel.Delete(ctx, device.Id); err != nil {
+			logx.WithContext(ctx).Errorf("failed to remove device %s of closed account %d: %v", device.DeviceId, user.Id, err)
+		}
+	}
+	action := "deactivated"
+	if userStatus == model.UserStatusDeleting {
+		action = "deletion_scheduled"
+	}
+	if err := publishUserEvent(ctx, svcCtx, user.Id, action, user.InfoVersion); err != nil {
+		logx.WithContext(ctx).Errorf("failed to publish %s of user %d: %v", action, user.Id, err)
+	}
+	return nil
+}
+
+// publishUserEvent tells the other services about a change to the user, keyed by user so the
+// events of one user stay in order.
+func publishUserEvent(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, action string, infoVersion int64) error {
+	event := map[string]interface{}{
+		"type":         "user_event",
+		"action":       action,
+		"user_id":      userId,
+		"info_version": infoVersion,
+		"timestamp":    time.Now().Unix(),
+	}
+	data, _ := json.Marshal(event)
+	return svcCtx.Producer.Send(ctx, []byte(fmt.Sprintf("user_%d", userId)), data)
+}
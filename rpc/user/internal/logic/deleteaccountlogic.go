@@ -0,0 +1,97 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteAccountLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteAccountLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteAccountLogic {
+	return &DeleteAccountLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeleteAccount closes the caller's account like DeactivateAccount and schedules its deletion
+// after the grace period, see AccountDeletionWorker. Reactivating the account before cancels it.
+func (l *DeleteAccountLogic) DeleteAccount(in *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
+	md, ok := metadata.FromIncomingContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	userIdStrs := md.Get("user_id")
+	if len(userIdStrs) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "user_id not found in metadata")
+	}
+	userId, err := strconv.ParseInt(userIdStrs[0], 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid user_id in metadata")
+	}
+	user, err := l.svcCtx.UserModel.FindOne(l.ctx, userId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "invalid user!")
+	}
+	if user.Status != model.UserStatusNormal {
+		return nil, status.Error(codes.FailedPrecondition, "Account is not active")
+	}
+	if err := confirmPassword(l.ctx, l.svcCtx, user, in.Password); err != nil {
+		return nil, err
+	}
+
+	// Scheduled before the account is closed: the worker drops deletions of accounts that are
+	// not closed for deletion, so a failure in between never erases an open account
+	deleteAfter := time.Now().Add(l.svcCtx.Config.Deletion.Grace).Truncate(time.Second)
+	if err := l.schedule(userId, deleteAfter); err != nil {
+		l.Errorf("failed to schedule deletion of user %d: %v", userId, err)
+		return nil, status.Error(codes.Internal, "failed to schedule deletion")
+	}
+	if err := closeAccount(l.ctx, l.svcCtx, user, model.UserStatusDeleting); err != nil {
+		return nil, err
+	}
+	l.Infof("deletion of user %d scheduled for %s", userId, deleteAfter.Format(time.RFC3339))
+	return &pb.DeleteAccountResponse{
+		Base:        &pb.BaseResponse{Code: 200, Message: "Account scheduled for deletion"},
+		DeleteAfter: deleteAfter.Unix(),
+	}, nil
+}
+
+// schedule starts the user's deletion afresh, reusing the row of an earlier cancelled one
+func (l *DeleteAccountLogic) schedule(userId int64, deleteAfter time.Time) error {
+	deletion, err := l.svcCtx.DeletionModel.FindOneByUserId(l.ctx, userId)
+	if errors.Is(err, model.ErrNotFound) {
+		_, err = l.svcCtx.DeletionModel.Insert(l.ctx, &model.UserDeletion{
+			UserId:        userId,
+			Status:        model.DeletionScheduled,
+			DeleteAfter:   deleteAfter,
+			NextAttemptAt: deleteAfter,
+		})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	deletion.Status = model.DeletionScheduled
+	deletion.DeleteAfter = deleteAfter
+	deletion.Steps = ""
+	deletion.Attempts = 0
+	deletion.NextAttemptAt = deleteAfter
+	return l.svcCtx.DeletionModel.Update(l.ctx, deletion)
+}
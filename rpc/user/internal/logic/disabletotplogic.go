@@ -0,0 +1,98 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DisableTotpLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDisableTotpLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DisableTotpLogic {
+	return &DisableTotpLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DisableTotp turns 2FA off for the caller, who signs in again with the password and a current
+// code, so a stolen session alone cannot weaken the account. Admins must keep it on.
+func (l *DisableTotpLogic) DisableTotp(in *pb.DisableTotpRequest) (*pb.DisableTotpResponse, error) {
+	md, ok := metadata.FromIncomingContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	userIdStrs := md.Get("user_id")
+	if len(userIdStrs) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "user_id not found in metadata")
+	}
+	userId, err := strconv.ParseInt(userIdStrs[0], 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid user_id in metadata")
+	}
+	if in.Code == "" && in.RecoveryCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "code or recovery_code is required")
+	}
+	user, err := l.svcCtx.UserModel.FindOne(l.ctx, userId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "invalid user!")
+	}
+	if isMfaAdmin(l.svcCtx, user.Username) {
+		return nil, status.Error(codes.FailedPrecondition, "Admin accounts must use two-factor authentication")
+	}
+	mfa, err := l.svcCtx.MfaModel.FindOneByUserId(l.ctx, userId)
+	if errors.Is(err, model.ErrNotFound) || (err == nil && mfa.Enabled != 1) {
+		return nil, status.Error(codes.FailedPrecondition, "Two-factor authentication is not enabled")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "system error")
+	}
+
+	// Wrong guesses count against the account like failed sign-ins
+	if wait, err := l.svcCtx.LoginGuard.Check(l.ctx, user.Username, ""); err == nil && wait > 0 {
+		return nil, lockedOut(wait)
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(in.Password)); err != nil {
+		return nil, l.fail(user.Username, "Invalid password")
+	}
+	ok, err = checkSecondFactor(l.ctx, l.svcCtx, mfa, in.Code, in.RecoveryCode)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "system error")
+	}
+	if !ok {
+		return nil, l.fail(user.Username, "Invalid verification code")
+	}
+
+	if err := l.svcCtx.MfaModel.Delete(l.ctx, mfa.Id); err != nil {
+		return nil, status.Error(codes.Internal, "failed to disable 2FA: "+err.Error())
+	}
+	return &pb.DisableTotpResponse{
+		Base: &pb.BaseResponse{Code: 200, Message: "Two-factor authentication disabled"},
+	}, nil
+}
+
+func (l *DisableTotpLogic) fail(username, msg string) error {
+	wait, err := l.svcCtx.LoginGuard.Fail(l.ctx, username, "")
+	if err != nil {
+		l.Errorf("failed to count attempt of %q: %v", username, err)
+	}
+	if wait > 0 {
+		return lockedOut(wait)
+	}
+	return status.Error(codes.Unauthenticated, msg)
+}
@@ -0,0 +1,110 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/archyhsh/gochat/pkg/auth"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type EnrollTotpLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewEnrollTotpLogic(ctx context.Context, svcCtx *svc.ServiceContext) *EnrollTotpLogic {
+	return &EnrollTotpLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// EnrollTotp generates a new secret for the caller. 2FA only turns on once ConfirmTotp proves the
+// authenticator app holds it; enrolling again before that replaces the secret.
+func (l *EnrollTotpLogic) EnrollTotp(in *pb.EnrollTotpRequest) (*pb.EnrollTotpResponse, error) {
+	user, _, err := mfaEnrollee(l.ctx, l.svcCtx, in.MfaToken, false)
+	if err != nil {
+		return nil, err
+	}
+	mfa, err := l.svcCtx.MfaModel.FindOneByUserId(l.ctx, user.Id)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return nil, status.Error(codes.Internal, "system error")
+	}
+	if mfa != nil && mfa.Enabled == 1 {
+		return nil, status.Error(codes.FailedPrecondition, "Two-factor authentication is already enabled")
+	}
+	secret, err := auth.NewTOTPSecret()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate secret")
+	}
+	if mfa == nil {
+		_, err = l.svcCtx.MfaModel.Insert(l.ctx, &model.UserMfa{
+			UserId:        user.Id,
+			Secret:        secret,
+			RecoveryCodes: "[]",
+		})
+	} else {
+		mfa.Secret = secret
+		err = l.svcCtx.MfaModel.Update(l.ctx, mfa)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to save secret: "+err.Error())
+	}
+	return &pb.EnrollTotpResponse{
+		Base:       &pb.BaseResponse{Code: 200, Message: "Success"},
+		Secret:     secret,
+		OtpauthUri: auth.TOTPURI(l.svcCtx.Config.MFA.Issuer, user.Username, secret),
+	}, nil
+}
+
+// mfaEnrollee returns the user setting up 2FA: the caller from metadata, or, with mfaToken, the
+// account signing in that must enrol first. attempt counts the call against the token's attempts.
+func mfaEnrollee(ctx context.Context, svcCtx *svc.ServiceContext, mfaToken string, attempt bool) (*model.User, *auth.MFAChallenge, error) {
+	var userId int64
+	var challenge *auth.MFAChallenge
+	if mfaToken != "" {
+		var err error
+		if attempt {
+			challenge, err = svcCtx.Sessions.AttemptMFAChallenge(ctx, mfaToken, svcCtx.Config.MFA.MaxAttempts)
+		} else {
+			challenge, err = svcCtx.Sessions.PeekMFAChallenge(ctx, mfaToken)
+		}
+		if errors.Is(err, auth.ErrInvalidMFAToken) || (err == nil && !challenge.Enroll) {
+			return nil, nil, errInvalidMfaToken
+		}
+		if err != nil {
+			return nil, nil, status.Error(codes.Internal, "system error")
+		}
+		userId = challenge.UserID
+	} else {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return nil, nil, status.Error(codes.Unauthenticated, "missing metadata")
+		}
+		userIdStrs := md.Get("user_id")
+		if len(userIdStrs) == 0 {
+			return nil, nil, status.Error(codes.Unauthenticated, "user_id not found in metadata")
+		}
+		var err error
+		userId, err = strconv.ParseInt(userIdStrs[0], 10, 64)
+		if err != nil {
+			return nil, nil, status.Error(codes.Unauthenticated, "invalid user_id in metadata")
+		}
+	}
+	user, err := svcCtx.UserModel.FindOne(ctx, userId)
+	if err != nil {
+		return nil, nil, status.Error(codes.NotFound, "invalid user!")
+	}
+	return user, challenge, nil
+}
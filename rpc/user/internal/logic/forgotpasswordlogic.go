@@ -2,17 +2,24 @@ package logic
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/url"
 
+	"github.com/archyhsh/gochat/pkg/verify"
 	"github.com/archyhsh/gochat/rpc/pb"
 	"github.com/archyhsh/gochat/rpc/user/internal/svc"
 	"github.com/archyhsh/gochat/rpc/user/model"
-	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
+// forgotPasswordReply is the same whether or not a code was sent, so it does not tell which
+// usernames exist or have a verified contact
+const forgotPasswordReply = "If the account has a verified email or phone, a reset code has been sent to it"
+
 type ForgotPasswordLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -27,27 +34,77 @@ func NewForgotPasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Fo
 	}
 }
 
+// ForgotPassword sends a password reset code to the user's verified email or phone; the code is
+// redeemed through ResetPassword.
 func (l *ForgotPasswordLogic) ForgotPassword(in *pb.ForgotPasswordRequest) (*pb.ForgotPasswordResponse, error) {
+	if in.Channel != "" && in.Channel != verify.ChannelEmail && in.Channel != verify.ChannelSMS {
+		return nil, status.Error(codes.InvalidArgument, "channel must be email or sms")
+	}
+	reply := &pb.ForgotPasswordResponse{
+		Base: &pb.BaseResponse{Code: 200, Message: forgotPasswordReply},
+	}
+
 	user, err := l.svcCtx.UserModel.FindOneByUsername(l.ctx, in.Username)
+	if errors.Is(err, model.ErrNotFound) {
+		return reply, nil
+	}
 	if err != nil {
-		if err == model.ErrNotFound {
-			return nil, status.Error(codes.NotFound, "user not found")
-		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	if !resetAllowed(user) {
+		l.Infof("password reset for user %d skipped: account status %d", user.Id, user.Status)
+		return reply, nil
+	}
+	channel, target := verifiedContact(user, in.Channel)
+	if target == "" {
+		l.Infof("password reset for user %d skipped: no verified contact for channel %q", user.Id, in.Channel)
+		return reply, nil
+	}
 
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(in.NewPassword), bcrypt.DefaultCost)
+	code, err := l.svcCtx.Codes.Issue(l.ctx, verify.PurposePasswordReset, user.Id, target)
+	if errors.Is(err, verify.ErrResendTooSoon) {
+		return reply, nil
+	}
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to hash password")
+		l.Errorf("failed to issue password reset code to user %d: %v", user.Id, err)
+		return reply, nil
 	}
+	body := fmt.Sprintf("Your GoChat password reset code is %s. It expires in %s. If you did not ask to reset your password, ignore this message.",
+		code, l.svcCtx.Config.Verification.Code.TTL)
+	if resetUrl := l.svcCtx.Config.Verification.ResetUrl; resetUrl != "" {
+		body += fmt.Sprintf("\n\nOr open %s?%s", resetUrl, url.Values{"username": {user.Username}, "code": {code}}.Encode())
+	}
+	if err := l.svcCtx.Sender.Send(l.ctx, &verify.Message{
+		Channel: channel,
+		To:      target,
+		Subject: "Reset your GoChat password",
+		Body:    body,
+	}); err != nil {
+		// Failing loudly would tell which usernames have a verified contact
+		l.Errorf("failed to send password reset code to user %d over %s: %v", user.Id, channel, err)
+	}
+	return reply, nil
+}
 
-	user.Password = string(hashedPassword)
-	err = l.svcCtx.UserModel.Update(l.ctx, user)
-	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to update password")
+// verifiedContact returns where a code for the user may go over the channel; an empty channel
+// picks the verified email, then the verified phone. target is empty when there is none.
+func verifiedContact(user *model.User, channel string) (string, string) {
+	if (channel == "" || channel == verify.ChannelEmail) && user.EmailVerified == 1 && user.Email != "" {
+		return verify.ChannelEmail, user.Email
 	}
+	if (channel == "" || channel == verify.ChannelSMS) && user.PhoneVerified == 1 && user.Phone != "" {
+		return verify.ChannelSMS, user.Phone
+	}
+	return channel, ""
+}
 
-	return &pb.ForgotPasswordResponse{
-		Base: &pb.BaseResponse{Code: 200, Message: "Success"},
-	}, nil
+// resetAllowed reports whether the account may reset its password. A banned account must not
+// get back in this way; a deactivated or deleting one has to be reactivated first, which proves
+// the account like a sign-in does.
+func resetAllowed(user *model.User) bool {
+	switch user.Status {
+	case model.UserStatusBanned, model.UserStatusDeactivated, model.UserStatusDeleting:
+		return false
+	}
+	return true
 }
@@ -0,0 +1,63 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetAccountDeletionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetAccountDeletionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAccountDeletionLogic {
+	return &GetAccountDeletionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+var deletionStates = map[int64]string{
+	model.DeletionScheduled: "scheduled",
+	model.DeletionCleaning:  "cleaning",
+	model.DeletionDone:      "done",
+	model.DeletionCancelled: "cancelled",
+}
+
+// Admin: how far the deletion of an account got
+func (l *GetAccountDeletionLogic) GetAccountDeletion(in *pb.GetAccountDeletionRequest) (*pb.GetAccountDeletionResponse, error) {
+	deletion, err := l.svcCtx.DeletionModel.FindOneByUserId(l.ctx, in.UserId)
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "no deletion of this user")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "system error")
+	}
+	var completed []string
+	if deletion.Steps != "" {
+		completed = strings.Split(deletion.Steps, ",")
+	}
+	return &pb.GetAccountDeletionResponse{
+		Base: &pb.BaseResponse{Code: 200, Message: "Success"},
+		Deletion: &pb.AccountDeletion{
+			UserId:         deletion.UserId,
+			State:          deletionStates[deletion.Status],
+			DeleteAfter:    deletion.DeleteAfter.Unix(),
+			CompletedSteps: completed,
+			PendingSteps:   pendingDeletionSteps(deletion),
+			Attempts:       deletion.Attempts,
+			NextAttemptAt:  deletion.NextAttemptAt.Unix(),
+		},
+	}, nil
+}
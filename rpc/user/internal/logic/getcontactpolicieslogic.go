@@ -0,0 +1,50 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetContactPoliciesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetContactPoliciesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetContactPoliciesLogic {
+	return &GetContactPoliciesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetContactPolicies tells the relation and group services who may send the users friend
+// requests or add them to groups; those services know the friendships and enforce it.
+func (l *GetContactPoliciesLogic) GetContactPolicies(in *pb.GetContactPoliciesRequest) (*pb.GetContactPoliciesResponse, error) {
+	privacies, err := findPrivacies(l.ctx, l.svcCtx, in.UserIds)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to find privacy settings: "+err.Error())
+	}
+
+	policies := make([]*pb.ContactPolicy, 0, len(in.UserIds))
+	for _, uid := range in.UserIds {
+		p := privacies[uid]
+		policies = append(policies, &pb.ContactPolicy{
+			UserId:         uid,
+			FriendRequest:  pb.FriendRequestPolicy(p.FriendRequest),
+			FriendQuestion: p.FriendQuestion,
+			GroupInvite:    pb.Audience(p.GroupInvite),
+		})
+	}
+	return &pb.GetContactPoliciesResponse{
+		Base:     &pb.BaseResponse{Code: 200, Message: "Success"},
+		Policies: policies,
+	}, nil
+}
@@ -2,10 +2,12 @@ package logic
 
 import (
 	"context"
+	"errors"
 	"strconv"
 
 	"github.com/archyhsh/gochat/rpc/pb"
 	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -43,16 +45,23 @@ func (l *GetCurrentUserLogic) GetCurrentUser(in *pb.GetCurrentUserRequest) (*pb.
 	if err != nil {
 		return nil, status.Error(codes.NotFound, "invalid user!")
 	}
+	mfa, err := l.svcCtx.MfaModel.FindOneByUserId(l.ctx, userId)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return nil, status.Error(codes.Internal, "system error")
+	}
 	return &pb.GetCurrentUserResponse{
 		Base: &pb.BaseResponse{Code: 200},
 		User: &pb.User{
-			Id:       user.Id,
-			Username: user.Username,
-			Nickname: user.Nickname,
-			Avatar:   user.Avatar,
-			Phone:    user.Phone,
-			Email:    user.Email,
-			Gender:   int32(user.Gender),
+			Id:            user.Id,
+			Username:      user.Username,
+			Nickname:      user.Nickname,
+			Avatar:        user.Avatar,
+			Phone:         user.Phone,
+			Email:         user.Email,
+			Gender:        int32(user.Gender),
+			EmailVerified: user.EmailVerified == 1,
+			PhoneVerified: user.PhoneVerified == 1,
+			MfaEnabled:    mfa != nil && mfa.Enabled == 1,
 		},
 	}, nil
 }
@@ -0,0 +1,68 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetNotifySettingLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetNotifySettingLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetNotifySettingLogic {
+	return &GetNotifySettingLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetNotifySettingLogic) GetNotifySetting(in *pb.GetNotifySettingRequest) (*pb.GetNotifySettingResponse, error) {
+	md, ok := metadata.FromIncomingContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	userIdStrs := md.Get("user_id")
+	if len(userIdStrs) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "user_id not found in metadata")
+	}
+	userId, err := strconv.ParseInt(userIdStrs[0], 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid user_id in metadata")
+	}
+
+	setting, err := l.svcCtx.NotifySettingModel.FindOneByUserId(l.ctx, userId)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return nil, status.Error(codes.Internal, "failed to find notify setting: "+err.Error())
+	}
+
+	return &pb.GetNotifySettingResponse{
+		Base:    &pb.BaseResponse{Code: 200, Message: "Success"},
+		Setting: toPbNotifySetting(setting),
+	}, nil
+}
+
+// toPbNotifySetting converts a stored setting; users who never saved one get the defaults.
+func toPbNotifySetting(s *model.UserNotifySetting) *pb.NotifySetting {
+	if s == nil {
+		return &pb.NotifySetting{Timezone: "UTC"}
+	}
+	return &pb.NotifySetting{
+		DndEnabled: s.DndEnabled == 1,
+		DndStart:   int32(s.DndStart),
+		DndEnd:     int32(s.DndEnd),
+		Timezone:   s.Timezone,
+	}
+}
@@ -0,0 +1,101 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/archyhsh/gochat/pkg/presence"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var presenceStates = map[string]pb.PresenceState{
+	presence.StateOnline:  pb.PresenceState_PRESENCE_STATE_ONLINE,
+	presence.StateAway:    pb.PresenceState_PRESENCE_STATE_AWAY,
+	presence.StateOffline: pb.PresenceState_PRESENCE_STATE_OFFLINE,
+}
+
+type GetPresenceLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetPresenceLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPresenceLogic {
+	return &GetPresenceLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetPresenceLogic) GetPresence(in *pb.GetPresenceRequest) (*pb.GetPresenceResponse, error) {
+	md, ok := metadata.FromIncomingContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	userIdStrs := md.Get("user_id")
+	if len(userIdStrs) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "user_id not found in metadata")
+	}
+	userId, err := strconv.ParseInt(userIdStrs[0], 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid user_id in metadata")
+	}
+	if in.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	presences, err := loadPresences(l.ctx, l.svcCtx, userId, []int64{in.UserId})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetPresenceResponse{
+		Base:     &pb.BaseResponse{Code: 200, Message: "Success"},
+		Presence: presences[0],
+	}, nil
+}
+
+// loadPresences returns the presence of each listed user as seen by viewerId: last seen is left
+// out unless the user shows it to the viewer, and device details are only shown for the viewer's
+// own presence.
+func loadPresences(ctx context.Context, svcCtx *svc.ServiceContext, viewerId int64, userIds []int64) ([]*pb.Presence, error) {
+	states, err := svcCtx.Presence.BatchGet(ctx, userIds)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get presence: "+err.Error())
+	}
+	privacies, err := findPrivacies(ctx, svcCtx, userIds)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to find privacy settings: "+err.Error())
+	}
+	viewer := newPrivacyViewer(ctx, svcCtx, viewerId)
+
+	res := make([]*pb.Presence, 0, len(userIds))
+	for _, uid := range userIds {
+		p := states[uid]
+		item := &pb.Presence{
+			UserId:   uid,
+			State:    presenceStates[p.State],
+			LastSeen: p.LastSeen,
+		}
+		if uid == viewerId {
+			for _, d := range p.Devices {
+				item.Devices = append(item.Devices, &pb.DevicePresence{
+					DeviceId:  d.DeviceId,
+					State:     presenceStates[d.State],
+					UpdatedAt: d.UpdatedAt,
+				})
+			}
+		} else if !viewer.sees(uid, privacies[uid].LastSeenVisibility) {
+			item.LastSeen = 0
+		}
+		res = append(res, item)
+	}
+	return res, nil
+}
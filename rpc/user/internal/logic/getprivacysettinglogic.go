@@ -0,0 +1,150 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPrivacySettingLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetPrivacySettingLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPrivacySettingLogic {
+	return &GetPrivacySettingLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetPrivacySettingLogic) GetPrivacySetting(in *pb.GetPrivacySettingRequest) (*pb.GetPrivacySettingResponse, error) {
+	md, ok := metadata.FromIncomingContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	userIdStrs := md.Get("user_id")
+	if len(userIdStrs) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "user_id not found in metadata")
+	}
+	userId, err := strconv.ParseInt(userIdStrs[0], 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid user_id in metadata")
+	}
+
+	setting, err := l.svcCtx.PrivacyModel.FindOneByUserId(l.ctx, userId)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return nil, status.Error(codes.Internal, "failed to find privacy setting: "+err.Error())
+	}
+
+	return &pb.GetPrivacySettingResponse{
+		Base:    &pb.BaseResponse{Code: 200, Message: "Success"},
+		Setting: toPbPrivacySetting(setting),
+	}, nil
+}
+
+// toPbPrivacySetting converts a stored setting; users who never saved one get the defaults. The
+// answer to the friend question is never handed out.
+func toPbPrivacySetting(s *model.UserPrivacy) *pb.PrivacySetting {
+	if s == nil {
+		s = model.DefaultUserPrivacy(0)
+	}
+	return &pb.PrivacySetting{
+		SearchableByUsername: s.SearchableByUsername == 1,
+		SearchableByPhone:    s.SearchableByPhone == 1,
+		SearchableByEmail:    s.SearchableByEmail == 1,
+		FriendRequest:        pb.FriendRequestPolicy(s.FriendRequest),
+		FriendQuestion:       s.FriendQuestion,
+		GroupInvite:          pb.Audience(s.GroupInvite),
+		Phone:                pb.Audience(s.PhoneVisibility),
+		Email:                pb.Audience(s.EmailVisibility),
+		Gender:               pb.Audience(s.GenderVisibility),
+		LastSeen:             pb.Audience(s.LastSeenVisibility),
+	}
+}
+
+// findPrivacies returns the settings of each listed user by id, the defaults for users who never
+// saved any.
+func findPrivacies(ctx context.Context, svcCtx *svc.ServiceContext, userIds []int64) (map[int64]*model.UserPrivacy, error) {
+	saved, err := svcCtx.PrivacyModel.FindByUserIds(ctx, userIds)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int64]*model.UserPrivacy, len(userIds))
+	for _, uid := range userIds {
+		res[uid] = model.DefaultUserPrivacy(uid)
+	}
+	for _, p := range saved {
+		res[p.UserId] = p
+	}
+	return res, nil
+}
+
+// privacyViewer decides what a user may see of other users under their settings. Viewer 0 stands
+// for a caller that is not a signed-in user and only sees what everyone may see. Friendship is
+// looked up once per user and only when a setting limits something to friends.
+type privacyViewer struct {
+	ctx      context.Context
+	svcCtx   *svc.ServiceContext
+	viewerId int64
+	friends  map[int64]bool
+}
+
+func newPrivacyViewer(ctx context.Context, svcCtx *svc.ServiceContext, viewerId int64) *privacyViewer {
+	return &privacyViewer{
+		ctx:      ctx,
+		svcCtx:   svcCtx,
+		viewerId: viewerId,
+		friends:  make(map[int64]bool),
+	}
+}
+
+// sees reports whether the viewer is in the audience userId picked for something
+func (v *privacyViewer) sees(userId int64, audience int64) bool {
+	switch {
+	case userId == v.viewerId:
+		return true
+	case audience == model.AudienceEveryone:
+		return true
+	case audience != model.AudienceFriends || v.viewerId == 0:
+		return false
+	}
+	friend, ok := v.friends[userId]
+	if !ok {
+		resp, err := v.svcCtx.RelationRpc.CheckFriend(v.ctx, &pb.CheckFriendRequest{UserId: userId, FriendId: v.viewerId})
+		if err != nil {
+			// Shown to fewer people rather than to more
+			logx.WithContext(v.ctx).Errorf("failed to check friendship of %d and %d: %v", userId, v.viewerId, err)
+			return false
+		}
+		friend = resp.IsFriend && !resp.IsBlocked
+		v.friends[userId] = friend
+	}
+	return friend
+}
+
+// maskProfile clears the fields of u the viewer may not see
+func (v *privacyViewer) maskProfile(u *pb.User, p *model.UserPrivacy) {
+	if !v.sees(u.Id, p.PhoneVisibility) {
+		u.Phone = ""
+		u.PhoneVerified = false
+	}
+	if !v.sees(u.Id, p.EmailVisibility) {
+		u.Email = ""
+		u.EmailVerified = false
+	}
+	if !v.sees(u.Id, p.GenderVisibility) {
+		u.Gender = 0
+	}
+}
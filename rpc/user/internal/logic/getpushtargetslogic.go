@@ -0,0 +1,74 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPushTargetsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetPushTargetsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPushTargetsLogic {
+	return &GetPushTargetsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetPushTargets returns the push tokens and notify settings of offline recipients.
+func (l *GetPushTargetsLogic) GetPushTargets(in *pb.GetPushTargetsRequest) (*pb.GetPushTargetsResponse, error) {
+	devices, err := l.svcCtx.UserDeviceModel.FindPushTargetsByUserIds(l.ctx, in.UserIds)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to find devices: "+err.Error())
+	}
+	if len(devices) == 0 {
+		return &pb.GetPushTargetsResponse{
+			Base: &pb.BaseResponse{Code: 200, Message: "Success"},
+		}, nil
+	}
+
+	targets := make(map[int64]*pb.PushTarget)
+	var userIds []int64
+	for _, d := range devices {
+		t, ok := targets[d.UserId]
+		if !ok {
+			t = &pb.PushTarget{UserId: d.UserId, Setting: toPbNotifySetting(nil)}
+			targets[d.UserId] = t
+			userIds = append(userIds, d.UserId)
+		}
+		t.Devices = append(t.Devices, &pb.PushDevice{
+			DeviceId:  d.DeviceId,
+			Platform:  d.Platform,
+			PushToken: d.PushToken,
+		})
+	}
+
+	settings, err := l.svcCtx.NotifySettingModel.FindByUserIds(l.ctx, userIds)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to find notify settings: "+err.Error())
+	}
+	for _, s := range settings {
+		if t, ok := targets[s.UserId]; ok {
+			t.Setting = toPbNotifySetting(s)
+		}
+	}
+
+	resp := &pb.GetPushTargetsResponse{
+		Base:    &pb.BaseResponse{Code: 200, Message: "Success"},
+		Targets: make([]*pb.PushTarget, 0, len(userIds)),
+	}
+	for _, uid := range userIds {
+		resp.Targets = append(resp.Targets, targets[uid])
+	}
+	return resp, nil
+}
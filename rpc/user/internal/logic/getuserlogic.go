@@ -2,9 +2,11 @@ package logic
 
 import (
 	"context"
+	"errors"
 
 	"github.com/archyhsh/gochat/rpc/pb"
 	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -30,14 +32,39 @@ func (l *GetUserLogic) GetUser(in *pb.GetUserRequest) (*pb.GetUserResponse, erro
 	if err != nil {
 		return nil, status.Error(codes.NotFound, "invalid user!")
 	}
+	// Closed accounts are hidden like deleted ones
+	if user.Status == model.UserStatusDeactivated || user.Status == model.UserStatusDeleting {
+		return nil, status.Error(codes.NotFound, "invalid user!")
+	}
+	privacy, err := l.svcCtx.PrivacyModel.FindOneByUserId(l.ctx, user.Id)
+	if errors.Is(err, model.ErrNotFound) {
+		privacy, err = model.DefaultUserPrivacy(user.Id), nil
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to find privacy setting: "+err.Error())
+	}
+
+	res := &pb.User{
+		Id:          user.Id,
+		Username:    user.Username,
+		Nickname:    user.Nickname,
+		Avatar:      user.Avatar,
+		Phone:       user.Phone,
+		Email:       user.Email,
+		Gender:      int32(user.Gender),
+		InfoVersion: user.InfoVersion,
+	}
+	// Profile fields are shown as the user allows to the caller; other services see what everyone sees
+	viewerId := callerId(l.ctx)
+	newPrivacyViewer(l.ctx, l.svcCtx, viewerId).maskProfile(res, privacy)
+	if viewerId != user.Id {
+		res.FriendRequest = pb.FriendRequestPolicy(privacy.FriendRequest)
+		if privacy.FriendRequest == model.FriendRequestQuestion {
+			res.FriendQuestion = privacy.FriendQuestion
+		}
+	}
 	return &pb.GetUserResponse{
 		Base: &pb.BaseResponse{Code: 200},
-		User: &pb.User{
-			Id:       user.Id,
-			Username: user.Username,
-			Nickname: user.Nickname,
-			Phone:    user.Phone,
-			Email:    user.Email,
-		},
+		User: res,
 	}, nil
 }
@@ -0,0 +1,74 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// kickReasons is the text of the close frame sent for each reason
+var kickReasons = map[pb.KickReason]string{
+	pb.KickReason_KICK_REASON_UNSPECIFIED:         "disconnected by the server",
+	pb.KickReason_KICK_REASON_PASSWORD_CHANGED:    "password changed",
+	pb.KickReason_KICK_REASON_BANNED:              "account banned",
+	pb.KickReason_KICK_REASON_LOGGED_IN_ELSEWHERE: "logged in elsewhere",
+	pb.KickReason_KICK_REASON_DEVICE_REMOVED:      "device removed",
+	pb.KickReason_KICK_REASON_LOGGED_OUT:          "logged out",
+	pb.KickReason_KICK_REASON_SESSION_REVOKED:     "session revoked",
+	pb.KickReason_KICK_REASON_ACCOUNT_CLOSED:      "account closed",
+}
+
+type KickUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewKickUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *KickUserLogic {
+	return &KickUserLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Admin: close the connections of a user, or of one device, on every gateway
+func (l *KickUserLogic) KickUser(in *pb.KickUserRequest) (*pb.KickUserResponse, error) {
+	if in.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if in.Revoke {
+		var err error
+		if in.DeviceId != "" {
+			err = l.svcCtx.Sessions.Revoke(l.ctx, in.UserId, in.DeviceId)
+		} else {
+			err = l.svcCtx.Sessions.RevokeAll(l.ctx, in.UserId)
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to revoke sessions: "+err.Error())
+		}
+	}
+	if err := kickUser(l.ctx, l.svcCtx, in.UserId, in.DeviceId, in.Reason); err != nil {
+		return nil, status.Error(codes.Unavailable, "failed to reach gateway: "+err.Error())
+	}
+	return &pb.KickUserResponse{
+		Base: &pb.BaseResponse{Code: 200, Message: "Success"},
+	}, nil
+}
+
+// kickUser disconnects the user (or just deviceId) wherever they are connected. Callers that
+// revoked the session first may ignore the error: a revoked device that stays connected is
+// cut off at its next heartbeat.
+func kickUser(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, deviceId string, reason pb.KickReason) error {
+	return svcCtx.Kicker.Kick(ctx, &pb.KickRequest{
+		UserId:   userId,
+		DeviceId: deviceId,
+		Code:     reason,
+		Reason:   kickReasons[reason],
+	})
+}
@@ -0,0 +1,68 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListDevicesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListDevicesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListDevicesLogic {
+	return &ListDevicesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListDevices returns the devices the caller is signed in on.
+func (l *ListDevicesLogic) ListDevices(in *pb.ListDevicesRequest) (*pb.ListDevicesResponse, error) {
+	md, ok := metadata.FromIncomingContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	userIdStrs := md.Get("user_id")
+	if len(userIdStrs) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "user_id not found in metadata")
+	}
+	userId, err := strconv.ParseInt(userIdStrs[0], 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid user_id in metadata")
+	}
+	var currentDevice string
+	if ids := md.Get("device_id"); len(ids) > 0 {
+		currentDevice = ids[0]
+	}
+
+	devices, err := l.svcCtx.UserDeviceModel.FindByUserId(l.ctx, userId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to find devices: "+err.Error())
+	}
+	lastActive, err := l.svcCtx.Sessions.LastActive(l.ctx, userId)
+	if err != nil {
+		l.Errorf("failed to load last active times of user %d: %v", userId, err)
+	}
+
+	resp := &pb.ListDevicesResponse{
+		Base:    &pb.BaseResponse{Code: 200, Message: "Success"},
+		Devices: make([]*pb.Device, 0, len(devices)),
+	}
+	for _, d := range devices {
+		device := toPbDevice(d, lastActive)
+		device.Current = d.DeviceId == currentDevice
+		resp.Devices = append(resp.Devices, device)
+	}
+	return resp, nil
+}
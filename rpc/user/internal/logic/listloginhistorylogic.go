@@ -0,0 +1,75 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	defaultLoginHistoryLimit = 20
+	maxLoginHistoryLimit     = 100
+)
+
+type ListLoginHistoryLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListLoginHistoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListLoginHistoryLogic {
+	return &ListLoginHistoryLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListLoginHistory lets users review recent sign-ins on their account, rejected ones included.
+func (l *ListLoginHistoryLogic) ListLoginHistory(in *pb.ListLoginHistoryRequest) (*pb.ListLoginHistoryResponse, error) {
+	md, ok := metadata.FromIncomingContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	userIdStrs := md.Get("user_id")
+	if len(userIdStrs) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "user_id not found in metadata")
+	}
+	userId, err := strconv.ParseInt(userIdStrs[0], 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid user_id in metadata")
+	}
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = defaultLoginHistoryLimit
+	}
+	limit = min(limit, maxLoginHistoryLimit)
+
+	rows, err := l.svcCtx.LoginHistoryModel.FindRecentByUserId(l.ctx, userId, in.BeforeId, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list login history: "+err.Error())
+	}
+	records := make([]*pb.LoginRecord, 0, len(rows))
+	for _, r := range rows {
+		records = append(records, &pb.LoginRecord{
+			Id:        r.Id,
+			DeviceId:  r.DeviceId,
+			Platform:  r.Platform,
+			Ip:        r.Ip,
+			Success:   r.Success == 1,
+			Reason:    r.Reason,
+			CreatedAt: r.CreatedAt.Unix(),
+		})
+	}
+	return &pb.ListLoginHistoryResponse{
+		Base:    &pb.BaseResponse{Code: 200, Message: "Success"},
+		Records: records,
+	}, nil
+}
@@ -0,0 +1,40 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListOidcProvidersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListOidcProvidersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListOidcProvidersLogic {
+	return &ListOidcProvidersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListOidcProviders returns the providers shown as sign-in buttons, in configuration order.
+func (l *ListOidcProvidersLogic) ListOidcProviders(in *pb.ListOidcProvidersRequest) (*pb.ListOidcProvidersResponse, error) {
+	providers := make([]*pb.OidcProvider, 0, len(l.svcCtx.Config.OIDC.Providers))
+	for _, pc := range l.svcCtx.Config.OIDC.Providers {
+		p := l.svcCtx.OidcProviders[pc.Name]
+		providers = append(providers, &pb.OidcProvider{
+			Name:        p.Name(),
+			DisplayName: p.DisplayName(),
+		})
+	}
+	return &pb.ListOidcProvidersResponse{
+		Base:      &pb.BaseResponse{Code: 200, Message: "Success"},
+		Providers: providers,
+	}, nil
+}
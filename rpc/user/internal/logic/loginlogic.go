@@ -2,9 +2,14 @@ package logic
 
 import (
 	"context"
+	"errors"
+	"sync"
+	"time"
 
+	"github.com/archyhsh/gochat/pkg/auth"
 	"github.com/archyhsh/gochat/rpc/pb"
 	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -26,21 +31,139 @@ func NewLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LoginLogic
 	}
 }
 
+// errBadCredentials is returned for an unknown username and a wrong password alike
+var errBadCredentials = status.Error(codes.Unauthenticated, "Invalid username or password")
+
+// dummyPasswordHash is compared against for unknown usernames, so they take as long to reject
+// as wrong passwords
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte(auth.NewTokenID()), bcrypt.DefaultCost)
+	return hash
+})
+
+// Reasons recorded for rejected sign-ins
+const (
+	loginReasonBadPassword = "bad_password"
+	loginReasonLockedOut   = "locked_out"
+	loginReasonBanned      = "banned"
+	loginReasonBadMfaCode  = "bad_mfa_code"
+	loginReasonDeactivated = "deactivated"
+)
+
 func (l *LoginLogic) Login(in *pb.LoginRequest) (*pb.LoginResponse, error) {
-	user, err := l.svcCtx.UserModel.FindOneByUsername(l.ctx, in.Username)
+	if len(in.DeviceId) > 100 {
+		return nil, status.Error(codes.InvalidArgument, "device_id too long")
+	}
+	wait, err := l.svcCtx.LoginGuard.Check(l.ctx, in.Username, in.Ip)
 	if err != nil {
-		return nil, status.Error(codes.NotFound, "Cannot find username")
+		l.Errorf("failed to check sign-in attempts of %q: %v", in.Username, err)
+	}
+	user, err := l.svcCtx.UserModel.FindOneByUsername(l.ctx, in.Username)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return nil, status.Error(codes.Internal, "system error")
+	}
+	if wait > 0 {
+		if user != nil {
+			l.recordLogin(user.Id, in.DeviceId, in, loginReasonLockedOut)
+		}
+		return nil, lockedOut(wait)
+	}
+
+	if user == nil {
+		_ = bcrypt.CompareHashAndPassword(dummyPasswordHash(), []byte(in.Password))
+		return nil, l.fail(in)
 	}
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(in.Password)); err != nil {
-		return nil, status.Error(codes.Unauthenticated, "Invalid password")
+		l.recordLogin(user.Id, in.DeviceId, in, loginReasonBadPassword)
+		return nil, l.fail(in)
+	}
+	if reason, err := closedAccount(l.ctx, l.svcCtx, user); err != nil {
+		l.recordLogin(user.Id, in.DeviceId, in, reason)
+		return nil, err
+	}
+	deviceId := in.DeviceId
+	if deviceId == "" {
+		deviceId = auth.NewTokenID()
+	}
+	return continueLogin(l.ctx, l.svcCtx, user, deviceId, in.Platform, in.Ip)
+}
+
+// closedAccount rejects signing in to a banned account or one its owner closed, and returns the
+// reason to record. A temporary ban that ran out is lifted on the way.
+func closedAccount(ctx context.Context, svcCtx *svc.ServiceContext, user *model.User) (string, error) {
+	switch user.Status {
+	case model.UserStatusBanned:
+		if err := bannedAccount(ctx, svcCtx, user); err != nil {
+			return loginReasonBanned, err
+		}
+	case model.UserStatusDeactivated:
+		return loginReasonDeactivated, status.Error(codes.FailedPrecondition, "Account is deactivated, reactivate it to sign in")
+	case model.UserStatusDeleting:
+		return loginReasonDeactivated, status.Error(codes.FailedPrecondition, "Account is scheduled for deletion, reactivate it to keep it")
 	}
-	Token, err := l.svcCtx.JwtManager.GenerateToken(user.Id, user.Username)
+	return "", nil
+}
+
+// continueLogin moves a sign-in past its first factor, a password or a provider's ID token. With
+// 2FA on, or required but not set up yet, that only earns a pending token for the second step.
+func continueLogin(ctx context.Context, svcCtx *svc.ServiceContext, user *model.User, deviceId string, platform pb.DevicePlatform, ip string) (*pb.LoginResponse, error) {
+	mfa, err := svcCtx.MfaModel.FindOneByUserId(ctx, user.Id)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return nil, status.Error(codes.Internal, "system error")
+	}
+	enabled := mfa != nil && mfa.Enabled == 1
+	if !enabled && !isMfaAdmin(svcCtx, user.Username) {
+		return completeLogin(ctx, svcCtx, user, deviceId, platform, ip)
+	}
+	token, err := svcCtx.Sessions.CreateMFAChallenge(ctx, &auth.MFAChallenge{
+		UserID:   user.Id,
+		Username: user.Username,
+		DeviceID: deviceId,
+		Platform: int32(platform),
+		IP:       ip,
+		Enroll:   !enabled,
+	}, svcCtx.Config.MFA.PendingTTL)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "Failed to generate token")
+		return nil, status.Error(codes.Internal, "Failed to create session")
 	}
 	return &pb.LoginResponse{
-		Base:  &pb.BaseResponse{Code: 200, Message: "Login successful"},
-		Token: Token,
+		Base:                  &pb.BaseResponse{Code: 200, Message: "Second factor required"},
+		DeviceId:              deviceId,
+		MfaRequired:           true,
+		MfaToken:              token,
+		MfaEnrollmentRequired: !enabled,
+	}, nil
+}
+
+// completeLogin signs the device in once every factor was checked: it clears the failed attempts,
+// registers the device and issues the access and refresh tokens.
+func completeLogin(ctx context.Context, svcCtx *svc.ServiceContext, user *model.User, deviceId string, platform pb.DevicePlatform, ip string) (*pb.LoginResponse, error) {
+	if err := svcCtx.LoginGuard.Succeed(ctx, user.Username); err != nil {
+		logx.WithContext(ctx).Errorf("failed to reset sign-in attempts of user %d: %v", user.Id, err)
+	}
+	device, err := saveDevice(ctx, svcCtx, user.Id, deviceId, platform, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to register device")
+	}
+	Token, err := issueAccessToken(ctx, svcCtx, user.Id, user.Username, deviceId, device.Platform, true)
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := svcCtx.Sessions.IssueRefresh(ctx, &auth.RefreshSession{
+		UserID:   user.Id,
+		DeviceID: deviceId,
+		Platform: device.Platform,
+	}, refreshTTL(svcCtx))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to create session")
+	}
+	recordLogin(ctx, svcCtx, user.Id, deviceId, platform, ip, "")
+	return &pb.LoginResponse{
+		Base:         &pb.BaseResponse{Code: 200, Message: "Login successful"},
+		Token:        Token,
+		DeviceId:     deviceId,
+		RefreshToken: refreshToken,
+		ExpiresIn:    svcCtx.Config.JWT.AccessExpire,
 		User: &pb.User{
 			Id:       user.Id,
 			Username: user.Username,
@@ -52,3 +175,70 @@ func (l *LoginLogic) Login(in *pb.LoginRequest) (*pb.LoginResponse, error) {
 		},
 	}, nil
 }
+
+// issueAccessToken signs an access token for the device at the user's current token version and
+// binds it to the device. Signing in again on a device replaces whatever token it held before,
+// and with kickReplaced the connection of that older sign-in is closed; a refresh only rotates
+// the device's own token, so it keeps the connection.
+func issueAccessToken(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, username, deviceId, platform string, kickReplaced bool) (string, error) {
+	version, err := svcCtx.Sessions.Version(ctx, userId)
+	if err != nil {
+		return "", status.Error(codes.Internal, "Failed to create session")
+	}
+	token, tokenId, err := svcCtx.JwtManager.GenerateToken(userId, username, deviceId, platform, version)
+	if err != nil {
+		return "", status.Error(codes.Internal, "Failed to generate token")
+	}
+	replaced, err := svcCtx.Sessions.Bind(ctx, userId, deviceId, tokenId, refreshTTL(svcCtx))
+	if err != nil {
+		return "", status.Error(codes.Internal, "Failed to create session")
+	}
+	if replaced && kickReplaced {
+		if err := kickUser(ctx, svcCtx, userId, deviceId, pb.KickReason_KICK_REASON_LOGGED_IN_ELSEWHERE); err != nil {
+			logx.WithContext(ctx).Errorf("failed to disconnect replaced session of device %s: %v", deviceId, err)
+		}
+	}
+	return token, nil
+}
+
+func refreshTTL(svcCtx *svc.ServiceContext) time.Duration {
+	return time.Duration(svcCtx.Config.JWT.RefreshExpire) * time.Second
+}
+
+// fail counts a rejected attempt against the account and IP
+func (l *LoginLogic) fail(in *pb.LoginRequest) error {
+	wait, err := l.svcCtx.LoginGuard.Fail(l.ctx, in.Username, in.Ip)
+	if err != nil {
+		l.Errorf("failed to count sign-in attempt of %q: %v", in.Username, err)
+	}
+	if wait > 0 {
+		return lockedOut(wait)
+	}
+	return errBadCredentials
+}
+
+func lockedOut(wait time.Duration) error {
+	return status.Errorf(codes.ResourceExhausted, "Too many failed sign-in attempts, try again in %s", wait.Round(time.Second))
+}
+
+// recordLogin adds the attempt to the user's login history; reason is empty for a success.
+func (l *LoginLogic) recordLogin(userId int64, deviceId string, in *pb.LoginRequest, reason string) {
+	recordLogin(l.ctx, l.svcCtx, userId, deviceId, in.Platform, in.Ip, reason)
+}
+
+func recordLogin(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, deviceId string, platform pb.DevicePlatform, ip, reason string) {
+	var success int64
+	if reason == "" {
+		success = 1
+	}
+	if _, err := svcCtx.LoginHistoryModel.Insert(ctx, &model.UserLoginHistory{
+		UserId:   userId,
+		DeviceId: deviceId,
+		Platform: platformName(platform),
+		Ip:       ip,
+		Success:  success,
+		Reason:   reason,
+	}); err != nil {
+		logx.WithContext(ctx).Errorf("failed to record sign-in of user %d: %v", userId, err)
+	}
+}
@@ -0,0 +1,71 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/archyhsh/gochat/pkg/auth"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type LogoutLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewLogoutLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LogoutLogic {
+	return &LogoutLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Logout signs the caller's device out: its access and refresh tokens stop being accepted and
+// its live connection is closed. The device comes from the request, or from the refresh token.
+func (l *LogoutLogic) Logout(in *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	md, ok := metadata.FromIncomingContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	userIdStrs := md.Get("user_id")
+	if len(userIdStrs) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "user_id not found in metadata")
+	}
+	userId, err := strconv.ParseInt(userIdStrs[0], 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid user_id in metadata")
+	}
+
+	deviceId := in.DeviceId
+	if deviceId == "" && in.RefreshToken != "" {
+		sess, err := l.svcCtx.Sessions.RefreshOwner(l.ctx, in.RefreshToken)
+		if err != nil && !errors.Is(err, auth.ErrInvalidRefreshToken) {
+			return nil, status.Error(codes.Internal, "failed to find session: "+err.Error())
+		}
+		if sess != nil && sess.UserID == userId {
+			deviceId = sess.DeviceID
+		}
+	}
+	if deviceId == "" {
+		return nil, status.Error(codes.InvalidArgument, "device_id is required")
+	}
+
+	if err := l.svcCtx.Sessions.Revoke(l.ctx, userId, deviceId); err != nil {
+		return nil, status.Error(codes.Internal, "failed to revoke session: "+err.Error())
+	}
+	if err := kickUser(l.ctx, l.svcCtx, userId, deviceId, pb.KickReason_KICK_REASON_LOGGED_OUT); err != nil {
+		l.Errorf("failed to disconnect device %s of user %d: %v", deviceId, userId, err)
+	}
+	return &pb.LogoutResponse{
+		Base: &pb.BaseResponse{Code: 200, Message: "Logged out"},
+	}, nil
+}
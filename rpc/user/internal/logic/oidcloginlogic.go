@@ -0,0 +1,198 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/archyhsh/gochat/pkg/auth"
+	"github.com/archyhsh/gochat/pkg/oidc"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxUsernameLen leaves room for the suffix added when a derived username is taken
+const maxUsernameLen = 40
+
+type OidcLoginLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewOidcLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *OidcLoginLogic {
+	return &OidcLoginLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// OidcLogin finishes a sign-in at an identity provider: it redeems the code for a verified ID
+// token and signs in the account linked to it, linking or creating one on first use.
+func (l *OidcLoginLogic) OidcLogin(in *pb.OidcLoginRequest) (*pb.LoginResponse, error) {
+	st, err := l.svcCtx.OidcStates.Take(l.ctx, in.State)
+	if errors.Is(err, oidc.ErrInvalidState) {
+		return nil, status.Error(codes.Unauthenticated, "Sign-in expired, please try again")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "system error")
+	}
+	provider, ok := l.svcCtx.OidcProviders[st.Provider]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "unknown identity provider")
+	}
+	idToken, err := provider.Exchange(l.ctx, in.Code, st.CodeVerifier, st.Nonce)
+	if err != nil {
+		l.Errorf("sign-in at %s failed: %v", st.Provider, err)
+		return nil, status.Error(codes.Unauthenticated, "Sign-in at the identity provider failed")
+	}
+
+	user, err := l.linkedUser(st.Provider, idToken)
+	if err != nil {
+		return nil, err
+	}
+	deviceId := st.DeviceID
+	if deviceId == "" {
+		deviceId = auth.NewTokenID()
+	}
+	platform := pb.DevicePlatform(st.Platform)
+	if reason, err := closedAccount(l.ctx, l.svcCtx, user); err != nil {
+		recordLogin(l.ctx, l.svcCtx, user.Id, deviceId, platform, in.Ip, reason)
+		return nil, err
+	}
+	return continueLogin(l.ctx, l.svcCtx, user, deviceId, platform, in.Ip)
+}
+
+// linkedUser returns the account of the provider's subject. On first sign-in it links the one
+// account that verified the same email, or creates an account when there is none.
+func (l *OidcLoginLogic) linkedUser(provider string, idToken *oidc.IDToken) (*model.User, error) {
+	identity, err := l.svcCtx.IdentityModel.FindOneByProviderSubject(l.ctx, provider, idToken.Subject)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return nil, status.Error(codes.Internal, "system error")
+	}
+	email := ""
+	if idToken.EmailVerified {
+		email = strings.ToLower(strings.TrimSpace(idToken.Email))
+	}
+	if identity != nil {
+		if email != "" && identity.Email != email {
+			identity.Email = email
+			if err := l.svcCtx.IdentityModel.Update(l.ctx, identity); err != nil {
+				l.Errorf("failed to update email of identity %d: %v", identity.Id, err)
+			}
+		}
+		user, err := l.svcCtx.UserModel.FindOne(l.ctx, identity.UserId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "system error")
+		}
+		return user, nil
+	}
+
+	var user *model.User
+	if email != "" {
+		// Only accounts that proved they own the address, so nobody can claim an employee's
+		// sign-in by putting their email on a local account
+		users, err := l.svcCtx.UserModel.FindByVerifiedEmail(l.ctx, email, 2)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "system error")
+		}
+		if len(users) > 1 {
+			return nil, status.Error(codes.FailedPrecondition, "Several accounts use this email, sign in with your password")
+		}
+		if len(users) == 1 {
+			user = users[0]
+		}
+	}
+	if user == nil {
+		if user, err = l.provision(idToken, email); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := l.svcCtx.IdentityModel.Insert(l.ctx, &model.UserIdentity{
+		UserId:   user.Id,
+		Provider: provider,
+		Subject:  idToken.Subject,
+		Email:    email,
+	}); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to link account")
+	}
+	l.Infof("linked %s identity %s to user %d", provider, idToken.Subject, user.Id)
+	return user, nil
+}
+
+// provision creates an account for a first-time user of the provider. It has a random password
+// until the user sets one through a password reset.
+func (l *OidcLoginLogic) provision(idToken *oidc.IDToken, email string) (*model.User, error) {
+	username, err := l.freeUsername(idToken)
+	if err != nil {
+		return nil, err
+	}
+	nickname := idToken.Name
+	if nickname == "" {
+		nickname = username
+	}
+	for utf8.RuneCountInString(nickname) > 50 {
+		_, size := utf8.DecodeLastRuneInString(nickname)
+		nickname = nickname[:len(nickname)-size]
+	}
+	password, _ := bcrypt.GenerateFromPassword([]byte(auth.NewTokenID()), bcrypt.DefaultCost)
+	user := &model.User{
+		Username: username,
+		Nickname: nickname,
+		Password: string(password),
+		Status:   1,
+	}
+	if email != "" {
+		user.Email = email
+		user.EmailVerified = 1
+	}
+	res, err := l.svcCtx.UserModel.Insert(l.ctx, user)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to create user")
+	}
+	user.Id, _ = res.LastInsertId()
+	return user, nil
+}
+
+// freeUsername derives an unused username from the token's preferred username or email
+func (l *OidcLoginLogic) freeUsername(idToken *oidc.IDToken) (string, error) {
+	base := idToken.PreferredUsername
+	if base == "" {
+		base, _, _ = strings.Cut(idToken.Email, "@")
+	}
+	base = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_', r == '.', r == '-':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + ('a' - 'A')
+		}
+		return -1
+	}, base)
+	if len(base) > maxUsernameLen {
+		base = base[:maxUsernameLen]
+	}
+	if base == "" {
+		base = "user"
+	}
+	candidate := base
+	for range 5 {
+		_, err := l.svcCtx.UserModel.FindOneByUsername(l.ctx, candidate)
+		if errors.Is(err, model.ErrNotFound) {
+			return candidate, nil
+		}
+		if err != nil {
+			return "", status.Error(codes.Internal, "system error")
+		}
+		candidate = base + "_" + auth.NewTokenID()[:6]
+	}
+	return "", status.Error(codes.Internal, "Failed to pick a username")
+}
@@ -0,0 +1,132 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReactivateAccountLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReactivateAccountLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReactivateAccountLogic {
+	return &ReactivateAccountLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ReactivateAccount reopens a deactivated account, or one whose deletion is still in its grace
+// period, which cancels the deletion. The user proves the account like at sign-in and signs in
+// afterwards.
+func (l *ReactivateAccountLogic) ReactivateAccount(in *pb.ReactivateAccountRequest) (*pb.ReactivateAccountResponse, error) {
+	wait, err := l.svcCtx.LoginGuard.Check(l.ctx, in.Username, in.Ip)
+	if err != nil {
+		l.Errorf("failed to check sign-in attempts of %q: %v", in.Username, err)
+	}
+	if wait > 0 {
+		return nil, lockedOut(wait)
+	}
+	user, err := l.svcCtx.UserModel.FindOneByUsername(l.ctx, in.Username)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return nil, status.Error(codes.Internal, "system error")
+	}
+	if user == nil {
+		_ = bcrypt.CompareHashAndPassword(dummyPasswordHash(), []byte(in.Password))
+		return nil, l.fail(in, errBadCredentials)
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(in.Password)); err != nil {
+		return nil, l.fail(in, errBadCredentials)
+	}
+	mfa, err := l.svcCtx.MfaModel.FindOneByUserId(l.ctx, user.Id)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return nil, status.Error(codes.Internal, "system error")
+	}
+	if mfa != nil && mfa.Enabled == 1 {
+		if in.Code == "" && in.RecoveryCode == "" {
+			return nil, status.Error(codes.InvalidArgument, "code or recovery_code is required")
+		}
+		ok, err := checkSecondFactor(l.ctx, l.svcCtx, mfa, in.Code, in.RecoveryCode)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "system error")
+		}
+		if !ok {
+			return nil, l.fail(in, status.Error(codes.Unauthenticated, "Invalid verification code"))
+		}
+	}
+
+	switch user.Status {
+	case model.UserStatusBanned:
+		return nil, status.Error(codes.PermissionDenied, "Account is banned")
+	case model.UserStatusNormal:
+		return nil, status.Error(codes.FailedPrecondition, "Account is already active")
+	case model.UserStatusDeleting:
+		if err := l.cancelDeletion(user.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	user.Status = model.UserStatusNormal
+	user.InfoVersion = time.Now().UnixNano()
+	if err := l.svcCtx.UserModel.Update(l.ctx, user); err != nil {
+		return nil, status.Error(codes.Internal, "failed to update user status")
+	}
+	if err := l.svcCtx.LoginGuard.Succeed(l.ctx, user.Username); err != nil {
+		l.Errorf("failed to reset sign-in attempts of user %d: %v", user.Id, err)
+	}
+	if err := publishUserEvent(l.ctx, l.svcCtx, user.Id, "reactivated", user.InfoVersion); err != nil {
+		l.Errorf("failed to publish reactivation of user %d: %v", user.Id, err)
+	}
+	return &pb.ReactivateAccountResponse{
+		Base: &pb.BaseResponse{Code: 200, Message: "Account reactivated"},
+	}, nil
+}
+
+// cancelDeletion calls off the user's deletion, which is too late once its grace period is over
+func (l *ReactivateAccountLogic) cancelDeletion(userId int64) error {
+	deletion, err := l.svcCtx.DeletionModel.FindOneByUserId(l.ctx, userId)
+	if errors.Is(err, model.ErrNotFound) {
+		// Closed, but the deletion was never scheduled
+		return nil
+	}
+	if err != nil {
+		return status.Error(codes.Internal, "system error")
+	}
+	if deletion.Status == model.DeletionCancelled {
+		return nil
+	}
+	ok, err := l.svcCtx.DeletionModel.Cancel(l.ctx, deletion, time.Now())
+	if err != nil {
+		return status.Error(codes.Internal, "failed to cancel deletion")
+	}
+	if !ok {
+		return status.Error(codes.FailedPrecondition, "Account deletion is already under way")
+	}
+	l.Infof("deletion of user %d cancelled", userId)
+	return nil
+}
+
+// fail counts a rejected attempt against the account and IP like a failed sign-in
+func (l *ReactivateAccountLogic) fail(in *pb.ReactivateAccountRequest, rejected error) error {
+	wait, err := l.svcCtx.LoginGuard.Fail(l.ctx, in.Username, in.Ip)
+	if err != nil {
+		l.Errorf("failed to count sign-in attempt of %q: %v", in.Username, err)
+	}
+	if wait > 0 {
+		return lockedOut(wait)
+	}
+	return rejected
+}
@@ -0,0 +1,69 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"github.com/archyhsh/gochat/pkg/auth"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RefreshTokenLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRefreshTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RefreshTokenLogic {
+	return &RefreshTokenLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RefreshToken exchanges a refresh token for a new access token and a new refresh token. A
+// refresh token that was already exchanged once signs its device out.
+func (l *RefreshTokenLogic) RefreshToken(in *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	sess, refreshToken, err := l.svcCtx.Sessions.Rotate(l.ctx, in.RefreshToken, refreshTTL(l.svcCtx))
+	if errors.Is(err, auth.ErrRefreshTokenReused) && sess != nil {
+		l.Infof("refresh token of device %s of user %d reused, session revoked", sess.DeviceID, sess.UserID)
+		if err := kickUser(l.ctx, l.svcCtx, sess.UserID, sess.DeviceID, pb.KickReason_KICK_REASON_SESSION_REVOKED); err != nil {
+			l.Errorf("failed to disconnect revoked device %s of user %d: %v", sess.DeviceID, sess.UserID, err)
+		}
+		return nil, status.Error(codes.Unauthenticated, "refresh token reused")
+	}
+	if errors.Is(err, auth.ErrInvalidRefreshToken) || errors.Is(err, auth.ErrRefreshTokenReused) {
+		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to refresh session: "+err.Error())
+	}
+
+	user, err := l.svcCtx.UserModel.FindOne(l.ctx, sess.UserID)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+	}
+	if _, err := closedAccount(l.ctx, l.svcCtx, user); err != nil {
+		if err := l.svcCtx.Sessions.Revoke(l.ctx, user.Id, sess.DeviceID); err != nil {
+			l.Errorf("failed to revoke device %s of closed account %d: %v", sess.DeviceID, user.Id, err)
+		}
+		return nil, err
+	}
+
+	token, err := issueAccessToken(l.ctx, l.svcCtx, user.Id, user.Username, sess.DeviceID, sess.Platform, false)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RefreshTokenResponse{
+		Base:         &pb.BaseResponse{Code: 200, Message: "Success"},
+		Token:        token,
+		RefreshToken: refreshToken,
+		ExpiresIn:    l.svcCtx.Config.JWT.AccessExpire,
+	}, nil
+}
@@ -0,0 +1,145 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RegisterDeviceLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRegisterDeviceLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RegisterDeviceLogic {
+	return &RegisterDeviceLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RegisterDevice updates the platform and push token of the device the caller signed in on.
+func (l *RegisterDeviceLogic) RegisterDevice(in *pb.RegisterDeviceRequest) (*pb.RegisterDeviceResponse, error) {
+	md, ok := metadata.FromIncomingContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	userIdStrs := md.Get("user_id")
+	if len(userIdStrs) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "user_id not found in metadata")
+	}
+	userId, err := strconv.ParseInt(userIdStrs[0], 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid user_id in metadata")
+	}
+	deviceIds := md.Get("device_id")
+	if len(deviceIds) == 0 || deviceIds[0] == "" {
+		return nil, status.Error(codes.Unauthenticated, "device_id not found in metadata")
+	}
+	if len(in.PushToken) > 255 {
+		return nil, status.Error(codes.InvalidArgument, "push_token too long")
+	}
+
+	pushToken := in.PushToken
+	device, err := saveDevice(l.ctx, l.svcCtx, userId, deviceIds[0], in.Platform, &pushToken)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to save device: "+err.Error())
+	}
+	resp := toPbDevice(device, nil)
+	resp.Current = true
+	return &pb.RegisterDeviceResponse{
+		Base:   &pb.BaseResponse{Code: 200, Message: "Success"},
+		Device: resp,
+	}, nil
+}
+
+// saveDevice creates or refreshes a device row. An unspecified platform keeps the stored one,
+// and a nil pushToken keeps the stored token.
+func saveDevice(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, deviceId string, platform pb.DevicePlatform, pushToken *string) (*model.UserDevice, error) {
+	for i := 0; i < 2; i++ {
+		device, err := svcCtx.UserDeviceModel.FindOneByUserIdDeviceId(ctx, userId, deviceId)
+		if err != nil && !errors.Is(err, model.ErrNotFound) {
+			return nil, err
+		}
+		if device == nil {
+			device = &model.UserDevice{
+				UserId:       userId,
+				DeviceId:     deviceId,
+				Platform:     platformName(platform),
+				LastActiveAt: time.Now(),
+			}
+			if pushToken != nil {
+				device.PushToken = *pushToken
+			}
+			if _, err := svcCtx.UserDeviceModel.Insert(ctx, device); err != nil {
+				// Raced with another sign-in on the same device: update the row it created
+				continue
+			}
+			return device, nil
+		}
+
+		if platform != pb.DevicePlatform_PLATFORM_UNSPECIFIED {
+			device.Platform = platformName(platform)
+		}
+		if pushToken != nil {
+			device.PushToken = *pushToken
+		}
+		device.LastActiveAt = time.Now()
+		if err := svcCtx.UserDeviceModel.Update(ctx, device); err != nil {
+			return nil, err
+		}
+		return device, nil
+	}
+	return nil, errors.New("device changed concurrently")
+}
+
+var platformNames = map[pb.DevicePlatform]string{
+	pb.DevicePlatform_PLATFORM_WEB:     "web",
+	pb.DevicePlatform_PLATFORM_IOS:     "ios",
+	pb.DevicePlatform_PLATFORM_ANDROID: "android",
+	pb.DevicePlatform_PLATFORM_DESKTOP: "desktop",
+}
+
+// platformName is the value stored in user_device.platform and in tokens
+func platformName(p pb.DevicePlatform) string {
+	if name, ok := platformNames[p]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+func platformFromName(name string) pb.DevicePlatform {
+	for p, n := range platformNames {
+		if n == name {
+			return p
+		}
+	}
+	return pb.DevicePlatform_PLATFORM_UNSPECIFIED
+}
+
+// toPbDevice converts a stored device; lastActive holds the gateway's more recent sightings.
+func toPbDevice(d *model.UserDevice, lastActive map[string]int64) *pb.Device {
+	active := d.LastActiveAt.Unix()
+	if ts, ok := lastActive[d.DeviceId]; ok && ts > active {
+		active = ts
+	}
+	return &pb.Device{
+		DeviceId:     d.DeviceId,
+		Platform:     platformFromName(d.Platform),
+		HasPushToken: d.PushToken != "",
+		LastActiveAt: active,
+		CreatedAt:    d.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,73 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RemoveDeviceLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRemoveDeviceLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RemoveDeviceLogic {
+	return &RemoveDeviceLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RemoveDevice signs a device of the caller out: its token stops being accepted, its push token
+// is forgotten and its live connection is closed.
+func (l *RemoveDeviceLogic) RemoveDevice(in *pb.RemoveDeviceRequest) (*pb.RemoveDeviceResponse, error) {
+	md, ok := metadata.FromIncomingContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	userIdStrs := md.Get("user_id")
+	if len(userIdStrs) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "user_id not found in metadata")
+	}
+	userId, err := strconv.ParseInt(userIdStrs[0], 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid user_id in metadata")
+	}
+	if in.DeviceId == "" {
+		return nil, status.Error(codes.InvalidArgument, "device_id is required")
+	}
+
+	device, err := l.svcCtx.UserDeviceModel.FindOneByUserIdDeviceId(l.ctx, userId, in.DeviceId)
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "device not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to find device: "+err.Error())
+	}
+
+	// Revoke first: even if the rest fails the device can no longer act as the user
+	if err := l.svcCtx.Sessions.Revoke(l.ctx, userId, in.DeviceId); err != nil {
+		return nil, status.Error(codes.Internal, "failed to revoke session: "+err.Error())
+	}
+	if err := l.svcCtx.UserDeviceModel.Delete(l.ctx, device.Id); err != nil {
+		return nil, status.Error(codes.Internal, "failed to delete device: "+err.Error())
+	}
+	if err := kickUser(l.ctx, l.svcCtx, userId, in.DeviceId, pb.KickReason_KICK_REASON_DEVICE_REMOVED); err != nil {
+		l.Errorf("failed to disconnect removed device %s of user %d: %v", in.DeviceId, userId, err)
+	}
+
+	return &pb.RemoveDeviceResponse{
+		Base: &pb.BaseResponse{Code: 200, Message: "Device removed"},
+	}, nil
+}
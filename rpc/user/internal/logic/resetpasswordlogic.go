@@ -0,0 +1,84 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"github.com/archyhsh/gochat/pkg/verify"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ResetPasswordLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewResetPasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ResetPasswordLogic {
+	return &ResetPasswordLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ResetPassword sets a new password with a code from ForgotPassword and signs every device out.
+func (l *ResetPasswordLogic) ResetPassword(in *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	if in.NewPassword == "" {
+		return nil, status.Error(codes.InvalidArgument, "new_password is required")
+	}
+	user, err := l.svcCtx.UserModel.FindOneByUsername(l.ctx, in.Username)
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, status.Error(codes.InvalidArgument, verify.ErrInvalidCode.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// A code sent before the account was closed is turned down like a wrong one
+	if !resetAllowed(user) {
+		return nil, status.Error(codes.InvalidArgument, verify.ErrInvalidCode.Error())
+	}
+
+	if _, err := l.svcCtx.Codes.Verify(l.ctx, verify.PurposePasswordReset, user.Id, in.Code); err != nil {
+		return nil, codeError(err)
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(in.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to hash password")
+	}
+	user.Password = string(hashedPassword)
+	if err := l.svcCtx.UserModel.Update(l.ctx, user); err != nil {
+		return nil, status.Error(codes.Internal, "failed to update password")
+	}
+
+	// Every device signed in with the old password is signed out
+	if err := l.svcCtx.Sessions.RevokeAll(l.ctx, user.Id); err != nil {
+		l.Errorf("failed to revoke sessions of user %d: %v", user.Id, err)
+	}
+	if err := kickUser(l.ctx, l.svcCtx, user.Id, "", pb.KickReason_KICK_REASON_PASSWORD_CHANGED); err != nil {
+		l.Errorf("failed to disconnect user %d after password change: %v", user.Id, err)
+	}
+
+	return &pb.ResetPasswordResponse{
+		Base: &pb.BaseResponse{Code: 200, Message: "Success"},
+	}, nil
+}
+
+// codeError maps a failed code verification to its status
+func codeError(err error) error {
+	switch {
+	case errors.Is(err, verify.ErrInvalidCode):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, verify.ErrTooManyAttempts):
+		return status.Error(codes.ResourceExhausted, "too many attempts, request a new code")
+	}
+	return status.Error(codes.Internal, "failed to verify code: "+err.Error())
+}
@@ -2,15 +2,19 @@ package logic
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/archyhsh/gochat/rpc/pb"
 	"github.com/archyhsh/gochat/rpc/user/internal/svc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
+const maxSearchUsers = 50
+
 type SearchUsersLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -25,29 +29,47 @@ func NewSearchUsersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Searc
 	}
 }
 
+// SearchUsers finds users by nickname, username, phone or email as far as they allow it, and
+// shows each profile field only when its owner shows it to the caller.
 func (l *SearchUsersLogic) SearchUsers(in *pb.SearchUsersRequest) (*pb.SearchUsersResponse, error) {
 	if len(in.Keyword) == 0 {
 		return &pb.SearchUsersResponse{
 			Base: &pb.BaseResponse{Code: 200, Message: "Success"},
 		}, nil
 	}
+	limit := int(in.Limit)
+	if limit <= 0 || limit > maxSearchUsers {
+		limit = maxSearchUsers
+	}
 
-	users, err := l.svcCtx.UserModel.SearchUsersByName(l.ctx, in.Keyword)
+	users, err := l.svcCtx.UserModel.SearchUsers(l.ctx, in.Keyword, limit)
 	if err != nil {
 		l.Errorf("SearchUsers failed: keyword=%s, error=%v", in.Keyword, err)
 		return nil, status.Error(codes.Internal, "failed to search users")
 	}
+	userIds := make([]int64, 0, len(users))
+	for _, u := range users {
+		userIds = append(userIds, u.Id)
+	}
+	privacies, err := findPrivacies(l.ctx, l.svcCtx, userIds)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to find privacy settings: "+err.Error())
+	}
+	viewer := newPrivacyViewer(l.ctx, l.svcCtx, callerId(l.ctx))
 
 	var userSummaries []*pb.User
 	for _, u := range users {
-		userSummaries = append(userSummaries, &pb.User{
+		item := &pb.User{
 			Id:       u.Id,
+			Username: u.Username,
 			Nickname: u.Nickname,
 			Avatar:   u.Avatar,
 			Phone:    u.Phone,
 			Email:    u.Email,
 			Gender:   int32(u.Gender),
-		})
+		}
+		viewer.maskProfile(item, privacies[u.Id])
+		userSummaries = append(userSummaries, item)
 	}
 
 	return &pb.SearchUsersResponse{
@@ -55,3 +77,18 @@ func (l *SearchUsersLogic) SearchUsers(in *pb.SearchUsersRequest) (*pb.SearchUse
 		Users: userSummaries,
 	}, nil
 }
+
+// callerId returns the signed-in user a call is made for, or 0 when another service calls on its
+// own behalf
+func callerId(ctx context.Context) int64 {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0
+	}
+	userIdStrs := md.Get("user_id")
+	if len(userIdStrs) == 0 {
+		return 0
+	}
+	userId, _ := strconv.ParseInt(userIdStrs[0], 10, 64)
+	return userId
+}
@@ -0,0 +1,96 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/archyhsh/gochat/pkg/verify"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SendVerificationCodeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSendVerificationCodeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendVerificationCodeLogic {
+	return &SendVerificationCodeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SendVerificationCode sends a code proving ownership of the caller's email or phone, which
+// VerifyContact redeems. Only verified addresses can receive password reset codes.
+func (l *SendVerificationCodeLogic) SendVerificationCode(in *pb.SendVerificationCodeRequest) (*pb.SendVerificationCodeResponse, error) {
+	md, ok := metadata.FromIncomingContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	userIdStrs := md.Get("user_id")
+	if len(userIdStrs) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "user_id not found in metadata")
+	}
+	userId, err := strconv.ParseInt(userIdStrs[0], 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid user_id in metadata")
+	}
+	user, err := l.svcCtx.UserModel.FindOne(l.ctx, userId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	purpose, target, verified, err := contactToVerify(user, in.Channel)
+	if err != nil {
+		return nil, err
+	}
+	if target == "" {
+		return nil, status.Error(codes.FailedPrecondition, "no "+in.Channel+" set on the account")
+	}
+	if verified {
+		return nil, status.Error(codes.FailedPrecondition, in.Channel+" already verified")
+	}
+
+	code, err := l.svcCtx.Codes.Issue(l.ctx, purpose, userId, target)
+	if errors.Is(err, verify.ErrResendTooSoon) {
+		return nil, status.Error(codes.ResourceExhausted, "please wait before requesting another code")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to issue code: "+err.Error())
+	}
+	if err := l.svcCtx.Sender.Send(l.ctx, &verify.Message{
+		Channel: in.Channel,
+		To:      target,
+		Subject: "Verify your GoChat contact details",
+		Body:    fmt.Sprintf("Your GoChat verification code is %s. It expires in %s.", code, l.svcCtx.Config.Verification.Code.TTL),
+	}); err != nil {
+		l.Errorf("failed to send verification code to user %d over %s: %v", userId, in.Channel, err)
+		return nil, status.Error(codes.Unavailable, "failed to send code")
+	}
+
+	return &pb.SendVerificationCodeResponse{
+		Base: &pb.BaseResponse{Code: 200, Message: "Code sent"},
+	}, nil
+}
+
+// contactToVerify returns the code purpose for the channel with the user's current address on it
+func contactToVerify(user *model.User, channel string) (purpose, target string, verified bool, err error) {
+	switch channel {
+	case verify.ChannelEmail:
+		return verify.PurposeVerifyEmail, user.Email, user.EmailVerified == 1, nil
+	case verify.ChannelSMS:
+		return verify.PurposeVerifyPhone, user.Phone, user.PhoneVerified == 1, nil
+	}
+	return "", "", false, status.Error(codes.InvalidArgument, "channel must be email or sms")
+}
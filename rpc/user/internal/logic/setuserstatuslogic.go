@@ -0,0 +1,154 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const maxBanReasonLen = 255
+
+type SetUserStatusLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetUserStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetUserStatusLogic {
+	return &SetUserStatusLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Admin: ban an account, for good or until expires_at, or lift its ban. A ban signs out and
+// disconnects every device of the user.
+func (l *SetUserStatusLogic) SetUserStatus(in *pb.SetUserStatusRequest) (*pb.SetUserStatusResponse, error) {
+	if len(in.Reason) > maxBanReasonLen {
+		return nil, status.Error(codes.InvalidArgument, "reason too long")
+	}
+	user, err := l.svcCtx.UserModel.FindOne(l.ctx, in.UserId)
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	switch in.Status {
+	case model.UserStatusBanned:
+		if user.Status == model.UserStatusDeleting {
+			return nil, status.Error(codes.FailedPrecondition, "Account is scheduled for deletion")
+		}
+		var until time.Time
+		if in.ExpiresAt > 0 {
+			until = time.Unix(in.ExpiresAt, 0)
+			if !until.After(time.Now()) {
+				return nil, status.Error(codes.InvalidArgument, "expires_at is in the past")
+			}
+		}
+		if err := banUser(l.ctx, l.svcCtx, user, in.Reason, in.OperatorId, until); err != nil {
+			return nil, err
+		}
+	case model.UserStatusNormal:
+		if user.Status != model.UserStatusBanned {
+			return nil, status.Error(codes.FailedPrecondition, "Account is not banned")
+		}
+		if err := liftBan(l.ctx, l.svcCtx, user); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "status must be 0 (banned) or 1 (normal)")
+	}
+	return &pb.SetUserStatusResponse{
+		Base: &pb.BaseResponse{Code: 200, Message: "Success"},
+	}, nil
+}
+
+// banUser records the ban, shares it with the gateways so they turn the user's requests down,
+// signs out every device and tells the other services. Banning a banned user again replaces
+// the reason and expiry.
+func banUser(ctx context.Context, svcCtx *svc.ServiceContext, user *model.User, reason string, operatorId int64, until time.Time) error {
+	if err := svcCtx.BanModel.Upsert(ctx, &model.UserBan{
+		UserId:     user.Id,
+		Reason:     reason,
+		OperatorId: operatorId,
+		ExpiresAt:  sql.NullTime{Time: until, Valid: !until.IsZero()},
+	}); err != nil {
+		return status.Error(codes.Internal, "failed to record ban")
+	}
+	user.Status = model.UserStatusBanned
+	user.InfoVersion = time.Now().UnixNano()
+	if err := svcCtx.UserModel.Update(ctx, user); err != nil {
+		return status.Error(codes.Internal, "failed to update user status")
+	}
+	if err := svcCtx.Status.Ban(ctx, user.Id, reason, until); err != nil {
+		return status.Error(codes.Internal, "failed to share ban: "+err.Error())
+	}
+	if err := svcCtx.Sessions.RevokeAll(ctx, user.Id); err != nil {
+		return status.Error(codes.Internal, "failed to revoke sessions: "+err.Error())
+	}
+	if err := kickUser(ctx, svcCtx, user.Id, "", pb.KickReason_KICK_REASON_BANNED); err != nil {
+		logx.WithContext(ctx).Errorf("failed to disconnect banned user %d: %v", user.Id, err)
+	}
+	if err := publishUserEvent(ctx, svcCtx, user.Id, "banned", user.InfoVersion); err != nil {
+		logx.WithContext(ctx).Errorf("failed to publish ban of user %d: %v", user.Id, err)
+	}
+	return nil
+}
+
+// liftBan makes a banned account normal again, whether an admin lifted the ban or it expired.
+func liftBan(ctx context.Context, svcCtx *svc.ServiceContext, user *model.User) error {
+	user.Status = model.UserStatusNormal
+	user.InfoVersion = time.Now().UnixNano()
+	if err := svcCtx.UserModel.Update(ctx, user); err != nil {
+		return status.Error(codes.Internal, "failed to update user status")
+	}
+	ban, err := svcCtx.BanModel.FindOneByUserId(ctx, user.Id)
+	if err == nil {
+		err = svcCtx.BanModel.Delete(ctx, ban.Id)
+	}
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		logx.WithContext(ctx).Errorf("failed to remove ban of user %d: %v", user.Id, err)
+	}
+	if err := svcCtx.Status.Lift(ctx, user.Id); err != nil {
+		return status.Error(codes.Internal, "failed to share lifted ban: "+err.Error())
+	}
+	if err := publishUserEvent(ctx, svcCtx, user.Id, "unbanned", user.InfoVersion); err != nil {
+		logx.WithContext(ctx).Errorf("failed to publish lifted ban of user %d: %v", user.Id, err)
+	}
+	return nil
+}
+
+// bannedAccount refuses signing in to a banned account, telling until when and why. A temporary
+// ban that ran out is lifted instead.
+func bannedAccount(ctx context.Context, svcCtx *svc.ServiceContext, user *model.User) error {
+	ban, err := svcCtx.BanModel.FindOneByUserId(ctx, user.Id)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		logx.WithContext(ctx).Errorf("failed to find ban of user %d: %v", user.Id, err)
+	}
+	if ban == nil {
+		return status.Error(codes.PermissionDenied, "Account is banned")
+	}
+	if ban.ExpiresAt.Valid && !ban.ExpiresAt.Time.After(time.Now()) {
+		return liftBan(ctx, svcCtx, user)
+	}
+	msg := "Account is banned"
+	if ban.ExpiresAt.Valid {
+		msg += " until " + ban.ExpiresAt.Time.UTC().Format(time.RFC3339)
+	}
+	if ban.Reason != "" {
+		msg += ": " + ban.Reason
+	}
+	return status.Error(codes.PermissionDenied, msg)
+}
@@ -0,0 +1,59 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/archyhsh/gochat/pkg/oidc"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type StartOidcLoginLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewStartOidcLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *StartOidcLoginLogic {
+	return &StartOidcLoginLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// StartOidcLogin begins a sign-in at the provider. The state, nonce and PKCE code verifier stay
+// here until OidcLogin redeems the state.
+func (l *StartOidcLoginLogic) StartOidcLogin(in *pb.StartOidcLoginRequest) (*pb.StartOidcLoginResponse, error) {
+	if len(in.DeviceId) > 100 {
+		return nil, status.Error(codes.InvalidArgument, "device_id too long")
+	}
+	provider, ok := l.svcCtx.OidcProviders[in.Provider]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "unknown identity provider")
+	}
+	st := &oidc.LoginState{
+		Provider:     in.Provider,
+		CodeVerifier: oidc.NewRandom(),
+		Nonce:        oidc.NewRandom(),
+		DeviceID:     in.DeviceId,
+		Platform:     int32(in.Platform),
+	}
+	state, err := l.svcCtx.OidcStates.Save(l.ctx, st)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "system error")
+	}
+	authUrl, err := provider.AuthURL(l.ctx, state, st.Nonce, st.CodeVerifier)
+	if err != nil {
+		l.Errorf("identity provider %s unavailable: %v", in.Provider, err)
+		return nil, status.Error(codes.Unavailable, "Identity provider unavailable")
+	}
+	return &pb.StartOidcLoginResponse{
+		Base:             &pb.BaseResponse{Code: 200, Message: "Success"},
+		AuthorizationUrl: authUrl,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const minutesPerDay = 24 * 60
+
+type UpdateNotifySettingLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateNotifySettingLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateNotifySettingLogic {
+	return &UpdateNotifySettingLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *UpdateNotifySettingLogic) UpdateNotifySetting(in *pb.UpdateNotifySettingRequest) (*pb.UpdateNotifySettingResponse, error) {
+	md, ok := metadata.FromIncomingContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	userIdStrs := md.Get("user_id")
+	if len(userIdStrs) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "user_id not found in metadata")
+	}
+	userId, err := strconv.ParseInt(userIdStrs[0], 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid user_id in metadata")
+	}
+
+	s := in.Setting
+	if s == nil {
+		return nil, status.Error(codes.InvalidArgument, "setting is required")
+	}
+	if s.DndStart < 0 || s.DndStart >= minutesPerDay || s.DndEnd < 0 || s.DndEnd >= minutesPerDay {
+		return nil, status.Error(codes.InvalidArgument, "dnd_start and dnd_end must be minutes of the day")
+	}
+	if s.Timezone == "" {
+		s.Timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(s.Timezone); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "unknown timezone: "+s.Timezone)
+	}
+
+	data := &model.UserNotifySetting{
+		UserId:   userId,
+		DndStart: int64(s.DndStart),
+		DndEnd:   int64(s.DndEnd),
+		Timezone: s.Timezone,
+	}
+	if s.DndEnabled {
+		data.DndEnabled = 1
+	}
+	if err := l.svcCtx.NotifySettingModel.Upsert(l.ctx, data); err != nil {
+		return nil, status.Error(codes.Internal, "failed to update notify setting: "+err.Error())
+	}
+
+	return &pb.UpdateNotifySettingResponse{
+		Base:    &pb.BaseResponse{Code: 200, Message: "Success"},
+		Setting: toPbNotifySetting(data),
+	}, nil
+}
@@ -0,0 +1,140 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdatePrivacySettingLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdatePrivacySettingLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdatePrivacySettingLogic {
+	return &UpdatePrivacySettingLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *UpdatePrivacySettingLogic) UpdatePrivacySetting(in *pb.UpdatePrivacySettingRequest) (*pb.UpdatePrivacySettingResponse, error) {
+	md, ok := metadata.FromIncomingContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	userIdStrs := md.Get("user_id")
+	if len(userIdStrs) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "user_id not found in metadata")
+	}
+	userId, err := strconv.ParseInt(userIdStrs[0], 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid user_id in metadata")
+	}
+
+	s := in.Setting
+	if s == nil {
+		return nil, status.Error(codes.InvalidArgument, "setting is required")
+	}
+
+	if !validAudience(s.GroupInvite) || !validAudience(s.Phone) || !validAudience(s.Email) ||
+		!validAudience(s.Gender) || !validAudience(s.LastSeen) {
+		return nil, status.Error(codes.InvalidArgument, "invalid audience")
+	}
+	if _, ok := pb.FriendRequestPolicy_name[int32(s.FriendRequest)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid friend_request")
+	}
+
+	data := &model.UserPrivacy{
+		UserId:             userId,
+		FriendRequest:      int64(s.FriendRequest),
+		GroupInvite:        int64(s.GroupInvite),
+		PhoneVisibility:    int64(s.Phone),
+		EmailVisibility:    int64(s.Email),
+		GenderVisibility:   int64(s.Gender),
+		LastSeenVisibility: int64(s.LastSeen),
+	}
+	if s.SearchableByUsername {
+		data.SearchableByUsername = 1
+	}
+	if s.SearchableByPhone {
+		data.SearchableByPhone = 1
+	}
+	if s.SearchableByEmail {
+		data.SearchableByEmail = 1
+	}
+	if s.FriendRequest == pb.FriendRequestPolicy_FRIEND_REQUEST_QUESTION {
+		if err := l.setQuestion(data, s.FriendQuestion, s.FriendAnswer); err != nil {
+			return nil, err
+		}
+	}
+	if err := l.svcCtx.PrivacyModel.Upsert(l.ctx, data); err != nil {
+		return nil, status.Error(codes.Internal, "failed to update privacy setting: "+err.Error())
+	}
+
+	return &pb.UpdatePrivacySettingResponse{
+		Base:    &pb.BaseResponse{Code: 200, Message: "Success"},
+		Setting: toPbPrivacySetting(data),
+	}, nil
+}
+
+// setQuestion sets the question asked to those sending friend requests. The answer is kept as a
+// hash like a password; without a new answer the current one stays.
+func (l *UpdatePrivacySettingLogic) setQuestion(data *model.UserPrivacy, question, answer string) error {
+	question = strings.TrimSpace(question)
+	if question == "" || utf8.RuneCountInString(question) > maxFriendQuestionLen {
+		return status.Errorf(codes.InvalidArgument, "friend_question must be 1 to %d characters", maxFriendQuestionLen)
+	}
+	data.FriendQuestion = question
+
+	answer = normalizeFriendAnswer(answer)
+	if answer == "" {
+		current, err := l.svcCtx.PrivacyModel.FindOneByUserId(l.ctx, data.UserId)
+		if err != nil && !errors.Is(err, model.ErrNotFound) {
+			return status.Error(codes.Internal, "failed to find privacy setting: "+err.Error())
+		}
+		if current == nil || current.FriendAnswer == "" {
+			return status.Error(codes.InvalidArgument, "friend_answer is required")
+		}
+		data.FriendAnswer = current.FriendAnswer
+		return nil
+	}
+	if len(answer) > maxFriendAnswerLen {
+		return status.Errorf(codes.InvalidArgument, "friend_answer must be at most %d bytes", maxFriendAnswerLen)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(answer), bcrypt.DefaultCost)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to hash answer")
+	}
+	data.FriendAnswer = string(hash)
+	return nil
+}
+
+const (
+	maxFriendQuestionLen = 100
+	maxFriendAnswerLen   = 72 // what bcrypt takes into account
+)
+
+// normalizeFriendAnswer ignores case and spacing, so answers need not be typed exactly alike
+func normalizeFriendAnswer(answer string) string {
+	return strings.ToLower(strings.Join(strings.Fields(answer), " "))
+}
+
+func validAudience(a pb.Audience) bool {
+	_, ok := pb.Audience_name[int32(a)]
+	return ok
+}
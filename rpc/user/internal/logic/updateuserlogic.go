@@ -53,8 +53,13 @@ func (l *UpdateUserLogic) UpdateUser(in *pb.UpdateUserRequest) (*pb.UpdateUserRe
 	userInfo.Id = userId
 	userInfo.Nickname = in.Nickname
 	userInfo.Avatar = in.Avatar
-	userInfo.Phone = in.Phone
-	userInfo.Email = in.Email
+	// A changed address has to be verified again
+	if in.Phone != userInfo.Phone {
+		userInfo.Phone, userInfo.PhoneVerified = in.Phone, 0
+	}
+	if in.Email != userInfo.Email {
+		userInfo.Email, userInfo.EmailVerified = in.Email, 0
+	}
 	userInfo.Gender = int64(in.Gender)
 	userInfo.InfoVersion = time.Now().UnixNano()
 	err = l.svcCtx.UserModel.Update(l.ctx, userInfo)
@@ -0,0 +1,120 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/archyhsh/gochat/pkg/auth"
+	"github.com/archyhsh/gochat/rpc/pb"
+	"github.com/archyhsh/gochat/rpc/user/internal/svc"
+	"github.com/archyhsh/gochat/rpc/user/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// recoveryCodeCount is how many recovery codes are handed out when 2FA is turned on
+const recoveryCodeCount = 10
+
+var errInvalidMfaToken = status.Error(codes.Unauthenticated, "Sign-in expired, please sign in again")
+
+type VerifyMfaLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewVerifyMfaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VerifyMfaLogic {
+	return &VerifyMfaLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// VerifyMfa finishes a sign-in that Login answered with mfa_required, given a TOTP code or an
+// unused recovery code.
+func (l *VerifyMfaLogic) VerifyMfa(in *pb.VerifyMfaRequest) (*pb.LoginResponse, error) {
+	if in.Code == "" && in.RecoveryCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "code or recovery_code is required")
+	}
+	challenge, err := l.svcCtx.Sessions.AttemptMFAChallenge(l.ctx, in.MfaToken, l.svcCtx.Config.MFA.MaxAttempts)
+	if errors.Is(err, auth.ErrInvalidMFAToken) {
+		return nil, errInvalidMfaToken
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "system error")
+	}
+	if challenge.Enroll {
+		return nil, status.Error(codes.FailedPrecondition, "Two-factor authentication must be set up first")
+	}
+	user, err := l.svcCtx.UserModel.FindOne(l.ctx, challenge.UserID)
+	if err != nil {
+		return nil, errInvalidMfaToken
+	}
+	mfa, err := l.svcCtx.MfaModel.FindOneByUserId(l.ctx, user.Id)
+	if errors.Is(err, model.ErrNotFound) || (err == nil && mfa.Enabled != 1) {
+		// 2FA was turned off meanwhile; sign in again without it
+		return nil, errInvalidMfaToken
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "system error")
+	}
+
+	ok, err := checkSecondFactor(l.ctx, l.svcCtx, mfa, in.Code, in.RecoveryCode)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "system error")
+	}
+	platform := pb.DevicePlatform(challenge.Platform)
+	if !ok {
+		recordLogin(l.ctx, l.svcCtx, user.Id, challenge.DeviceID, platform, challenge.IP, loginReasonBadMfaCode)
+		wait, err := l.svcCtx.LoginGuard.Fail(l.ctx, user.Username, challenge.IP)
+		if err != nil {
+			l.Errorf("failed to count sign-in attempt of %q: %v", user.Username, err)
+		}
+		if wait > 0 {
+			_ = l.svcCtx.Sessions.CompleteMFAChallenge(l.ctx, in.MfaToken)
+			return nil, lockedOut(wait)
+		}
+		return nil, status.Error(codes.Unauthenticated, "Invalid verification code")
+	}
+	if err := l.svcCtx.Sessions.CompleteMFAChallenge(l.ctx, in.MfaToken); err != nil {
+		l.Errorf("failed to consume mfa token of user %d: %v", user.Id, err)
+	}
+	return completeLogin(l.ctx, l.svcCtx, user, challenge.DeviceID, platform, challenge.IP)
+}
+
+// isMfaAdmin reports whether the account is one of the admins that must use 2FA
+func isMfaAdmin(svcCtx *svc.ServiceContext, username string) bool {
+	return slices.Contains(svcCtx.Config.MFA.Admins, username)
+}
+
+// checkSecondFactor accepts a TOTP code or, when code is empty, a recovery code, using it up
+// either way so it cannot be replayed.
+func checkSecondFactor(ctx context.Context, svcCtx *svc.ServiceContext, mfa *model.UserMfa, code, recoveryCode string) (bool, error) {
+	if code != "" {
+		step, ok := auth.ValidateTOTP(mfa.Secret, code, time.Now(), svcCtx.Config.MFA.Skew)
+		if !ok {
+			return false, nil
+		}
+		return svcCtx.MfaModel.UseStep(ctx, mfa, step)
+	}
+
+	var hashes []string
+	if err := json.Unmarshal([]byte(mfa.RecoveryCodes), &hashes); err != nil {
+		return false, err
+	}
+	i := slices.Index(hashes, auth.HashRecoveryCode(recoveryCode))
+	if i < 0 {
+		return false, nil
+	}
+	left, err := json.Marshal(slices.Delete(hashes, i, i+1))
+	if err != nil {
+		return false, err
+	}
+	return svcCtx.MfaModel.UseRecoveryCodes(ctx, mfa, mfa.RecoveryCodes, string(left))
+}
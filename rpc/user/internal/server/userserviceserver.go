@@ -33,6 +33,11 @@ func (s *UserServiceServer) Login(ctx context.Context, in *pb.LoginRequest) (*pb
 	return l.Login(in)
 }
 
+func (s *UserServiceServer) VerifyMfa(ctx context.Context, in *pb.VerifyMfaRequest) (*pb.LoginResponse, error) {
+	l := logic.NewVerifyMfaLogic(ctx, s.svcCtx)
+	return l.VerifyMfa(in)
+}
+
 func (s *UserServiceServer) GetUser(ctx context.Context, in *pb.GetUserRequest) (*pb.GetUserResponse, error) {
 	l := logic.NewGetUserLogic(ctx, s.svcCtx)
 	return l.GetUser(in)
@@ -62,3 +67,160 @@ func (s *UserServiceServer) ForgotPassword(ctx context.Context, in *pb.ForgotPas
 	l := logic.NewForgotPasswordLogic(ctx, s.svcCtx)
 	return l.ForgotPassword(in)
 }
+
+func (s *UserServiceServer) GetNotifySetting(ctx context.Context, in *pb.GetNotifySettingRequest) (*pb.GetNotifySettingResponse, error) {
+	l := logic.NewGetNotifySettingLogic(ctx, s.svcCtx)
+	return l.GetNotifySetting(in)
+}
+
+func (s *UserServiceServer) UpdateNotifySetting(ctx context.Context, in *pb.UpdateNotifySettingRequest) (*pb.UpdateNotifySettingResponse, error) {
+	l := logic.NewUpdateNotifySettingLogic(ctx, s.svcCtx)
+	return l.UpdateNotifySetting(in)
+}
+
+// only for message service use
+func (s *UserServiceServer) GetPushTargets(ctx context.Context, in *pb.GetPushTargetsRequest) (*pb.GetPushTargetsResponse, error) {
+	l := logic.NewGetPushTargetsLogic(ctx, s.svcCtx)
+	return l.GetPushTargets(in)
+}
+
+func (s *UserServiceServer) RegisterDevice(ctx context.Context, in *pb.RegisterDeviceRequest) (*pb.RegisterDeviceResponse, error) {
+	l := logic.NewRegisterDeviceLogic(ctx, s.svcCtx)
+	return l.RegisterDevice(in)
+}
+
+func (s *UserServiceServer) ListDevices(ctx context.Context, in *pb.ListDevicesRequest) (*pb.ListDevicesResponse, error) {
+	l := logic.NewListDevicesLogic(ctx, s.svcCtx)
+	return l.ListDevices(in)
+}
+
+func (s *UserServiceServer) RemoveDevice(ctx context.Context, in *pb.RemoveDeviceRequest) (*pb.RemoveDeviceResponse, error) {
+	l := logic.NewRemoveDeviceLogic(ctx, s.svcCtx)
+	return l.RemoveDevice(in)
+}
+
+// Admin: force users off their gateways, and ban accounts or lift their bans
+func (s *UserServiceServer) KickUser(ctx context.Context, in *pb.KickUserRequest) (*pb.KickUserResponse, error) {
+	l := logic.NewKickUserLogic(ctx, s.svcCtx)
+	return l.KickUser(in)
+}
+
+func (s *UserServiceServer) SetUserStatus(ctx context.Context, in *pb.SetUserStatusRequest) (*pb.SetUserStatusResponse, error) {
+	l := logic.NewSetUserStatusLogic(ctx, s.svcCtx)
+	return l.SetUserStatus(in)
+}
+
+func (s *UserServiceServer) GetPresence(ctx context.Context, in *pb.GetPresenceRequest) (*pb.GetPresenceResponse, error) {
+	l := logic.NewGetPresenceLogic(ctx, s.svcCtx)
+	return l.GetPresence(in)
+}
+
+func (s *UserServiceServer) BatchGetPresence(ctx context.Context, in *pb.BatchGetPresenceRequest) (*pb.BatchGetPresenceResponse, error) {
+	l := logic.NewBatchGetPresenceLogic(ctx, s.svcCtx)
+	return l.BatchGetPresence(in)
+}
+
+func (s *UserServiceServer) GetPrivacySetting(ctx context.Context, in *pb.GetPrivacySettingRequest) (*pb.GetPrivacySettingResponse, error) {
+	l := logic.NewGetPrivacySettingLogic(ctx, s.svcCtx)
+	return l.GetPrivacySetting(in)
+}
+
+func (s *UserServiceServer) UpdatePrivacySetting(ctx context.Context, in *pb.UpdatePrivacySettingRequest) (*pb.UpdatePrivacySettingResponse, error) {
+	l := logic.NewUpdatePrivacySettingLogic(ctx, s.svcCtx)
+	return l.UpdatePrivacySetting(in)
+}
+
+func (s *UserServiceServer) RefreshToken(ctx context.Context, in *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	l := logic.NewRefreshTokenLogic(ctx, s.svcCtx)
+	return l.RefreshToken(in)
+}
+
+func (s *UserServiceServer) Logout(ctx context.Context, in *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	l := logic.NewLogoutLogic(ctx, s.svcCtx)
+	return l.Logout(in)
+}
+
+func (s *UserServiceServer) ResetPassword(ctx context.Context, in *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	l := logic.NewResetPasswordLogic(ctx, s.svcCtx)
+	return l.ResetPassword(in)
+}
+
+func (s *UserServiceServer) SendVerificationCode(ctx context.Context, in *pb.SendVerificationCodeRequest) (*pb.SendVerificationCodeResponse, error) {
+	l := logic.NewSendVerificationCodeLogic(ctx, s.svcCtx)
+	return l.SendVerificationCode(in)
+}
+
+func (s *UserServiceServer) VerifyContact(ctx context.Context, in *pb.VerifyContactRequest) (*pb.VerifyContactResponse, error) {
+	l := logic.NewVerifyContactLogic(ctx, s.svcCtx)
+	return l.VerifyContact(in)
+}
+
+func (s *UserServiceServer) ListLoginHistory(ctx context.Context, in *pb.ListLoginHistoryRequest) (*pb.ListLoginHistoryResponse, error) {
+	l := logic.NewListLoginHistoryLogic(ctx, s.svcCtx)
+	return l.ListLoginHistory(in)
+}
+
+func (s *UserServiceServer) EnrollTotp(ctx context.Context, in *pb.EnrollTotpRequest) (*pb.EnrollTotpResponse, error) {
+	l := logic.NewEnrollTotpLogic(ctx, s.svcCtx)
+	return l.EnrollTotp(in)
+}
+
+func (s *UserServiceServer) ConfirmTotp(ctx context.Context, in *pb.ConfirmTotpRequest) (*pb.ConfirmTotpResponse, error) {
+	l := logic.NewConfirmTotpLogic(ctx, s.svcCtx)
+	return l.ConfirmTotp(in)
+}
+
+func (s *UserServiceServer) DisableTotp(ctx context.Context, in *pb.DisableTotpRequest) (*pb.DisableTotpResponse, error) {
+	l := logic.NewDisableTotpLogic(ctx, s.svcCtx)
+	return l.DisableTotp(in)
+}
+
+func (s *UserServiceServer) ListOidcProviders(ctx context.Context, in *pb.ListOidcProvidersRequest) (*pb.ListOidcProvidersResponse, error) {
+	l := logic.NewListOidcProvidersLogic(ctx, s.svcCtx)
+	return l.ListOidcProviders(in)
+}
+
+func (s *UserServiceServer) StartOidcLogin(ctx context.Context, in *pb.StartOidcLoginRequest) (*pb.StartOidcLoginResponse, error) {
+	l := logic.NewStartOidcLoginLogic(ctx, s.svcCtx)
+	return l.StartOidcLogin(in)
+}
+
+func (s *UserServiceServer) OidcLogin(ctx context.Context, in *pb.OidcLoginRequest) (*pb.LoginResponse, error) {
+	l := logic.NewOidcLoginLogic(ctx, s.svcCtx)
+	return l.OidcLogin(in)
+}
+
+func (s *UserServiceServer) DeactivateAccount(ctx context.Context, in *pb.DeactivateAccountRequest) (*pb.DeactivateAccountResponse, error) {
+	l := logic.NewDeactivateAccountLogic(ctx, s.svcCtx)
+	return l.DeactivateAccount(in)
+}
+
+func (s *UserServiceServer) DeleteAccount(ctx context.Context, in *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
+	l := logic.NewDeleteAccountLogic(ctx, s.svcCtx)
+	return l.DeleteAccount(in)
+}
+
+func (s *UserServiceServer) ReactivateAccount(ctx context.Context, in *pb.ReactivateAccountRequest) (*pb.ReactivateAccountResponse, error) {
+	l := logic.NewReactivateAccountLogic(ctx, s.svcCtx)
+	return l.ReactivateAccount(in)
+}
+
+func (s *UserServiceServer) GetAccountDeletion(ctx context.Context, in *pb.GetAccountDeletionRequest) (*pb.GetAccountDeletionResponse, error) {
+	l := logic.NewGetAccountDeletionLogic(ctx, s.svcCtx)
+	return l.GetAccountDeletion(in)
+}
+
+func (s *UserServiceServer) CompleteDeletionStep(ctx context.Context, in *pb.CompleteDeletionStepRequest) (*pb.CompleteDeletionStepResponse, error) {
+	l := logic.NewCompleteDeletionStepLogic(ctx, s.svcCtx)
+	return l.CompleteDeletionStep(in)
+}
+
+func (s *UserServiceServer) GetContactPolicies(ctx context.Context, in *pb.GetContactPoliciesRequest) (*pb.GetContactPoliciesResponse, error) {
+	l := logic.NewGetContactPoliciesLogic(ctx, s.svcCtx)
+	return l.GetContactPolicies(in)
+}
+
+func (s *UserServiceServer) CheckFriendAnswer(ctx context.Context, in *pb.CheckFriendAnswerRequest) (*pb.CheckFriendAnswerResponse, error) {
+	l := logic.NewCheckFriendAnswerLogic(ctx, s.svcCtx)
+	return l.CheckFriendAnswer(in)
+}
@@ -1,21 +1,52 @@
 package svc
 
 import (
+	"net/http"
+	"strings"
+	"time"
+
 	"github.com/archyhsh/gochat/pkg/auth"
+	"github.com/archyhsh/gochat/pkg/gateway"
 	"github.com/archyhsh/gochat/pkg/kafka"
 	"github.com/archyhsh/gochat/pkg/messaging"
+	"github.com/archyhsh/gochat/pkg/oidc"
+	"github.com/archyhsh/gochat/pkg/oidc/mockidp"
+	"github.com/archyhsh/gochat/pkg/presence"
+	"github.com/archyhsh/gochat/pkg/router"
+	"github.com/archyhsh/gochat/pkg/verify"
+	"github.com/archyhsh/gochat/rpc/relation/relationservice"
 	"github.com/archyhsh/gochat/rpc/user/internal/config"
 	"github.com/archyhsh/gochat/rpc/user/model"
+	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type ServiceContext struct {
-	Config          config.Config
-	UserModel       model.UserModel
-	UserDeviceModel model.UserDeviceModel
-	JwtManager      *auth.JWTManager
-	Producer        *messaging.ReliableProducer
+	Config             config.Config
+	UserModel          model.UserModel
+	UserDeviceModel    model.UserDeviceModel
+	NotifySettingModel model.UserNotifySettingModel
+	PrivacyModel       model.UserPrivacyModel
+	LoginHistoryModel  model.UserLoginHistoryModel
+	MfaModel           model.UserMfaModel
+	IdentityModel      model.UserIdentityModel
+	DeletionModel      model.UserDeletionModel
+	BanModel           model.UserBanModel
+	JwtManager         *auth.JWTManager
+	Producer           *messaging.ReliableProducer
+	Redis              *redis.Redis
+	Sessions           *auth.SessionStore
+	Status             *auth.StatusStore
+	LoginGuard         *auth.LoginGuard
+	Kicker             *gateway.Kicker
+	Presence           *presence.Store
+	Codes              *verify.CodeStore
+	Sender             verify.Sender
+	OidcProviders      map[string]*oidc.Provider
+	OidcStates         *oidc.StateStore
+	RelationRpc        relationservice.RelationService
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -24,12 +55,55 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	rdb := redis.MustNewRedis(c.Cache[0].RedisConf)
 	failureStore := messaging.NewRedisFailureStore(rdb, "")
 	producer := messaging.NewReliableProducer(rawProducer, failureStore, c.Kafka.Topic)
+	gatewaySigner := auth.NewSigner(c.Gateway.Secret)
 
 	return &ServiceContext{
-		Config:          c,
-		UserModel:       model.NewUserModel(sqlConn, c.Cache),
-		UserDeviceModel: model.NewUserDeviceModel(sqlConn, c.Cache),
-		JwtManager:      auth.NewJWTManager(c.JWT.AccessSecret, int(c.JWT.AccessExpire)/3600),
-		Producer:        producer,
+		Config:             c,
+		UserModel:          model.NewUserModel(sqlConn, c.Cache),
+		UserDeviceModel:    model.NewUserDeviceModel(sqlConn, c.Cache),
+		NotifySettingModel: model.NewUserNotifySettingModel(sqlConn, c.Cache),
+		PrivacyModel:       model.NewUserPrivacyModel(sqlConn, c.Cache),
+		LoginHistoryModel:  model.NewUserLoginHistoryModel(sqlConn, c.Cache),
+		MfaModel:           model.NewUserMfaModel(sqlConn, c.Cache),
+		IdentityModel:      model.NewUserIdentityModel(sqlConn, c.Cache),
+		DeletionModel:      model.NewUserDeletionModel(sqlConn, c.Cache),
+		BanModel:           model.NewUserBanModel(sqlConn, c.Cache),
+		JwtManager:         auth.NewJWTManagerWithKeys(c.JWT.AccessSecret, c.JWT.KeyConf, time.Duration(c.JWT.AccessExpire)*time.Second),
+		Producer:           producer,
+		Redis:              rdb,
+		Sessions:           auth.NewSessionStore(rdb),
+		Status:             auth.NewStatusStore(rdb, 0),
+		LoginGuard:         auth.NewLoginGuard(rdb, c.LoginGuard),
+		Kicker: gateway.NewKicker(router.NewRouter(rdb, ""), gateway.NewPool(c.Gateway.Timeout,
+			zrpc.WithUnaryClientInterceptor(gatewaySigner.UnaryClientInterceptor())), gatewaySigner),
+		Presence:      presence.NewStore(rdb, c.Presence.StaleAfter),
+		Codes:         verify.NewCodeStore(rdb, c.Verification.Code),
+		Sender:        verify.NewSender(c.Verification.Sender),
+		OidcProviders: newOidcProviders(c),
+		OidcStates:    oidc.NewStateStore(rdb, c.OIDC.StateTTL),
+		RelationRpc:   relationservice.NewRelationService(zrpc.MustNewClient(c.RelationRpc)),
+	}
+}
+
+// newOidcProviders sets up the configured providers by name. Providers issued by the mock
+// provider are called in-process.
+func newOidcProviders(c config.Config) map[string]*oidc.Provider {
+	var mock *mockidp.Server
+	if c.OIDC.MockIdP.Listen != "" {
+		var err error
+		if mock, err = mockidp.New(c.OIDC.MockIdP); err != nil {
+			logx.Errorf("mock identity provider disabled: %v", err)
+		} else {
+			mock.Start()
+		}
+	}
+	providers := make(map[string]*oidc.Provider, len(c.OIDC.Providers))
+	for _, pc := range c.OIDC.Providers {
+		client := &http.Client{Timeout: c.OIDC.Timeout}
+		if mock != nil && strings.TrimSuffix(pc.Issuer, "/") == mock.Issuer() {
+			client = mock.Client()
+		}
+		providers[pc.Name] = oidc.NewProvider(pc, client)
 	}
+	return providers
 }
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ UserBanModel = (*customUserBanModel)(nil)
+
+type (
+	// UserBanModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customUserBanModel.
+	UserBanModel interface {
+		userBanModel
+		Upsert(ctx context.Context, data *UserBan) error
+	}
+
+	customUserBanModel struct {
+		*defaultUserBanModel
+	}
+)
+
+// NewUserBanModel returns a model for the database table.
+func NewUserBanModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) UserBanModel {
+	return &customUserBanModel{
+		defaultUserBanModel: newUserBanModel(conn, c, opts...),
+	}
+}
+
+// Upsert records the user's ban, replacing the one they had before
+func (m *customUserBanModel) Upsert(ctx context.Context, data *UserBan) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (%s)
+		VALUES (?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			reason = VALUES(reason),
+			operator_id = VALUES(operator_id),
+			expires_at = VALUES(expires_at)
+	`, m.table, userBanRowsExpectAutoSet)
+	// The row found by user id is cached under its id as well
+	keys := []string{fmt.Sprintf("%s%v", cacheUserBanUserIdPrefix, data.UserId)}
+	if old, err := m.FindOneByUserId(ctx, data.UserId); err == nil {
+		keys = append(keys, fmt.Sprintf("%s%v", cacheUserBanIdPrefix, old.Id))
+	}
+	_, err := m.ExecNoCacheCtx(ctx, query, data.UserId, data.Reason, data.OperatorId, data.ExpiresAt)
+	if err == nil {
+		_ = m.DelCacheCtx(ctx, keys...)
+	}
+	return err
+}
@@ -0,0 +1,86 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ UserDeletionModel = (*customUserDeletionModel)(nil)
+
+// Values of user_deletion.status
+const (
+	DeletionScheduled = 0
+	DeletionCleaning  = 1
+	DeletionDone      = 2
+	DeletionCancelled = 3
+)
+
+type (
+	// UserDeletionModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customUserDeletionModel.
+	UserDeletionModel interface {
+		userDeletionModel
+		FindDue(ctx context.Context, now time.Time, limit int) ([]*UserDeletion, error)
+		Claim(ctx context.Context, data *UserDeletion, next time.Time) (bool, error)
+		AddStep(ctx context.Context, data *UserDeletion, step string) (bool, error)
+		Cancel(ctx context.Context, data *UserDeletion, now time.Time) (bool, error)
+	}
+
+	customUserDeletionModel struct {
+		*defaultUserDeletionModel
+	}
+)
+
+// NewUserDeletionModel returns a model for the database table.
+func NewUserDeletionModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) UserDeletionModel {
+	return &customUserDeletionModel{
+		defaultUserDeletionModel: newUserDeletionModel(conn, c, opts...),
+	}
+}
+
+// FindDue returns the deletions past their grace period that are due to be announced again,
+// oldest first.
+func (m *customUserDeletionModel) FindDue(ctx context.Context, now time.Time, limit int) ([]*UserDeletion, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `status` IN (?, ?) AND `next_attempt_at` <= ? AND `delete_after` <= ? ORDER BY `next_attempt_at` LIMIT ?", userDeletionRows, m.table)
+	var resp []*UserDeletion
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, DeletionScheduled, DeletionCleaning, now, now, limit)
+	return resp, err
+}
+
+// Claim moves a due deletion to cleaning and postpones its next attempt to next. It reports false
+// when another instance claimed the same attempt first, or the deletion was cancelled meanwhile.
+func (m *customUserDeletionModel) Claim(ctx context.Context, data *UserDeletion, next time.Time) (bool, error) {
+	return m.execCond(ctx, data, "UPDATE %s SET `status` = ?, `attempts` = `attempts` + 1, `next_attempt_at` = ? WHERE `id` = ? AND `attempts` = ? AND `status` IN (?, ?)",
+		DeletionCleaning, next, data.Id, data.Attempts, DeletionScheduled, DeletionCleaning)
+}
+
+// AddStep records that step finished its cleanup; it reports false when it was recorded before.
+func (m *customUserDeletionModel) AddStep(ctx context.Context, data *UserDeletion, step string) (bool, error) {
+	return m.execCond(ctx, data, "UPDATE %s SET `steps` = IF(`steps` = '', ?, CONCAT(`steps`, ',', ?)) WHERE `id` = ? AND `status` = ? AND NOT FIND_IN_SET(?, `steps`)",
+		step, step, data.Id, DeletionCleaning, step)
+}
+
+// Cancel calls off a deletion that is still in its grace period; it reports false once the
+// grace period is over or the cleanup started.
+func (m *customUserDeletionModel) Cancel(ctx context.Context, data *UserDeletion, now time.Time) (bool, error) {
+	return m.execCond(ctx, data, "UPDATE %s SET `status` = ? WHERE `id` = ? AND `status` = ? AND `delete_after` > ?",
+		DeletionCancelled, data.Id, DeletionScheduled, now)
+}
+
+func (m *customUserDeletionModel) execCond(ctx context.Context, data *UserDeletion, format string, args ...any) (bool, error) {
+	userDeletionIdKey := fmt.Sprintf("%s%v", cacheUserDeletionIdPrefix, data.Id)
+	userDeletionUserIdKey := fmt.Sprintf("%s%v", cacheUserDeletionUserIdPrefix, data.UserId)
+	res, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		return conn.ExecCtx(ctx, fmt.Sprintf(format, m.table), args...)
+	}, userDeletionIdKey, userDeletionUserIdKey)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
@@ -1,6 +1,10 @@
 package model
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
@@ -12,6 +16,8 @@ type (
 	// and implement the added methods in customUserDeviceModel.
 	UserDeviceModel interface {
 		userDeviceModel
+		FindPushTargetsByUserIds(ctx context.Context, userIds []int64) ([]*UserDevice, error)
+		FindByUserId(ctx context.Context, userId int64) ([]*UserDevice, error)
 	}
 
 	customUserDeviceModel struct {
@@ -25,3 +31,27 @@ func NewUserDeviceModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Opti
 		defaultUserDeviceModel: newUserDeviceModel(conn, c, opts...),
 	}
 }
+
+// FindPushTargetsByUserIds returns the devices of the listed users that registered a push token.
+func (m *customUserDeviceModel) FindPushTargetsByUserIds(ctx context.Context, userIds []int64) ([]*UserDevice, error) {
+	if len(userIds) == 0 {
+		return nil, nil
+	}
+	args := make([]interface{}, len(userIds))
+	for i, uid := range userIds {
+		args[i] = uid
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE user_id IN (%s) AND push_token != ''", userDeviceRows, m.table,
+		strings.TrimSuffix(strings.Repeat("?,", len(userIds)), ","))
+	var resp []*UserDevice
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	return resp, err
+}
+
+// FindByUserId returns every device of a user, most recently active first.
+func (m *customUserDeviceModel) FindByUserId(ctx context.Context, userId int64) ([]*UserDevice, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE user_id = ? ORDER BY last_active_at DESC", userDeviceRows, m.table)
+	var resp []*UserDevice
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userId)
+	return resp, err
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ UserIdentityModel = (*customUserIdentityModel)(nil)
+
+type (
+	// UserIdentityModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customUserIdentityModel.
+	UserIdentityModel interface {
+		userIdentityModel
+		FindByUserId(ctx context.Context, userId int64) ([]*UserIdentity, error)
+	}
+
+	customUserIdentityModel struct {
+		*defaultUserIdentityModel
+	}
+)
+
+// NewUserIdentityModel returns a model for the database table.
+func NewUserIdentityModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) UserIdentityModel {
+	return &customUserIdentityModel{
+		defaultUserIdentityModel: newUserIdentityModel(conn, c, opts...),
+	}
+}
+
+// FindByUserId returns the provider identities linked to a user.
+func (m *customUserIdentityModel) FindByUserId(ctx context.Context, userId int64) ([]*UserIdentity, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `user_id` = ?", userIdentityRows, m.table)
+	var resp []*UserIdentity
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userId)
+	return resp, err
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ UserLoginHistoryModel = (*customUserLoginHistoryModel)(nil)
+
+type (
+	// UserLoginHistoryModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customUserLoginHistoryModel.
+	UserLoginHistoryModel interface {
+		userLoginHistoryModel
+		FindRecentByUserId(ctx context.Context, userId, beforeId int64, limit int) ([]*UserLoginHistory, error)
+		DeleteByUserId(ctx context.Context, userId int64) error
+	}
+
+	customUserLoginHistoryModel struct {
+		*defaultUserLoginHistoryModel
+	}
+)
+
+// NewUserLoginHistoryModel returns a model for the database table.
+func NewUserLoginHistoryModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) UserLoginHistoryModel {
+	return &customUserLoginHistoryModel{
+		defaultUserLoginHistoryModel: newUserLoginHistoryModel(conn, c, opts...),
+	}
+}
+
+// FindRecentByUserId returns the user's sign-ins newest first, starting below beforeId when it is set.
+func (m *customUserLoginHistoryModel) FindRecentByUserId(ctx context.Context, userId, beforeId int64, limit int) ([]*UserLoginHistory, error) {
+	var resp []*UserLoginHistory
+	if beforeId > 0 {
+		query := fmt.Sprintf("SELECT %s FROM %s WHERE `user_id` = ? AND `id` < ? ORDER BY `id` DESC LIMIT ?", userLoginHistoryRows, m.table)
+		err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userId, beforeId, limit)
+		return resp, err
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `user_id` = ? ORDER BY `id` DESC LIMIT ?", userLoginHistoryRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, userId, limit)
+	return resp, err
+}
+
+// DeleteByUserId removes the user's whole history. Entries are never read by id, so there is no
+// cache to clear.
+func (m *customUserLoginHistoryModel) DeleteByUserId(ctx context.Context, userId int64) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE `user_id` = ?", m.table)
+	_, err := m.ExecNoCacheCtx(ctx, query, userId)
+	return err
+}
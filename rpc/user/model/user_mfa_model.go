@@ -0,0 +1,65 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ UserMfaModel = (*customUserMfaModel)(nil)
+
+type (
+	// UserMfaModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customUserMfaModel.
+	UserMfaModel interface {
+		userMfaModel
+		UseStep(ctx context.Context, data *UserMfa, step int64) (bool, error)
+		UseRecoveryCodes(ctx context.Context, data *UserMfa, old, codes string) (bool, error)
+	}
+
+	customUserMfaModel struct {
+		*defaultUserMfaModel
+	}
+)
+
+// NewUserMfaModel returns a model for the database table.
+func NewUserMfaModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) UserMfaModel {
+	return &customUserMfaModel{
+		defaultUserMfaModel: newUserMfaModel(conn, c, opts...),
+	}
+}
+
+// UseRecoveryCodes replaces the stored recovery codes if they are still old, so a recovery code
+// is used at most once even under concurrent sign-ins.
+func (m *customUserMfaModel) UseRecoveryCodes(ctx context.Context, data *UserMfa, old, codes string) (bool, error) {
+	userMfaIdKey := fmt.Sprintf("%s%v", cacheUserMfaIdPrefix, data.Id)
+	userMfaUserIdKey := fmt.Sprintf("%s%v", cacheUserMfaUserIdPrefix, data.UserId)
+	res, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("UPDATE %s SET `recovery_codes` = ? WHERE `id` = ? AND `recovery_codes` = ?", m.table)
+		return conn.ExecCtx(ctx, query, codes, data.Id, old)
+	}, userMfaIdKey, userMfaUserIdKey)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// UseStep records a TOTP time step as used; it reports false when that step, or a later one,
+// was used already, so each code is accepted at most once even under concurrent sign-ins.
+func (m *customUserMfaModel) UseStep(ctx context.Context, data *UserMfa, step int64) (bool, error) {
+	userMfaIdKey := fmt.Sprintf("%s%v", cacheUserMfaIdPrefix, data.Id)
+	userMfaUserIdKey := fmt.Sprintf("%s%v", cacheUserMfaUserIdPrefix, data.UserId)
+	res, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("UPDATE %s SET `last_used_step` = ? WHERE `id` = ? AND `last_used_step` < ?", m.table)
+		return conn.ExecCtx(ctx, query, step, data.Id, step)
+	}, userMfaIdKey, userMfaUserIdKey)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
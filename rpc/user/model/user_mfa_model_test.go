@@ -0,0 +1,66 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlc"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// stepConn keeps last_used_step of a single row, applying UseStep's conditional update
+type stepConn struct {
+	sqlx.SqlConn
+	lastUsedStep int64
+}
+
+func (c *stepConn) ExecCtx(_ context.Context, _ string, args ...any) (sql.Result, error) {
+	step := args[0].(int64)
+	if c.lastUsedStep >= step {
+		return rowsAffected(0), nil
+	}
+	c.lastUsedStep = step
+	return rowsAffected(1), nil
+}
+
+type rowsAffected int64
+
+func (r rowsAffected) LastInsertId() (int64, error) { return 0, nil }
+func (r rowsAffected) RowsAffected() (int64, error) { return int64(r), nil }
+
+type nopCache struct {
+	cache.Cache
+}
+
+func (nopCache) DelCtx(context.Context, ...string) error { return nil }
+
+func TestUseStepAcceptsEachStepOnce(t *testing.T) {
+	conn := &stepConn{}
+	m := &customUserMfaModel{defaultUserMfaModel: &defaultUserMfaModel{
+		CachedConn: sqlc.NewConnWithCache(conn, nopCache{}),
+		table:      "`user_mfa`",
+	}}
+	mfa := &UserMfa{Id: 1, UserId: 1}
+	ctx := context.Background()
+
+	tests := []struct {
+		step int64
+		ok   bool
+	}{
+		{100, true},
+		{100, false}, // the same code again
+		{99, false},  // an older code still within the skew
+		{101, true},
+	}
+	for _, tt := range tests {
+		ok, err := m.UseStep(ctx, mfa, tt.step)
+		if err != nil {
+			t.Fatalf("UseStep(%d): %v", tt.step, err)
+		}
+		if ok != tt.ok {
+			t.Errorf("UseStep(%d) = %v, want %v", tt.step, ok, tt.ok)
+		}
+	}
+}
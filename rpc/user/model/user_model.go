@@ -11,13 +11,22 @@ import (
 
 var _ UserModel = (*customUserModel)(nil)
 
+// Values of user.status
+const (
+	UserStatusBanned      = 0
+	UserStatusNormal      = 1
+	UserStatusDeactivated = 2 // closed by the user, who can reactivate it
+	UserStatusDeleting    = 3 // deletion scheduled, see user_deletion
+)
+
 type (
 	// UserModel is an interface to be customized, add more methods here,
 	// and implement the added methods in customUserModel.
 	UserModel interface {
 		userModel
-		SearchUsersByName(ctx context.Context, name string) ([]*User, error)
+		SearchUsers(ctx context.Context, keyword string, limit int) ([]*User, error)
 		SearchUsersByIds(ctx context.Context, ids []int64) ([]*User, error)
+		FindByVerifiedEmail(ctx context.Context, email string, limit int) ([]*User, error)
 	}
 
 	customUserModel struct {
@@ -32,10 +41,21 @@ func NewUserModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) Us
 	}
 }
 
-func (m *customUserModel) SearchUsersByName(ctx context.Context, name string) ([]*User, error) {
-	query := fmt.Sprintf("SELECT %s FROM %s WHERE `nickname` LIKE ? AND `status` = 1 LIMIT 50", userRows, m.table)
+// SearchUsers finds active users by nickname prefix or exact username, phone or email, each only
+// when the user allows being found that way in user_privacy (see DefaultUserPrivacy).
+func (m *customUserModel) SearchUsers(ctx context.Context, keyword string, limit int) ([]*User, error) {
+	query := fmt.Sprintf(`
+		SELECT u.%s FROM %s u
+		LEFT JOIN user_privacy p ON p.user_id = u.id
+		WHERE u.status = ? AND (
+			((u.nickname LIKE ? OR u.username = ?) AND COALESCE(p.searchable_by_username, 1) = 1)
+			OR (u.phone = ? AND p.searchable_by_phone = 1)
+			OR (u.email = ? AND p.searchable_by_email = 1)
+		)
+		LIMIT ?
+	`, strings.Join(userFieldNames, ",u."), m.table)
 	var resp []*User
-	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, name+"%")
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, UserStatusNormal, keyword+"%", keyword, keyword, keyword, limit)
 	return resp, err
 }
 
@@ -58,3 +78,11 @@ func (m *customUserModel) SearchUsersByIds(ctx context.Context, ids []int64) ([]
 	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
 	return resp, err
 }
+
+// FindByVerifiedEmail returns the users that proved they own email
+func (m *customUserModel) FindByVerifiedEmail(ctx context.Context, email string, limit int) ([]*User, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `email` = ? AND `email_verified` = 1 LIMIT ?", userRows, m.table)
+	var resp []*User
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, email, limit)
+	return resp, err
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ UserNotifySettingModel = (*customUserNotifySettingModel)(nil)
+
+type (
+	// UserNotifySettingModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customUserNotifySettingModel.
+	UserNotifySettingModel interface {
+		userNotifySettingModel
+		Upsert(ctx context.Context, data *UserNotifySetting) error
+		FindByUserIds(ctx context.Context, userIds []int64) ([]*UserNotifySetting, error)
+	}
+
+	customUserNotifySettingModel struct {
+		*defaultUserNotifySettingModel
+	}
+)
+
+// NewUserNotifySettingModel returns a model for the database table.
+func NewUserNotifySettingModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) UserNotifySettingModel {
+	return &customUserNotifySettingModel{
+		defaultUserNotifySettingModel: newUserNotifySettingModel(conn, c, opts...),
+	}
+}
+
+func (m *customUserNotifySettingModel) Upsert(ctx context.Context, data *UserNotifySetting) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (user_id, dnd_enabled, dnd_start, dnd_end, timezone)
+		VALUES (?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			dnd_enabled = VALUES(dnd_enabled),
+			dnd_start = VALUES(dnd_start),
+			dnd_end = VALUES(dnd_end),
+			timezone = VALUES(timezone)
+	`, m.table)
+	_, err := m.ExecNoCacheCtx(ctx, query, data.UserId, data.DndEnabled, data.DndStart, data.DndEnd, data.Timezone)
+	if err == nil {
+		_ = m.DelCacheCtx(ctx, fmt.Sprintf("%s%v", cacheUserNotifySettingUserIdPrefix, data.UserId))
+	}
+	return err
+}
+
+// FindByUserIds returns the settings of the listed users; users who never saved any are left out.
+func (m *customUserNotifySettingModel) FindByUserIds(ctx context.Context, userIds []int64) ([]*UserNotifySetting, error) {
+	if len(userIds) == 0 {
+		return nil, nil
+	}
+	args := make([]interface{}, len(userIds))
+	for i, uid := range userIds {
+		args[i] = uid
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE user_id IN (%s)", userNotifySettingRows, m.table,
+		strings.TrimSuffix(strings.Repeat("?,", len(userIds)), ","))
+	var resp []*UserNotifySetting
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	return resp, err
+}
@@ -0,0 +1,101 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ UserPrivacyModel = (*customUserPrivacyModel)(nil)
+
+// Values of the user_privacy visibility columns and group_invite
+const (
+	AudienceEveryone = 0
+	AudienceFriends  = 1
+	AudienceNobody   = 2
+)
+
+// Values of user_privacy.friend_request
+const (
+	FriendRequestAnyone           = 0
+	FriendRequestFriendsOfFriends = 1
+	FriendRequestNobody           = 2
+	FriendRequestQuestion         = 3
+)
+
+type (
+	// UserPrivacyModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customUserPrivacyModel.
+	UserPrivacyModel interface {
+		userPrivacyModel
+		Upsert(ctx context.Context, data *UserPrivacy) error
+		FindByUserIds(ctx context.Context, userIds []int64) ([]*UserPrivacy, error)
+	}
+
+	customUserPrivacyModel struct {
+		*defaultUserPrivacyModel
+	}
+)
+
+// NewUserPrivacyModel returns a model for the database table.
+func NewUserPrivacyModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) UserPrivacyModel {
+	return &customUserPrivacyModel{
+		defaultUserPrivacyModel: newUserPrivacyModel(conn, c, opts...),
+	}
+}
+
+// DefaultUserPrivacy returns the settings of a user who never saved any; they match the column
+// defaults.
+func DefaultUserPrivacy(userId int64) *UserPrivacy {
+	return &UserPrivacy{
+		UserId:               userId,
+		SearchableByUsername: 1,
+		PhoneVisibility:      AudienceFriends,
+		EmailVisibility:      AudienceFriends,
+	}
+}
+
+func (m *customUserPrivacyModel) Upsert(ctx context.Context, data *UserPrivacy) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (%s)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			searchable_by_username = VALUES(searchable_by_username),
+			searchable_by_phone = VALUES(searchable_by_phone),
+			searchable_by_email = VALUES(searchable_by_email),
+			friend_request = VALUES(friend_request),
+			friend_question = VALUES(friend_question),
+			friend_answer = VALUES(friend_answer),
+			group_invite = VALUES(group_invite),
+			phone_visibility = VALUES(phone_visibility),
+			email_visibility = VALUES(email_visibility),
+			gender_visibility = VALUES(gender_visibility),
+			last_seen_visibility = VALUES(last_seen_visibility)
+	`, m.table, userPrivacyRowsExpectAutoSet)
+	_, err := m.ExecNoCacheCtx(ctx, query, data.UserId, data.SearchableByUsername, data.SearchableByPhone,
+		data.SearchableByEmail, data.FriendRequest, data.FriendQuestion, data.FriendAnswer, data.GroupInvite,
+		data.PhoneVisibility, data.EmailVisibility, data.GenderVisibility, data.LastSeenVisibility)
+	if err == nil {
+		_ = m.DelCacheCtx(ctx, fmt.Sprintf("%s%v", cacheUserPrivacyUserIdPrefix, data.UserId))
+	}
+	return err
+}
+
+// FindByUserIds returns the settings of the listed users; users who never saved any are left out.
+func (m *customUserPrivacyModel) FindByUserIds(ctx context.Context, userIds []int64) ([]*UserPrivacy, error) {
+	if len(userIds) == 0 {
+		return nil, nil
+	}
+	args := make([]interface{}, len(userIds))
+	for i, uid := range userIds {
+		args[i] = uid
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE user_id IN (%s)", userPrivacyRows, m.table,
+		strings.TrimSuffix(strings.Repeat("?,", len(userIds)), ","))
+	var resp []*UserPrivacy
+	err := m.QueryRowsNoCacheCtx(ctx, &resp, query, args...)
+	return resp, err
+}
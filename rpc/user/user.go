@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/archyhsh/gochat/rpc/pb"
 	"github.com/archyhsh/gochat/rpc/user/internal/config"
+	"github.com/archyhsh/gochat/rpc/user/internal/logic"
 	"github.com/archyhsh/gochat/rpc/user/internal/server"
 	"github.com/archyhsh/gochat/rpc/user/internal/svc"
 	"github.com/joho/godotenv"
@@ -36,6 +38,9 @@ func main() {
 	conf.MustLoad(*configFile, &c, conf.UseEnv())
 	ctx := svc.NewServiceContext(c)
 
+	// Erases deleted accounts once their grace period is over
+	go logic.NewAccountDeletionWorker(ctx).Start(context.Background())
+
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
 		pb.RegisterUserServiceServer(grpcServer, server.NewUserServiceServer(ctx))
 
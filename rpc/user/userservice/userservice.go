@@ -14,33 +14,137 @@ import (
 )
 
 type (
-	ForgotPasswordRequest  = pb.ForgotPasswordRequest
-	ForgotPasswordResponse = pb.ForgotPasswordResponse
-	GetCurrentUserRequest  = pb.GetCurrentUserRequest
-	GetCurrentUserResponse = pb.GetCurrentUserResponse
-	GetUserRequest         = pb.GetUserRequest
-	GetUserResponse        = pb.GetUserResponse
-	GetUsersByIdsRequest   = pb.GetUsersByIdsRequest
-	GetUsersByIdsResponse  = pb.GetUsersByIdsResponse
-	LoginRequest           = pb.LoginRequest
-	LoginResponse          = pb.LoginResponse
-	RegisterRequest        = pb.RegisterRequest
-	RegisterResponse       = pb.RegisterResponse
-	SearchUsersRequest     = pb.SearchUsersRequest
-	SearchUsersResponse    = pb.SearchUsersResponse
-	UpdateUserRequest      = pb.UpdateUserRequest
-	UpdateUserResponse     = pb.UpdateUserResponse
-	User                   = pb.User
+	AccountDeletion              = pb.AccountDeletion
+	BatchGetPresenceRequest      = pb.BatchGetPresenceRequest
+	BatchGetPresenceResponse     = pb.BatchGetPresenceResponse
+	CheckFriendAnswerRequest     = pb.CheckFriendAnswerRequest
+	CheckFriendAnswerResponse    = pb.CheckFriendAnswerResponse
+	CompleteDeletionStepRequest  = pb.CompleteDeletionStepRequest
+	CompleteDeletionStepResponse = pb.CompleteDeletionStepResponse
+	ConfirmTotpRequest           = pb.ConfirmTotpRequest
+	ConfirmTotpResponse          = pb.ConfirmTotpResponse
+	ContactPolicy                = pb.ContactPolicy
+	DeactivateAccountRequest     = pb.DeactivateAccountRequest
+	DeactivateAccountResponse    = pb.DeactivateAccountResponse
+	DeleteAccountRequest         = pb.DeleteAccountRequest
+	DeleteAccountResponse        = pb.DeleteAccountResponse
+	Device                       = pb.Device
+	DisableTotpRequest           = pb.DisableTotpRequest
+	DisableTotpResponse          = pb.DisableTotpResponse
+	EnrollTotpRequest            = pb.EnrollTotpRequest
+	EnrollTotpResponse           = pb.EnrollTotpResponse
+	ForgotPasswordRequest        = pb.ForgotPasswordRequest
+	ForgotPasswordResponse       = pb.ForgotPasswordResponse
+	GetAccountDeletionRequest    = pb.GetAccountDeletionRequest
+	GetAccountDeletionResponse   = pb.GetAccountDeletionResponse
+	GetContactPoliciesRequest    = pb.GetContactPoliciesRequest
+	GetContactPoliciesResponse   = pb.GetContactPoliciesResponse
+	GetCurrentUserRequest        = pb.GetCurrentUserRequest
+	GetCurrentUserResponse       = pb.GetCurrentUserResponse
+	GetNotifySettingRequest      = pb.GetNotifySettingRequest
+	GetNotifySettingResponse     = pb.GetNotifySettingResponse
+	GetPresenceRequest           = pb.GetPresenceRequest
+	GetPresenceResponse          = pb.GetPresenceResponse
+	GetPrivacySettingRequest     = pb.GetPrivacySettingRequest
+	GetPrivacySettingResponse    = pb.GetPrivacySettingResponse
+	GetPushTargetsRequest        = pb.GetPushTargetsRequest
+	GetPushTargetsResponse       = pb.GetPushTargetsResponse
+	GetUserRequest               = pb.GetUserRequest
+	GetUserResponse              = pb.GetUserResponse
+	GetUsersByIdsRequest         = pb.GetUsersByIdsRequest
+	GetUsersByIdsResponse        = pb.GetUsersByIdsResponse
+	KickUserRequest              = pb.KickUserRequest
+	KickUserResponse             = pb.KickUserResponse
+	ListDevicesRequest           = pb.ListDevicesRequest
+	ListDevicesResponse          = pb.ListDevicesResponse
+	ListLoginHistoryRequest      = pb.ListLoginHistoryRequest
+	ListLoginHistoryResponse     = pb.ListLoginHistoryResponse
+	ListOidcProvidersRequest     = pb.ListOidcProvidersRequest
+	ListOidcProvidersResponse    = pb.ListOidcProvidersResponse
+	LoginRecord                  = pb.LoginRecord
+	LoginRequest                 = pb.LoginRequest
+	LoginResponse                = pb.LoginResponse
+	LogoutRequest                = pb.LogoutRequest
+	LogoutResponse               = pb.LogoutResponse
+	NotifySetting                = pb.NotifySetting
+	OidcLoginRequest             = pb.OidcLoginRequest
+	OidcProvider                 = pb.OidcProvider
+	PushDevice                   = pb.PushDevice
+	PushTarget                   = pb.PushTarget
+	ReactivateAccountRequest     = pb.ReactivateAccountRequest
+	ReactivateAccountResponse    = pb.ReactivateAccountResponse
+	RefreshTokenRequest          = pb.RefreshTokenRequest
+	RefreshTokenResponse         = pb.RefreshTokenResponse
+	RegisterDeviceRequest        = pb.RegisterDeviceRequest
+	RegisterDeviceResponse       = pb.RegisterDeviceResponse
+	RegisterRequest              = pb.RegisterRequest
+	RegisterResponse             = pb.RegisterResponse
+	RemoveDeviceRequest          = pb.RemoveDeviceRequest
+	RemoveDeviceResponse         = pb.RemoveDeviceResponse
+	ResetPasswordRequest         = pb.ResetPasswordRequest
+	ResetPasswordResponse        = pb.ResetPasswordResponse
+	SearchUsersRequest           = pb.SearchUsersRequest
+	SearchUsersResponse          = pb.SearchUsersResponse
+	SendVerificationCodeRequest  = pb.SendVerificationCodeRequest
+	SendVerificationCodeResponse = pb.SendVerificationCodeResponse
+	SetUserStatusRequest         = pb.SetUserStatusRequest
+	SetUserStatusResponse        = pb.SetUserStatusResponse
+	StartOidcLoginRequest        = pb.StartOidcLoginRequest
+	StartOidcLoginResponse       = pb.StartOidcLoginResponse
+	UpdateNotifySettingRequest   = pb.UpdateNotifySettingRequest
+	UpdateNotifySettingResponse  = pb.UpdateNotifySettingResponse
+	UpdatePrivacySettingRequest  = pb.UpdatePrivacySettingRequest
+	UpdatePrivacySettingResponse = pb.UpdatePrivacySettingResponse
+	UpdateUserRequest            = pb.UpdateUserRequest
+	UpdateUserResponse           = pb.UpdateUserResponse
+	User                         = pb.User
+	VerifyContactRequest         = pb.VerifyContactRequest
+	VerifyContactResponse        = pb.VerifyContactResponse
+	VerifyMfaRequest             = pb.VerifyMfaRequest
 
 	UserService interface {
 		Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
 		Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+		VerifyMfa(ctx context.Context, in *VerifyMfaRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 		GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 		GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...grpc.CallOption) (*GetCurrentUserResponse, error)
 		UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
 		SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
 		GetUsersByIds(ctx context.Context, in *GetUsersByIdsRequest, opts ...grpc.CallOption) (*GetUsersByIdsResponse, error)
 		ForgotPassword(ctx context.Context, in *ForgotPasswordRequest, opts ...grpc.CallOption) (*ForgotPasswordResponse, error)
+		GetNotifySetting(ctx context.Context, in *GetNotifySettingRequest, opts ...grpc.CallOption) (*GetNotifySettingResponse, error)
+		UpdateNotifySetting(ctx context.Context, in *UpdateNotifySettingRequest, opts ...grpc.CallOption) (*UpdateNotifySettingResponse, error)
+		// only for message service use
+		GetPushTargets(ctx context.Context, in *GetPushTargetsRequest, opts ...grpc.CallOption) (*GetPushTargetsResponse, error)
+		RegisterDevice(ctx context.Context, in *RegisterDeviceRequest, opts ...grpc.CallOption) (*RegisterDeviceResponse, error)
+		ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error)
+		RemoveDevice(ctx context.Context, in *RemoveDeviceRequest, opts ...grpc.CallOption) (*RemoveDeviceResponse, error)
+		// Admin: force users off their gateways, and ban accounts or lift their bans
+		KickUser(ctx context.Context, in *KickUserRequest, opts ...grpc.CallOption) (*KickUserResponse, error)
+		SetUserStatus(ctx context.Context, in *SetUserStatusRequest, opts ...grpc.CallOption) (*SetUserStatusResponse, error)
+		GetPresence(ctx context.Context, in *GetPresenceRequest, opts ...grpc.CallOption) (*GetPresenceResponse, error)
+		BatchGetPresence(ctx context.Context, in *BatchGetPresenceRequest, opts ...grpc.CallOption) (*BatchGetPresenceResponse, error)
+		GetPrivacySetting(ctx context.Context, in *GetPrivacySettingRequest, opts ...grpc.CallOption) (*GetPrivacySettingResponse, error)
+		UpdatePrivacySetting(ctx context.Context, in *UpdatePrivacySettingRequest, opts ...grpc.CallOption) (*UpdatePrivacySettingResponse, error)
+		RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+		Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+		ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error)
+		SendVerificationCode(ctx context.Context, in *SendVerificationCodeRequest, opts ...grpc.CallOption) (*SendVerificationCodeResponse, error)
+		VerifyContact(ctx context.Context, in *VerifyContactRequest, opts ...grpc.CallOption) (*VerifyContactResponse, error)
+		ListLoginHistory(ctx context.Context, in *ListLoginHistoryRequest, opts ...grpc.CallOption) (*ListLoginHistoryResponse, error)
+		EnrollTotp(ctx context.Context, in *EnrollTotpRequest, opts ...grpc.CallOption) (*EnrollTotpResponse, error)
+		ConfirmTotp(ctx context.Context, in *ConfirmTotpRequest, opts ...grpc.CallOption) (*ConfirmTotpResponse, error)
+		DisableTotp(ctx context.Context, in *DisableTotpRequest, opts ...grpc.CallOption) (*DisableTotpResponse, error)
+		ListOidcProviders(ctx context.Context, in *ListOidcProvidersRequest, opts ...grpc.CallOption) (*ListOidcProvidersResponse, error)
+		StartOidcLogin(ctx context.Context, in *StartOidcLoginRequest, opts ...grpc.CallOption) (*StartOidcLoginResponse, error)
+		OidcLogin(ctx context.Context, in *OidcLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+		DeactivateAccount(ctx context.Context, in *DeactivateAccountRequest, opts ...grpc.CallOption) (*DeactivateAccountResponse, error)
+		DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
+		ReactivateAccount(ctx context.Context, in *ReactivateAccountRequest, opts ...grpc.CallOption) (*ReactivateAccountResponse, error)
+		GetAccountDeletion(ctx context.Context, in *GetAccountDeletionRequest, opts ...grpc.CallOption) (*GetAccountDeletionResponse, error)
+		CompleteDeletionStep(ctx context.Context, in *CompleteDeletionStepRequest, opts ...grpc.CallOption) (*CompleteDeletionStepResponse, error)
+		GetContactPolicies(ctx context.Context, in *GetContactPoliciesRequest, opts ...grpc.CallOption) (*GetContactPoliciesResponse, error)
+		CheckFriendAnswer(ctx context.Context, in *CheckFriendAnswerRequest, opts ...grpc.CallOption) (*CheckFriendAnswerResponse, error)
 	}
 
 	defaultUserService struct {
@@ -64,6 +168,11 @@ func (m *defaultUserService) Login(ctx context.Context, in *LoginRequest, opts .
 	return client.Login(ctx, in, opts...)
 }
 
+func (m *defaultUserService) VerifyMfa(ctx context.Context, in *VerifyMfaRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.VerifyMfa(ctx, in, opts...)
+}
+
 func (m *defaultUserService) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
 	client := pb.NewUserServiceClient(m.cli.Conn())
 	return client.GetUser(ctx, in, opts...)
@@ -93,3 +202,160 @@ func (m *defaultUserService) ForgotPassword(ctx context.Context, in *ForgotPassw
 	client := pb.NewUserServiceClient(m.cli.Conn())
 	return client.ForgotPassword(ctx, in, opts...)
 }
+
+func (m *defaultUserService) GetNotifySetting(ctx context.Context, in *GetNotifySettingRequest, opts ...grpc.CallOption) (*GetNotifySettingResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.GetNotifySetting(ctx, in, opts...)
+}
+
+func (m *defaultUserService) UpdateNotifySetting(ctx context.Context, in *UpdateNotifySettingRequest, opts ...grpc.CallOption) (*UpdateNotifySettingResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.UpdateNotifySetting(ctx, in, opts...)
+}
+
+// only for message service use
+func (m *defaultUserService) GetPushTargets(ctx context.Context, in *GetPushTargetsRequest, opts ...grpc.CallOption) (*GetPushTargetsResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.GetPushTargets(ctx, in, opts...)
+}
+
+func (m *defaultUserService) RegisterDevice(ctx context.Context, in *RegisterDeviceRequest, opts ...grpc.CallOption) (*RegisterDeviceResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.RegisterDevice(ctx, in, opts...)
+}
+
+func (m *defaultUserService) ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.ListDevices(ctx, in, opts...)
+}
+
+func (m *defaultUserService) RemoveDevice(ctx context.Context, in *RemoveDeviceRequest, opts ...grpc.CallOption) (*RemoveDeviceResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.RemoveDevice(ctx, in, opts...)
+}
+
+// Admin: force users off their gateways, and ban accounts or lift their bans
+func (m *defaultUserService) KickUser(ctx context.Context, in *KickUserRequest, opts ...grpc.CallOption) (*KickUserResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.KickUser(ctx, in, opts...)
+}
+
+func (m *defaultUserService) SetUserStatus(ctx context.Context, in *SetUserStatusRequest, opts ...grpc.CallOption) (*SetUserStatusResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.SetUserStatus(ctx, in, opts...)
+}
+
+func (m *defaultUserService) GetPresence(ctx context.Context, in *GetPresenceRequest, opts ...grpc.CallOption) (*GetPresenceResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.GetPresence(ctx, in, opts...)
+}
+
+func (m *defaultUserService) BatchGetPresence(ctx context.Context, in *BatchGetPresenceRequest, opts ...grpc.CallOption) (*BatchGetPresenceResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.BatchGetPresence(ctx, in, opts...)
+}
+
+func (m *defaultUserService) GetPrivacySetting(ctx context.Context, in *GetPrivacySettingRequest, opts ...grpc.CallOption) (*GetPrivacySettingResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.GetPrivacySetting(ctx, in, opts...)
+}
+
+func (m *defaultUserService) UpdatePrivacySetting(ctx context.Context, in *UpdatePrivacySettingRequest, opts ...grpc.CallOption) (*UpdatePrivacySettingResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.UpdatePrivacySetting(ctx, in, opts...)
+}
+
+func (m *defaultUserService) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.RefreshToken(ctx, in, opts...)
+}
+
+func (m *defaultUserService) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.Logout(ctx, in, opts...)
+}
+
+func (m *defaultUserService) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.ResetPassword(ctx, in, opts...)
+}
+
+func (m *defaultUserService) SendVerificationCode(ctx context.Context, in *SendVerificationCodeRequest, opts ...grpc.CallOption) (*SendVerificationCodeResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.SendVerificationCode(ctx, in, opts...)
+}
+
+func (m *defaultUserService) VerifyContact(ctx context.Context, in *VerifyContactRequest, opts ...grpc.CallOption) (*VerifyContactResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.VerifyContact(ctx, in, opts...)
+}
+
+func (m *defaultUserService) ListLoginHistory(ctx context.Context, in *ListLoginHistoryRequest, opts ...grpc.CallOption) (*ListLoginHistoryResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.ListLoginHistory(ctx, in, opts...)
+}
+
+func (m *defaultUserService) EnrollTotp(ctx context.Context, in *EnrollTotpRequest, opts ...grpc.CallOption) (*EnrollTotpResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.EnrollTotp(ctx, in, opts...)
+}
+
+func (m *defaultUserService) ConfirmTotp(ctx context.Context, in *ConfirmTotpRequest, opts ...grpc.CallOption) (*ConfirmTotpResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.ConfirmTotp(ctx, in, opts...)
+}
+
+func (m *defaultUserService) DisableTotp(ctx context.Context, in *DisableTotpRequest, opts ...grpc.CallOption) (*DisableTotpResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.DisableTotp(ctx, in, opts...)
+}
+
+func (m *defaultUserService) ListOidcProviders(ctx context.Context, in *ListOidcProvidersRequest, opts ...grpc.CallOption) (*ListOidcProvidersResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.ListOidcProviders(ctx, in, opts...)
+}
+
+func (m *defaultUserService) StartOidcLogin(ctx context.Context, in *StartOidcLoginRequest, opts ...grpc.CallOption) (*StartOidcLoginResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.StartOidcLogin(ctx, in, opts...)
+}
+
+func (m *defaultUserService) OidcLogin(ctx context.Context, in *OidcLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.OidcLogin(ctx, in, opts...)
+}
+
+func (m *defaultUserService) DeactivateAccount(ctx context.Context, in *DeactivateAccountRequest, opts ...grpc.CallOption) (*DeactivateAccountResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.DeactivateAccount(ctx, in, opts...)
+}
+
+func (m *defaultUserService) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.DeleteAccount(ctx, in, opts...)
+}
+
+func (m *defaultUserService) ReactivateAccount(ctx context.Context, in *ReactivateAccountRequest, opts ...grpc.CallOption) (*ReactivateAccountResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.ReactivateAccount(ctx, in, opts...)
+}
+
+func (m *defaultUserService) GetAccountDeletion(ctx context.Context, in *GetAccountDeletionRequest, opts ...grpc.CallOption) (*GetAccountDeletionResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.GetAccountDeletion(ctx, in, opts...)
+}
+
+func (m *defaultUserService) CompleteDeletionStep(ctx context.Context, in *CompleteDeletionStepRequest, opts ...grpc.CallOption) (*CompleteDeletionStepResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.CompleteDeletionStep(ctx, in, opts...)
+}
+
+func (m *defaultUserService) GetContactPolicies(ctx context.Context, in *GetContactPoliciesRequest, opts ...grpc.CallOption) (*GetContactPoliciesResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.GetContactPolicies(ctx, in, opts...)
+}
+
+func (m *defaultUserService) CheckFriendAnswer(ctx context.Context, in *CheckFriendAnswerRequest, opts ...grpc.CallOption) (*CheckFriendAnswerResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.CheckFriendAnswer(ctx, in, opts...)
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/archyhsh/gochat/pkg/auth"
+	"github.com/archyhsh/gochat/pkg/moderation"
+)
+
+var (
+	listen = flag.String("listen", "127.0.0.1:9310", "Listen address")
+	secret = flag.String("secret", "", "Classifier secret; signatures are checked when set")
+	words  = flag.String("words", "spam,scam", "Comma separated words classified as abuse with score 1")
+)
+
+// nonceStore keeps nonces in memory, which is enough for a single stub process
+type nonceStore struct {
+	mu   sync.Mutex
+	seen map[string]struct{}
+}
+
+func (s *nonceStore) Claim(_ context.Context, nonce string, _ time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.seen[nonce]; ok {
+		return false, nil
+	}
+	s.seen[nonce] = struct{}{}
+	return true, nil
+}
+
+func main() {
+	flag.Parse()
+
+	var verifier *auth.Verifier
+	if *secret != "" {
+		verifier = auth.NewVerifier(*secret, auth.DefaultMaxSkew, &nonceStore{seen: make(map[string]struct{})})
+	}
+	var abusive []string
+	for _, w := range strings.Split(*words, ",") {
+		if w = strings.TrimSpace(w); w != "" {
+			abusive = append(abusive, w)
+		}
+	}
+	matcher := moderation.NewMatcher(abusive)
+
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if verifier != nil {
+			if err := verifier.VerifyRequest(r); err != nil {
+				log.Printf("rejected: %v", err)
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+		}
+		body, _ := io.ReadAll(r.Body)
+		var req struct {
+			Text string `json:"text"`
+		}
+		if err := json.Unmarshal(body, &req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		res := moderation.Classification{}
+		if len(matcher.Find(req.Text)) > 0 {
+			res = moderation.Classification{Label: "abuse", Score: 1}
+		}
+		log.Printf("text=%q label=%q score=%.2f", req.Text, res.Label, res.Score)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(res)
+	})
+
+	log.Printf("Moderation classifier stub listening on %s", *listen)
+	log.Fatal(http.ListenAndServe(*listen, nil))
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/archyhsh/gochat/pkg/auth"
+	"github.com/archyhsh/gochat/pkg/notify"
+)
+
+var (
+	listen = flag.String("listen", "127.0.0.1:9300", "Listen address")
+	secret = flag.String("secret", "", "Webhook secret; signatures are checked when set")
+	gone   = flag.String("gone", "", "Comma separated tokens answered with 410 Gone")
+)
+
+// nonceStore keeps nonces in memory, which is enough for a single stub process
+type nonceStore struct {
+	mu   sync.Mutex
+	seen map[string]struct{}
+}
+
+func (s *nonceStore) Claim(_ context.Context, nonce string, _ time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.seen[nonce]; ok {
+		return false, nil
+	}
+	s.seen[nonce] = struct{}{}
+	return true, nil
+}
+
+func main() {
+	flag.Parse()
+
+	var verifier *auth.Verifier
+	if *secret != "" {
+		verifier = auth.NewVerifier(*secret, auth.DefaultMaxSkew, &nonceStore{seen: make(map[string]struct{})})
+	}
+	unregistered := make(map[string]bool)
+	for _, t := range strings.Split(*gone, ",") {
+		if t != "" {
+			unregistered[t] = true
+		}
+	}
+
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if verifier != nil {
+			if err := verifier.VerifyRequest(r); err != nil {
+				log.Printf("rejected: %v", err)
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+		}
+		body, _ := io.ReadAll(r.Body)
+		var n notify.Notification
+		if err := json.Unmarshal(body, &n); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if unregistered[n.Token] {
+			log.Printf("token %s unregistered", n.Token)
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+		log.Printf("user=%d device=%s platform=%s silent=%v badge=%d collapse=%s title=%q body=%q",
+			n.UserId, n.DeviceId, n.Platform, n.Silent, n.Badge, n.CollapseKey, n.Title, n.Body)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	log.Printf("Notification stub listening on %s", *listen)
+	log.Fatal(http.ListenAndServe(*listen, nil))
+}
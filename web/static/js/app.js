@@ -1,9 +1,13 @@
 // GoChat Premium Client v2.8.2 - Master Build Final Correction
 const API_BASE = ''; 
+// WebSocket subprotocol: IncomingMessage/OutgoingMessage envelopes as protojson text frames
+const WS_PROTOCOL = 'gochat.v1.json';
 
 class GoChatApp {
     constructor() {
         this.token = localStorage.getItem('token');
+        this.refreshToken = localStorage.getItem('refresh_token');
+        this.tokenExpiresAt = Number(localStorage.getItem('token_expires_at') || 0);
         this.user = JSON.parse(localStorage.getItem('user') || 'null');
         this.currentChat = null; // { conversation_id, peer_id, isGroup }
         this.conversations = [];
@@ -16,11 +20,16 @@ class GoChatApp {
         // Cache for versioning (Identity Management)
         this.knownUsers = {}; 
         this.knownGroups = {}; 
+        this.presence = {}; // user_id -> online | away | offline
         
         this.currentView = 'chats'; 
         this.ws = null;
         this.reconnectAttempts = 0;
         this.heartbeatTimer = null;
+        this.pendingFrames = {}; // trace_id -> { resolve, reject } awaiting an ACK or ERROR
+        this.traceSeq = 0;
+        this.typingSentAt = 0;
+        this.typingTimer = null;
         this.searchTimer = null;
         
         this.init();
@@ -37,15 +46,35 @@ class GoChatApp {
                 await this.loadInitialData();
                 this.connectWebSocket();
             } catch (err) { this.handleLogout(); }
-        } else this.showAuth();
+        } else {
+            this.showAuth();
+            this.loadSsoProviders();
+            const params = new URLSearchParams(window.location.search);
+            if (params.get('state') && params.get('code')) {
+                // Redirected back by a single sign-on provider
+                window.history.replaceState(null, '', window.location.pathname);
+                this.handleSsoCallback(params.get('state'), params.get('code'));
+            } else if (params.get('code')) {
+                // Opened from a password reset link: ?username=...&code=...
+                this.showForgot();
+                document.getElementById('forgot-username').value = params.get('username') || '';
+                document.getElementById('forgot-code').value = params.get('code');
+            }
+        }
     }
 
     bindEvents() {
+        // --- Presence: tell the gateway when the tab goes to the background ---
+        document.addEventListener('visibilitychange', () => {
+            if (this.ws?.readyState === WebSocket.OPEN) this.ws.send(document.hidden ? 'away' : 'active');
+        });
+
         // --- Authentication ---
         document.getElementById('login-btn').onclick = () => this.handleLogin();
         document.getElementById('register-btn').onclick = () => this.handleRegister();
-        document.getElementById('logout-btn').onclick = () => this.handleLogout();
-        document.getElementById('reset-btn').onclick = () => this.handleForgotPassword();
+        document.getElementById('logout-btn').onclick = () => this.signOut();
+        document.getElementById('send-code-btn').onclick = () => this.handleForgotPassword();
+        document.getElementById('reset-btn').onclick = () => this.handleResetPassword();
         
         document.querySelectorAll('.auth-tab').forEach(tab => tab.onclick = () => this.switchAuthTab(tab.dataset.type));
         document.querySelectorAll('.nav-item').forEach(item => item.onclick = () => this.switchView(item.dataset.view));
@@ -55,6 +84,7 @@ class GoChatApp {
         document.getElementById('chat-input').onkeypress = (e) => {
             if (e.key === 'Enter') this.handleSendMessage();
         };
+        document.getElementById('chat-input').oninput = () => this.sendTyping(true);
 
         // --- Group Actions ---
         document.getElementById('create-group-btn').onclick = () => {
@@ -78,20 +108,49 @@ class GoChatApp {
         };
     }
 
-    async request(path, options = {}) {
-        const headers = options.headers || {};
+    async request(path, options = {}, retried = false) {
+        const headers = { ...(options.headers || {}) };
         if (this.token) headers['Authorization'] = `Bearer ${this.token}`;
         if (options.body && !headers['Content-Type']) headers['Content-Type'] = 'application/json';
         const resp = await fetch(`${API_BASE}${path}`, { ...options, headers });
         let data = {};
         try { if (resp.status !== 204) data = await resp.json(); } catch(e) {}
         if (!resp.ok) {
+            // An expired access token is renewed once with the refresh token, then the request is retried
+            if (resp.status === 401 && !retried && await this.refreshSession()) return this.request(path, options, true);
             if (resp.status === 401) this.handleLogout();
             throw new Error(data.message || 'Request failed');
         }
         return data;
     }
 
+    saveTokens(data) {
+        this.token = data.token;
+        this.refreshToken = data.refresh_token || null;
+        this.tokenExpiresAt = Date.now() + (data.expires_in || 0) * 1000;
+        localStorage.setItem('token', this.token);
+        if (this.refreshToken) localStorage.setItem('refresh_token', this.refreshToken);
+        localStorage.setItem('token_expires_at', String(this.tokenExpiresAt));
+    }
+
+    // refreshSession trades the refresh token for a new pair; concurrent callers share one call,
+    // since a refresh token presented twice signs the device out
+    refreshSession() {
+        if (!this.refreshToken) return Promise.resolve(false);
+        if (!this.refreshing) {
+            this.refreshing = fetch(`${API_BASE}/token/refresh`, {
+                method: 'POST',
+                headers: { 'Content-Type': 'application/json' },
+                body: JSON.stringify({ refresh_token: this.refreshToken }),
+            }).then(async resp => {
+                if (!resp.ok) return false;
+                this.saveTokens(await resp.json());
+                return true;
+            }).catch(() => false).finally(() => { this.refreshing = null; });
+        }
+        return this.refreshing;
+    }
+
     // --- UI View Control ---
     showApp() {
         document.getElementById('auth-page').classList.add('hidden');
@@ -193,16 +252,88 @@ class GoChatApp {
         const password = document.getElementById('login-password').value;
         const errorEl = document.getElementById('auth-error');
         try {
-            const data = await this.request('/login', { method: 'POST', body: JSON.stringify({ username, password }) });
-            this.token = data.token; this.user = data.user;
-            localStorage.setItem('token', this.token);
-            localStorage.setItem('user', JSON.stringify(this.user));
-            this.showApp();
-            await this.loadInitialData();
-            this.connectWebSocket();
+            const device_id = localStorage.getItem('device_id') || '';
+            const data = await this.request('/login', { method: 'POST', body: JSON.stringify({ username, password, device_id, platform: 'web' }) });
+            await this.finishLogin(data);
+        } catch (err) {
+            // A closed account is reopened on request, then the sign-in is repeated
+            if (/reactivate it/.test(err.message) && confirm(`${err.message}.\n\nReactivate the account now?`)) return this.handleReactivate(username, password);
+            errorEl.textContent = err.message; errorEl.classList.remove('hidden');
+        }
+    }
+
+    async handleReactivate(username, password) {
+        const errorEl = document.getElementById('auth-error');
+        try {
+            const body = { username, password };
+            try {
+                await this.request('/account/reactivate', { method: 'POST', body: JSON.stringify(body) });
+            } catch (err) {
+                if (!/code or recovery_code/.test(err.message)) throw err;
+                const code = prompt('Enter the code from your authenticator app, or a recovery code:');
+                if (!code) return;
+                if (/^\d{6}$/.test(code.trim())) body.code = code.trim(); else body.recovery_code = code.trim();
+                await this.request('/account/reactivate', { method: 'POST', body: JSON.stringify(body) });
+            }
+            await this.handleLogin();
         } catch (err) { errorEl.textContent = err.message; errorEl.classList.remove('hidden'); }
     }
 
+    // finishLogin takes a /login or /oauth/callback response, running the 2FA step when it is due
+    async finishLogin(data) {
+        if (data.mfa_required) data = await this.completeMfa(data);
+        if (!data) return;
+        this.saveTokens(data); this.user = data.user;
+        localStorage.setItem('device_id', data.device_id);
+        localStorage.setItem('user', JSON.stringify(this.user));
+        this.showApp();
+        await this.loadInitialData();
+        this.connectWebSocket();
+    }
+
+    // --- Single sign-on ---
+    async loadSsoProviders() {
+        try {
+            const data = await this.request('/oauth/providers');
+            document.getElementById('sso-providers').innerHTML = (data.providers || []).map(p =>
+                `<button class="auth-btn secondary" onclick="app.startSso('${encodeURIComponent(p.name)}')">Sign in with ${p.display_name}</button>`).join('');
+        } catch (e) { console.error('SSO providers error:', e); }
+    }
+
+    async startSso(provider) {
+        try {
+            const device_id = localStorage.getItem('device_id') || '';
+            const data = await this.request(`/oauth/${provider}/start`, { method: 'POST', body: JSON.stringify({ device_id, platform: 'web' }) });
+            window.location.href = data.authorization_url;
+        } catch (err) { alert(err.message); }
+    }
+
+    async handleSsoCallback(state, code) {
+        const errorEl = document.getElementById('auth-error');
+        try {
+            const data = await this.request('/oauth/callback', { method: 'POST', body: JSON.stringify({ state, code }) });
+            await this.finishLogin(data);
+        } catch (err) { errorEl.textContent = err.message; errorEl.classList.remove('hidden'); }
+    }
+
+    // completeMfa runs the second sign-in step, enrolling first when the account must use 2FA
+    // but has not set it up; it returns the final login response, or null when cancelled
+    async completeMfa(data) {
+        const mfa_token = data.mfa_token;
+        if (data.mfa_enrollment_required) {
+            const enroll = await this.request('/login/mfa/enroll', { method: 'POST', body: JSON.stringify({ mfa_token }) });
+            const code = prompt(`This account requires two-factor authentication.\nAdd this key to your authenticator app:\n${enroll.secret}\n\nThen enter the 6-digit code it shows:`);
+            if (!code) return null;
+            const confirmed = await this.request('/login/mfa/confirm', { method: 'POST', body: JSON.stringify({ mfa_token, code: code.trim() }) });
+            alert(`Save these recovery codes, each works once if you lose your device:\n\n${confirmed.recovery_codes.join('\n')}`);
+            return confirmed.login;
+        }
+        const code = prompt('Enter the code from your authenticator app, or a recovery code:');
+        if (!code) return null;
+        const body = /^\d{6}$/.test(code.trim()) ? { mfa_token, code: code.trim() } : { mfa_token, recovery_code: code.trim() };
+        return this.request('/login/mfa', { method: 'POST', body: JSON.stringify(body) });
+    }
+
     async handleRegister() {
         const username = document.getElementById('reg-username').value;
         const nickname = document.getElementById('reg-nickname').value;
@@ -216,19 +347,44 @@ class GoChatApp {
 
     async handleForgotPassword() {
         const username = document.getElementById('forgot-username').value;
+        try {
+            const data = await this.request('/forgot_password', { method: 'POST', body: JSON.stringify({ username }) });
+            alert(data.message);
+        } catch (err) { alert(err.message); }
+    }
+
+    async handleResetPassword() {
+        const username = document.getElementById('forgot-username').value;
+        const code = document.getElementById('forgot-code').value.trim();
         const new_password = document.getElementById('forgot-new-password').value;
         try {
-            await this.request('/forgot_password', { method: 'POST', body: JSON.stringify({ username, new_password }) });
-            alert('Password reset successful!');
+            await this.request('/reset_password', { method: 'POST', body: JSON.stringify({ username, code, new_password }) });
+            alert('Password reset successful! Please sign in again.');
             this.switchAuthTab('login');
         } catch (err) { alert(err.message); }
     }
 
+    // signOut revokes the device's tokens on the server; the socket is closed first so the
+    // resulting kick does not show up as a disconnect
+    signOut() {
+        const token = this.token, refresh_token = this.refreshToken || '';
+        this.handleLogout();
+        if (!token) return;
+        fetch(`${API_BASE}/logout`, {
+            method: 'POST',
+            headers: { 'Authorization': `Bearer ${token}`, 'Content-Type': 'application/json' },
+            body: JSON.stringify({ refresh_token }),
+        }).catch(() => {});
+    }
+
     handleLogout() {
         this.stopHeartbeat();
         if (this.ws) this.ws.close();
-        this.token = this.user = null;
+        this.token = this.refreshToken = this.user = null;
+        // The browser stays the same device across sign-ins
+        const deviceId = localStorage.getItem('device_id');
         localStorage.clear();
+        if (deviceId) localStorage.setItem('device_id', deviceId);
         this.showAuth();
     }
 
@@ -250,6 +406,25 @@ class GoChatApp {
         } catch (err) { alert(err.message); }
     }
 
+    async handleDeactivateAccount() {
+        const password = prompt('Deactivating hides your profile and signs you out everywhere until you sign in and reactivate it.\n\nEnter your password to continue:');
+        if (!password) return;
+        try {
+            await this.request('/user/me/deactivate', { method: 'POST', body: JSON.stringify({ password }) });
+            this.handleLogout();
+        } catch (err) { alert(err.message); }
+    }
+
+    async handleDeleteAccount() {
+        const password = prompt('Your account, friends, groups and conversations will be deleted for good after a grace period. Signing in and reactivating before then cancels it.\n\nEnter your password to continue:');
+        if (!password) return;
+        try {
+            const data = await this.request('/user/me/delete', { method: 'POST', body: JSON.stringify({ password }) });
+            alert(`Your account will be deleted on ${new Date(data.delete_after * 1000).toLocaleString()}.`);
+            this.handleLogout();
+        } catch (err) { alert(err.message); }
+    }
+
     updateMyProfile() {
         if (!this.user) return;
         document.getElementById('my-name').textContent = this.user.nickname;
@@ -258,27 +433,115 @@ class GoChatApp {
     }
 
     // --- Real-time Logic ---
-    connectWebSocket() {
+    async connectWebSocket() {
         if (this.ws) this.ws.close();
+        // The handshake is authenticated with the access token, so renew it first if it expired
+        if (this.tokenExpiresAt && Date.now() >= this.tokenExpiresAt - 5000 && !(await this.refreshSession())) return this.handleLogout();
         const wsUrl = `${window.location.protocol === 'https:' ? 'wss:' : 'ws:'}//${window.location.host}/ws?token=${this.token}`;
-        this.ws = new WebSocket(wsUrl);
-        this.ws.onopen = () => { this.reconnectAttempts = 0; this.startHeartbeat(); };
+        this.ws = new WebSocket(wsUrl, [WS_PROTOCOL]);
+        this.ws.onopen = () => { this.reconnectAttempts = 0; this.startHeartbeat(); if (document.hidden) this.ws.send('away'); this.syncNotifications(); };
         this.ws.onmessage = (event) => {
             if (event.data === 'pong') return;
-            try { this.onReceiveRealtimeMessage(JSON.parse(event.data)); } catch (e) {}
+            let frame;
+            try { frame = JSON.parse(event.data); } catch (e) { return; }
+            // A server without the subprotocol still pushes the legacy flat JSON
+            if (this.ws.protocol !== WS_PROTOCOL) return this.onReceiveRealtimeMessage(frame);
+            this.onFrame(frame);
         };
-        this.ws.onclose = () => {
+        this.ws.onclose = (event) => {
             this.stopHeartbeat();
+            Object.values(this.pendingFrames).forEach(p => p.reject(new Error('Connection lost')));
+            this.pendingFrames = {};
+            // 4000-4999: kicked by the server (password changed, banned, logged in elsewhere, device removed)
+            if (event.code >= 4000 && event.code < 5000) {
+                alert(`Disconnected: ${event.reason || 'signed out by the server'}`);
+                this.handleLogout();
+                return;
+            }
             if (this.token) setTimeout(() => { this.reconnectAttempts++; this.connectWebSocket(); }, Math.min(1000 * Math.pow(2, this.reconnectAttempts), 30000));
         };
     }
 
     startHeartbeat() {
         this.stopHeartbeat();
-        this.heartbeatTimer = setInterval(() => { if (this.ws?.readyState === WebSocket.OPEN) this.ws.send('ping'); }, 30000);
+        this.heartbeatTimer = setInterval(() => {
+            if (this.ws?.readyState !== WebSocket.OPEN) return;
+            if (this.ws.protocol === WS_PROTOCOL) this.sendFrame('TYPE_HEARTBEAT');
+            else this.ws.send('ping');
+        }, 30000);
     }
     stopHeartbeat() { if (this.heartbeatTimer) clearInterval(this.heartbeatTimer); }
 
+    socketReady() { return this.ws?.readyState === WebSocket.OPEN && this.ws.protocol === WS_PROTOCOL; }
+
+    // sendFrame writes an IncomingMessage; with awaitReply it resolves on the matching ACK and rejects on ERROR
+    sendFrame(type, payloadKey, payload, awaitReply = false) {
+        const frame = { type, trace_id: `t${Date.now()}_${++this.traceSeq}` };
+        if (payloadKey) frame[payloadKey] = payload;
+        this.ws.send(JSON.stringify(frame));
+        if (!awaitReply) return Promise.resolve();
+        return new Promise((resolve, reject) => {
+            this.pendingFrames[frame.trace_id] = { resolve, reject };
+            setTimeout(() => {
+                if (!this.pendingFrames[frame.trace_id]) return;
+                delete this.pendingFrames[frame.trace_id];
+                reject(new Error('Request timed out'));
+            }, 10000);
+        });
+    }
+
+    // onFrame handles an OutgoingMessage; int64 fields arrive as strings in protojson
+    onFrame(frame) {
+        const pending = this.pendingFrames[frame.trace_id];
+        switch (frame.type) {
+            case 'TYPE_HEARTBEAT': return;
+            case 'TYPE_ACK':
+                if (pending) { delete this.pendingFrames[frame.trace_id]; pending.resolve(frame.ack_msg); }
+                return;
+            case 'TYPE_ERROR':
+                if (pending) { delete this.pendingFrames[frame.trace_id]; pending.reject(new Error(frame.error_msg?.message || 'Request failed')); }
+                else console.warn('WebSocket error frame', frame.error_msg);
+                return;
+            case 'TYPE_CHAT': {
+                const m = frame.chat_msg || {};
+                return this.onReceiveRealtimeMessage({
+                    msg_id: m.msg_id, conversation_id: m.conversation_id, sender_id: Number(m.sender_id || 0),
+                    content: m.content || '', msg_type: m.msg_type || 0, timestamp: Number(m.timestamp || 0),
+                    sender_info_version: Number(m.sender_info_version || 0), group_meta_version: Number(m.group_meta_version || 0),
+                    relation_version: Number(m.relation_version || 0), sequence: Number(m.sequence || 0), unread_count: Number(m.unread_count || 0),
+                });
+            }
+            case 'TYPE_SYSTEM': {
+                const x = frame.system_msg?.extra || {};
+                return this.onReceiveRealtimeMessage({
+                    msg_id: x.msg_id, conversation_id: x.conversation_id || '', sender_id: Number(x.sender_id || 0),
+                    content: frame.system_msg?.content || '', msg_type: Number(x.msg_type || 0), timestamp: Number(x.timestamp || 0),
+                    sender_info_version: Number(x.sender_info_version || 0), relation_version: Number(x.relation_version || 0),
+                });
+            }
+            case 'TYPE_TYPING': return this.onTyping(frame.typing_msg || {});
+        }
+    }
+
+    sendTyping(isTyping) {
+        if (!this.currentChat || !this.socketReady()) return;
+        const now = Date.now();
+        if (isTyping && now - this.typingSentAt < 3000) return;
+        this.typingSentAt = isTyping ? now : 0;
+        this.sendFrame('TYPE_TYPING', 'typing_msg', { conversation_id: this.currentChat.conversation_id, is_typing: isTyping });
+    }
+
+    onTyping(t) {
+        if (this.currentChat?.conversation_id !== t.conversation_id) return;
+        const subtext = document.getElementById('chat-subtext');
+        clearTimeout(this.typingTimer);
+        if (!t.is_typing) { subtext.textContent = 'Online'; return; }
+        const uid = Number(t.user_id || 0);
+        const name = this.knownUsers[uid]?.nickname || this.friends.find(f => f.user_id == uid)?.nickname || `User ${uid}`;
+        subtext.textContent = this.currentChat.isGroup ? `${name} is typing...` : 'typing...';
+        this.typingTimer = setTimeout(() => { subtext.textContent = 'Online'; }, 6000);
+    }
+
     async onReceiveRealtimeMessage(msg) {
         if (msg.msg_type >= 10) return this.handleSignalMessage(msg);
 
@@ -334,13 +597,26 @@ class GoChatApp {
                 }
                 break;
             case 16: alert(`Join request rejected`); this.loadInitialData(); break;
+            case 19: // PRESENCE_CHANGED
+                this.presence[msg.sender_id] = msg.content;
+                if (this.currentView === 'friends') this.renderFriendList();
+                break;
         }
     }
 
     // --- Action Handlers ---
     async handleApplyFriend(userId) {
         const message = prompt('Intro:', 'Hi, I want to be your friend.');
-        if (message !== null) await this.request('/friend/apply', { method: 'POST', body: JSON.stringify({ to_user_id: userId, message }) });
+        if (message === null) return;
+        try {
+            await this.request('/friend/apply', { method: 'POST', body: JSON.stringify({ to_user_id: userId, message }) });
+        } catch (e) {
+            // Users who screen requests with a question get it asked before the apply is resent
+            const match = /Answer the question of this user: (.*)/.exec(e.message);
+            if (!match) throw e;
+            const answer = prompt(match[1]);
+            if (answer) await this.request('/friend/apply', { method: 'POST', body: JSON.stringify({ to_user_id: userId, message, answer }) });
+        }
     }
 
     async handleJoinGroup(groupId) {
@@ -489,6 +765,23 @@ class GoChatApp {
         } catch (e) {}
     }
 
+    // Messages whose realtime push failed for good are kept by the server until acknowledged; they
+    // are already stored, so refreshing the conversations shows them
+    async syncNotifications() {
+        try {
+            let afterId = 0, count = 0, data;
+            do {
+                data = await this.request(`/notifications?after_id=${afterId}`);
+                const ids = (data.notifications || []).map(n => n.id);
+                if (!ids.length) break;
+                await this.request('/notifications/ack', { method: 'POST', body: JSON.stringify({ ids }) });
+                afterId = ids[ids.length - 1];
+                count += ids.length;
+            } while (data.has_more);
+            if (count) this.loadConversations();
+        } catch (e) {}
+    }
+
     async loadConversations() {
         try {
             const data = await this.request('/conversations');
@@ -502,6 +795,16 @@ class GoChatApp {
         const [c, f, g, r, gr] = await Promise.all([this.request('/conversations'), this.request('/friends'), this.request('/groups'), this.request('/friend/apply/list'), this.request('/groups/requests')]);
         this.conversations = c.conversations || []; this.friends = f.friends || []; this.groups = g.groups || []; this.requests = r.applies || []; this.groupRequests = gr.requests || [];
         this.updateBadge(); this.renderCurrentList();
+        this.loadPresence();
+    }
+
+    async loadPresence() {
+        if (!this.friends.length) return;
+        try {
+            const data = await this.request('/users/presence', { method: 'POST', body: JSON.stringify({ user_ids: this.friends.map(f => f.user_id) }) });
+            (data.presences || []).forEach(p => { this.presence[p.user_id] = p.state; });
+            if (this.currentView === 'friends') this.renderFriendList();
+        } catch (e) {}
     }
 
     renderCurrentList() {
@@ -555,7 +858,7 @@ class GoChatApp {
         html += `<div class="list-section-title">MY FRIENDS</div>`;
         html += this.friends.map(f => `<div class="list-item" onclick="app.openPrivateChat(${f.user_id})">
             <div class="avatar-circle">${(f.nickname || 'U')[0].toUpperCase()}</div>
-            <div class="list-item-info"><div class="list-item-name">${f.nickname}</div><div class="list-item-preview">ID: ${f.user_id}</div></div>
+            <div class="list-item-info"><div class="list-item-name">${f.nickname}</div><div class="list-item-preview">ID: ${f.user_id} · ${this.presence[f.user_id] || 'offline'}</div></div>
             <button class="action-btn-small" onclick="event.stopPropagation(); app.openPrivateChat(${f.user_id})">Chat</button>
         </div>`).join('');
         document.getElementById('list-content').innerHTML = html;
@@ -630,7 +933,10 @@ class GoChatApp {
             const body = { conversation_id: this.currentChat.conversation_id, content, msg_type: 1 };
             if (this.currentChat.isGroup) body.group_id = this.currentChat.peer_id;
             else body.receiver_id = this.currentChat.peer_id;
-            await this.request('/messages/send', { method: 'POST', body: JSON.stringify(body) });
+            if (this.socketReady()) {
+                this.sendTyping(false);
+                await this.sendFrame('TYPE_CHAT', 'chat_msg', body, true);
+            } else await this.request('/messages/send', { method: 'POST', body: JSON.stringify(body) });
         } catch (e) { this.messages = this.messages.filter(m => m.msg_id !== opt.msg_id); this.renderMessages(); alert(e.message); }
     }
 
@@ -679,7 +985,12 @@ class GoChatApp {
         
         const data = await this.request(`/messages?conversation_id=${id}`);
         this.messages = data.messages || []; this.renderMessages(); this.scrollToBottom();
-        this.request('/conversations/clear_unread', { method: 'POST', body: JSON.stringify({ conversation_id: id }) }).then(() => this.loadConversations());
+        const conv = this.conversations.find(c => c.conversation_id === id);
+        if (conv) conv.unread_count = 0;
+        const read = this.socketReady()
+            ? this.sendFrame('TYPE_READ', 'read_msg', { conversation_id: id })
+            : this.request('/conversations/clear_unread', { method: 'POST', body: JSON.stringify({ conversation_id: id }) });
+        read.then(() => this.loadConversations());
     }
 
     async restoreAndOpen(id, pId, isG) {